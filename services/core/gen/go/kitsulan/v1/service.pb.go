@@ -9,6 +9,7 @@ package kitsulanv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,12 +23,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DMPolicy — кто может начать с пользователем личную переписку.
+type DMPolicy int32
+
+const (
+	DMPolicy_DM_POLICY_UNSPECIFIED   DMPolicy = 0
+	DMPolicy_DM_POLICY_EVERYONE      DMPolicy = 1
+	DMPolicy_DM_POLICY_GUILD_MEMBERS DMPolicy = 2 // Друзья и те, с кем есть общая гильдия
+	DMPolicy_DM_POLICY_NOBODY        DMPolicy = 3
+)
+
+// Enum value maps for DMPolicy.
+var (
+	DMPolicy_name = map[int32]string{
+		0: "DM_POLICY_UNSPECIFIED",
+		1: "DM_POLICY_EVERYONE",
+		2: "DM_POLICY_GUILD_MEMBERS",
+		3: "DM_POLICY_NOBODY",
+	}
+	DMPolicy_value = map[string]int32{
+		"DM_POLICY_UNSPECIFIED":   0,
+		"DM_POLICY_EVERYONE":      1,
+		"DM_POLICY_GUILD_MEMBERS": 2,
+		"DM_POLICY_NOBODY":        3,
+	}
+)
+
+func (x DMPolicy) Enum() *DMPolicy {
+	p := new(DMPolicy)
+	*p = x
+	return p
+}
+
+func (x DMPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DMPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (DMPolicy) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[0]
+}
+
+func (x DMPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DMPolicy.Descriptor instead.
+func (DMPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
 type ChannelType int32
 
 const (
 	ChannelType_CHANNEL_TYPE_UNSPECIFIED ChannelType = 0
 	ChannelType_CHANNEL_TYPE_TEXT        ChannelType = 1
 	ChannelType_CHANNEL_TYPE_VOICE       ChannelType = 2
+	// Писать могут только с PUBLISH_MESSAGES; сообщения копируются в каналы-подписчики
+	ChannelType_CHANNEL_TYPE_ANNOUNCEMENT ChannelType = 3
 )
 
 // Enum value maps for ChannelType.
@@ -36,11 +92,13 @@ var (
 		0: "CHANNEL_TYPE_UNSPECIFIED",
 		1: "CHANNEL_TYPE_TEXT",
 		2: "CHANNEL_TYPE_VOICE",
+		3: "CHANNEL_TYPE_ANNOUNCEMENT",
 	}
 	ChannelType_value = map[string]int32{
-		"CHANNEL_TYPE_UNSPECIFIED": 0,
-		"CHANNEL_TYPE_TEXT":        1,
-		"CHANNEL_TYPE_VOICE":       2,
+		"CHANNEL_TYPE_UNSPECIFIED":  0,
+		"CHANNEL_TYPE_TEXT":         1,
+		"CHANNEL_TYPE_VOICE":        2,
+		"CHANNEL_TYPE_ANNOUNCEMENT": 3,
 	}
 )
 
@@ -55,11 +113,11 @@ func (x ChannelType) String() string {
 }
 
 func (ChannelType) Descriptor() protoreflect.EnumDescriptor {
-	return file_kitsulan_v1_service_proto_enumTypes[0].Descriptor()
+	return file_kitsulan_v1_service_proto_enumTypes[1].Descriptor()
 }
 
 func (ChannelType) Type() protoreflect.EnumType {
-	return &file_kitsulan_v1_service_proto_enumTypes[0]
+	return &file_kitsulan_v1_service_proto_enumTypes[1]
 }
 
 func (x ChannelType) Number() protoreflect.EnumNumber {
@@ -68,7 +126,464 @@ func (x ChannelType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChannelType.Descriptor instead.
 func (ChannelType) EnumDescriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{0}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ComponentType int32
+
+const (
+	ComponentType_COMPONENT_TYPE_UNSPECIFIED ComponentType = 0
+	ComponentType_COMPONENT_TYPE_BUTTON      ComponentType = 1
+	ComponentType_COMPONENT_TYPE_SELECT      ComponentType = 2
+)
+
+// Enum value maps for ComponentType.
+var (
+	ComponentType_name = map[int32]string{
+		0: "COMPONENT_TYPE_UNSPECIFIED",
+		1: "COMPONENT_TYPE_BUTTON",
+		2: "COMPONENT_TYPE_SELECT",
+	}
+	ComponentType_value = map[string]int32{
+		"COMPONENT_TYPE_UNSPECIFIED": 0,
+		"COMPONENT_TYPE_BUTTON":      1,
+		"COMPONENT_TYPE_SELECT":      2,
+	}
+)
+
+func (x ComponentType) Enum() *ComponentType {
+	p := new(ComponentType)
+	*p = x
+	return p
+}
+
+func (x ComponentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComponentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ComponentType) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[2]
+}
+
+func (x ComponentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComponentType.Descriptor instead.
+func (ComponentType) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ButtonStyle int32
+
+const (
+	ButtonStyle_BUTTON_STYLE_UNSPECIFIED ButtonStyle = 0
+	ButtonStyle_BUTTON_STYLE_PRIMARY     ButtonStyle = 1
+	ButtonStyle_BUTTON_STYLE_SECONDARY   ButtonStyle = 2
+	ButtonStyle_BUTTON_STYLE_SUCCESS     ButtonStyle = 3
+	ButtonStyle_BUTTON_STYLE_DANGER      ButtonStyle = 4
+	ButtonStyle_BUTTON_STYLE_LINK        ButtonStyle = 5 // Открывает url, взаимодействия не создаёт
+)
+
+// Enum value maps for ButtonStyle.
+var (
+	ButtonStyle_name = map[int32]string{
+		0: "BUTTON_STYLE_UNSPECIFIED",
+		1: "BUTTON_STYLE_PRIMARY",
+		2: "BUTTON_STYLE_SECONDARY",
+		3: "BUTTON_STYLE_SUCCESS",
+		4: "BUTTON_STYLE_DANGER",
+		5: "BUTTON_STYLE_LINK",
+	}
+	ButtonStyle_value = map[string]int32{
+		"BUTTON_STYLE_UNSPECIFIED": 0,
+		"BUTTON_STYLE_PRIMARY":     1,
+		"BUTTON_STYLE_SECONDARY":   2,
+		"BUTTON_STYLE_SUCCESS":     3,
+		"BUTTON_STYLE_DANGER":      4,
+		"BUTTON_STYLE_LINK":        5,
+	}
+)
+
+func (x ButtonStyle) Enum() *ButtonStyle {
+	p := new(ButtonStyle)
+	*p = x
+	return p
+}
+
+func (x ButtonStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ButtonStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ButtonStyle) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[3]
+}
+
+func (x ButtonStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ButtonStyle.Descriptor instead.
+func (ButtonStyle) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+type EmbedType int32
+
+const (
+	EmbedType_EMBED_TYPE_UNSPECIFIED EmbedType = 0
+	EmbedType_EMBED_TYPE_LINK        EmbedType = 1
+	EmbedType_EMBED_TYPE_IMAGE       EmbedType = 2
+	EmbedType_EMBED_TYPE_VIDEO       EmbedType = 3
+	EmbedType_EMBED_TYPE_RICH        EmbedType = 4
+)
+
+// Enum value maps for EmbedType.
+var (
+	EmbedType_name = map[int32]string{
+		0: "EMBED_TYPE_UNSPECIFIED",
+		1: "EMBED_TYPE_LINK",
+		2: "EMBED_TYPE_IMAGE",
+		3: "EMBED_TYPE_VIDEO",
+		4: "EMBED_TYPE_RICH",
+	}
+	EmbedType_value = map[string]int32{
+		"EMBED_TYPE_UNSPECIFIED": 0,
+		"EMBED_TYPE_LINK":        1,
+		"EMBED_TYPE_IMAGE":       2,
+		"EMBED_TYPE_VIDEO":       3,
+		"EMBED_TYPE_RICH":        4,
+	}
+)
+
+func (x EmbedType) Enum() *EmbedType {
+	p := new(EmbedType)
+	*p = x
+	return p
+}
+
+func (x EmbedType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmbedType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[4].Descriptor()
+}
+
+func (EmbedType) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[4]
+}
+
+func (x EmbedType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmbedType.Descriptor instead.
+func (EmbedType) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+type MarkupNodeType int32
+
+const (
+	MarkupNodeType_MARKUP_NODE_TYPE_UNSPECIFIED  MarkupNodeType = 0
+	MarkupNodeType_MARKUP_NODE_TYPE_TEXT         MarkupNodeType = 1
+	MarkupNodeType_MARKUP_NODE_TYPE_LINE_BREAK   MarkupNodeType = 2
+	MarkupNodeType_MARKUP_NODE_TYPE_BOLD         MarkupNodeType = 3
+	MarkupNodeType_MARKUP_NODE_TYPE_ITALIC       MarkupNodeType = 4
+	MarkupNodeType_MARKUP_NODE_TYPE_SPOILER      MarkupNodeType = 5
+	MarkupNodeType_MARKUP_NODE_TYPE_INLINE_CODE  MarkupNodeType = 6
+	MarkupNodeType_MARKUP_NODE_TYPE_CODE_BLOCK   MarkupNodeType = 7
+	MarkupNodeType_MARKUP_NODE_TYPE_QUOTE        MarkupNodeType = 8
+	MarkupNodeType_MARKUP_NODE_TYPE_LINK         MarkupNodeType = 9
+	MarkupNodeType_MARKUP_NODE_TYPE_USER_MENTION MarkupNodeType = 10
+	MarkupNodeType_MARKUP_NODE_TYPE_ROLE_MENTION MarkupNodeType = 11
+	MarkupNodeType_MARKUP_NODE_TYPE_EVERYONE     MarkupNodeType = 12
+	MarkupNodeType_MARKUP_NODE_TYPE_HERE         MarkupNodeType = 13
+	MarkupNodeType_MARKUP_NODE_TYPE_CUSTOM_EMOJI MarkupNodeType = 14
+)
+
+// Enum value maps for MarkupNodeType.
+var (
+	MarkupNodeType_name = map[int32]string{
+		0:  "MARKUP_NODE_TYPE_UNSPECIFIED",
+		1:  "MARKUP_NODE_TYPE_TEXT",
+		2:  "MARKUP_NODE_TYPE_LINE_BREAK",
+		3:  "MARKUP_NODE_TYPE_BOLD",
+		4:  "MARKUP_NODE_TYPE_ITALIC",
+		5:  "MARKUP_NODE_TYPE_SPOILER",
+		6:  "MARKUP_NODE_TYPE_INLINE_CODE",
+		7:  "MARKUP_NODE_TYPE_CODE_BLOCK",
+		8:  "MARKUP_NODE_TYPE_QUOTE",
+		9:  "MARKUP_NODE_TYPE_LINK",
+		10: "MARKUP_NODE_TYPE_USER_MENTION",
+		11: "MARKUP_NODE_TYPE_ROLE_MENTION",
+		12: "MARKUP_NODE_TYPE_EVERYONE",
+		13: "MARKUP_NODE_TYPE_HERE",
+		14: "MARKUP_NODE_TYPE_CUSTOM_EMOJI",
+	}
+	MarkupNodeType_value = map[string]int32{
+		"MARKUP_NODE_TYPE_UNSPECIFIED":  0,
+		"MARKUP_NODE_TYPE_TEXT":         1,
+		"MARKUP_NODE_TYPE_LINE_BREAK":   2,
+		"MARKUP_NODE_TYPE_BOLD":         3,
+		"MARKUP_NODE_TYPE_ITALIC":       4,
+		"MARKUP_NODE_TYPE_SPOILER":      5,
+		"MARKUP_NODE_TYPE_INLINE_CODE":  6,
+		"MARKUP_NODE_TYPE_CODE_BLOCK":   7,
+		"MARKUP_NODE_TYPE_QUOTE":        8,
+		"MARKUP_NODE_TYPE_LINK":         9,
+		"MARKUP_NODE_TYPE_USER_MENTION": 10,
+		"MARKUP_NODE_TYPE_ROLE_MENTION": 11,
+		"MARKUP_NODE_TYPE_EVERYONE":     12,
+		"MARKUP_NODE_TYPE_HERE":         13,
+		"MARKUP_NODE_TYPE_CUSTOM_EMOJI": 14,
+	}
+)
+
+func (x MarkupNodeType) Enum() *MarkupNodeType {
+	p := new(MarkupNodeType)
+	*p = x
+	return p
+}
+
+func (x MarkupNodeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MarkupNodeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[5].Descriptor()
+}
+
+func (MarkupNodeType) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[5]
+}
+
+func (x MarkupNodeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MarkupNodeType.Descriptor instead.
+func (MarkupNodeType) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+type SystemMessageType int32
+
+const (
+	SystemMessageType_SYSTEM_MESSAGE_TYPE_UNSPECIFIED  SystemMessageType = 0
+	SystemMessageType_SYSTEM_MESSAGE_TYPE_MEMBER_JOIN  SystemMessageType = 1
+	SystemMessageType_SYSTEM_MESSAGE_TYPE_MEMBER_LEAVE SystemMessageType = 2
+	SystemMessageType_SYSTEM_MESSAGE_TYPE_MEMBER_KICK  SystemMessageType = 3
+	SystemMessageType_SYSTEM_MESSAGE_TYPE_MESSAGE_PIN  SystemMessageType = 4
+)
+
+// Enum value maps for SystemMessageType.
+var (
+	SystemMessageType_name = map[int32]string{
+		0: "SYSTEM_MESSAGE_TYPE_UNSPECIFIED",
+		1: "SYSTEM_MESSAGE_TYPE_MEMBER_JOIN",
+		2: "SYSTEM_MESSAGE_TYPE_MEMBER_LEAVE",
+		3: "SYSTEM_MESSAGE_TYPE_MEMBER_KICK",
+		4: "SYSTEM_MESSAGE_TYPE_MESSAGE_PIN",
+	}
+	SystemMessageType_value = map[string]int32{
+		"SYSTEM_MESSAGE_TYPE_UNSPECIFIED":  0,
+		"SYSTEM_MESSAGE_TYPE_MEMBER_JOIN":  1,
+		"SYSTEM_MESSAGE_TYPE_MEMBER_LEAVE": 2,
+		"SYSTEM_MESSAGE_TYPE_MEMBER_KICK":  3,
+		"SYSTEM_MESSAGE_TYPE_MESSAGE_PIN":  4,
+	}
+)
+
+func (x SystemMessageType) Enum() *SystemMessageType {
+	p := new(SystemMessageType)
+	*p = x
+	return p
+}
+
+func (x SystemMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SystemMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[6].Descriptor()
+}
+
+func (SystemMessageType) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[6]
+}
+
+func (x SystemMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SystemMessageType.Descriptor instead.
+func (SystemMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+type InteractionResponseType int32
+
+const (
+	InteractionResponseType_INTERACTION_RESPONSE_TYPE_UNSPECIFIED InteractionResponseType = 0
+	// Ответ видит только нажавший
+	InteractionResponseType_INTERACTION_RESPONSE_TYPE_EPHEMERAL_REPLY InteractionResponseType = 1
+	// Заменить текст и компоненты сообщения (видят все)
+	InteractionResponseType_INTERACTION_RESPONSE_TYPE_UPDATE_MESSAGE InteractionResponseType = 2
+)
+
+// Enum value maps for InteractionResponseType.
+var (
+	InteractionResponseType_name = map[int32]string{
+		0: "INTERACTION_RESPONSE_TYPE_UNSPECIFIED",
+		1: "INTERACTION_RESPONSE_TYPE_EPHEMERAL_REPLY",
+		2: "INTERACTION_RESPONSE_TYPE_UPDATE_MESSAGE",
+	}
+	InteractionResponseType_value = map[string]int32{
+		"INTERACTION_RESPONSE_TYPE_UNSPECIFIED":     0,
+		"INTERACTION_RESPONSE_TYPE_EPHEMERAL_REPLY": 1,
+		"INTERACTION_RESPONSE_TYPE_UPDATE_MESSAGE":  2,
+	}
+)
+
+func (x InteractionResponseType) Enum() *InteractionResponseType {
+	p := new(InteractionResponseType)
+	*p = x
+	return p
+}
+
+func (x InteractionResponseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InteractionResponseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[7].Descriptor()
+}
+
+func (InteractionResponseType) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[7]
+}
+
+func (x InteractionResponseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InteractionResponseType.Descriptor instead.
+func (InteractionResponseType) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ScheduledMessageStatus int32
+
+const (
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_UNSPECIFIED ScheduledMessageStatus = 0
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_PENDING     ScheduledMessageStatus = 1
+	ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_FAILED      ScheduledMessageStatus = 2 // Отправка отклонена, причина в error
+)
+
+// Enum value maps for ScheduledMessageStatus.
+var (
+	ScheduledMessageStatus_name = map[int32]string{
+		0: "SCHEDULED_MESSAGE_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_MESSAGE_STATUS_PENDING",
+		2: "SCHEDULED_MESSAGE_STATUS_FAILED",
+	}
+	ScheduledMessageStatus_value = map[string]int32{
+		"SCHEDULED_MESSAGE_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_MESSAGE_STATUS_PENDING":     1,
+		"SCHEDULED_MESSAGE_STATUS_FAILED":      2,
+	}
+)
+
+func (x ScheduledMessageStatus) Enum() *ScheduledMessageStatus {
+	p := new(ScheduledMessageStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledMessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledMessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[8].Descriptor()
+}
+
+func (ScheduledMessageStatus) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[8]
+}
+
+func (x ScheduledMessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledMessageStatus.Descriptor instead.
+func (ScheduledMessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+type RelationshipType int32
+
+const (
+	RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED      RelationshipType = 0
+	RelationshipType_RELATIONSHIP_TYPE_FRIEND           RelationshipType = 1
+	RelationshipType_RELATIONSHIP_TYPE_INCOMING_REQUEST RelationshipType = 2
+	RelationshipType_RELATIONSHIP_TYPE_OUTGOING_REQUEST RelationshipType = 3
+	RelationshipType_RELATIONSHIP_TYPE_BLOCKED          RelationshipType = 4
+)
+
+// Enum value maps for RelationshipType.
+var (
+	RelationshipType_name = map[int32]string{
+		0: "RELATIONSHIP_TYPE_UNSPECIFIED",
+		1: "RELATIONSHIP_TYPE_FRIEND",
+		2: "RELATIONSHIP_TYPE_INCOMING_REQUEST",
+		3: "RELATIONSHIP_TYPE_OUTGOING_REQUEST",
+		4: "RELATIONSHIP_TYPE_BLOCKED",
+	}
+	RelationshipType_value = map[string]int32{
+		"RELATIONSHIP_TYPE_UNSPECIFIED":      0,
+		"RELATIONSHIP_TYPE_FRIEND":           1,
+		"RELATIONSHIP_TYPE_INCOMING_REQUEST": 2,
+		"RELATIONSHIP_TYPE_OUTGOING_REQUEST": 3,
+		"RELATIONSHIP_TYPE_BLOCKED":          4,
+	}
+)
+
+func (x RelationshipType) Enum() *RelationshipType {
+	p := new(RelationshipType)
+	*p = x
+	return p
+}
+
+func (x RelationshipType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationshipType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kitsulan_v1_service_proto_enumTypes[9].Descriptor()
+}
+
+func (RelationshipType) Type() protoreflect.EnumType {
+	return &file_kitsulan_v1_service_proto_enumTypes[9]
+}
+
+func (x RelationshipType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationshipType.Descriptor instead.
+func (RelationshipType) EnumDescriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{9}
 }
 
 type User struct {
@@ -78,7 +593,8 @@ type User struct {
 	AvatarUrl string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	Bio       string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"` // TODO: Поле ожидается тяжёллым рассмотреть вариант сделать
 	// ленивую загрузку
-	IsOnline      bool `protobuf:"varint,5,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	IsOnline      bool     `protobuf:"varint,5,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	DmPolicy      DMPolicy `protobuf:"varint,6,opt,name=dm_policy,json=dmPolicy,proto3,enum=kitsulan.v1.DMPolicy" json:"dm_policy,omitempty"` // Только в собственном профиле
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -148,6 +664,13 @@ func (x *User) GetIsOnline() bool {
 	return false
 }
 
+func (x *User) GetDmPolicy() DMPolicy {
+	if x != nil {
+		return x.DmPolicy
+	}
+	return DMPolicy_DM_POLICY_UNSPECIFIED
+}
+
 // Auth Request/Response
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -554,9 +1077,12 @@ func (x *GetProfileResponse) GetUser() *User {
 type UpdateProfileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// optional позволяет понять, передали поле или нет
-	Nickname      *string `protobuf:"bytes,1,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
-	Bio           *string `protobuf:"bytes,2,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
-	AvatarUrl     *string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	Nickname  *string `protobuf:"bytes,1,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	Bio       *string `protobuf:"bytes,2,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	AvatarUrl *string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	// Проверяется при открытии нового личного канала и добавлении в групповой;
+	// уже открытые каналы не закрываются
+	DmPolicy      *DMPolicy `protobuf:"varint,4,opt,name=dm_policy,json=dmPolicy,proto3,enum=kitsulan.v1.DMPolicy,oneof" json:"dm_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -612,6 +1138,13 @@ func (x *UpdateProfileRequest) GetAvatarUrl() string {
 	return ""
 }
 
+func (x *UpdateProfileRequest) GetDmPolicy() DMPolicy {
+	if x != nil && x.DmPolicy != nil {
+		return *x.DmPolicy
+	}
+	return DMPolicy_DM_POLICY_UNSPECIFIED
+}
+
 type UpdateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -745,17 +1278,24 @@ func (x *SearchUsersResponse) GetUsers() []*User {
 }
 
 type Guild struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	IconUrl       string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
-	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"` // HEX цвет (например #ff0000)
-	OwnerId       string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	MemberCount   int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IconUrl         string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Color           string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"` // HEX цвет (например #ff0000)
+	OwnerId         string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	MemberCount     int32                  `protobuf:"varint,7,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BannerUrl       string                 `protobuf:"bytes,9,opt,name=banner_url,json=bannerUrl,proto3" json:"banner_url,omitempty"`
+	SplashUrl       string                 `protobuf:"bytes,10,opt,name=splash_url,json=splashUrl,proto3" json:"splash_url,omitempty"`
+	SystemChannelId string                 `protobuf:"bytes,11,opt,name=system_channel_id,json=systemChannelId,proto3" json:"system_channel_id,omitempty"` // Канал для системных сообщений (пусто = нет)
+	RulesChannelId  string                 `protobuf:"bytes,12,opt,name=rules_channel_id,json=rulesChannelId,proto3" json:"rules_channel_id,omitempty"`
+	AfkChannelId    string                 `protobuf:"bytes,13,opt,name=afk_channel_id,json=afkChannelId,proto3" json:"afk_channel_id,omitempty"`
+	AfkTimeout      int32                  `protobuf:"varint,14,opt,name=afk_timeout,json=afkTimeout,proto3" json:"afk_timeout,omitempty"` // В секундах
+	Version         uint32                 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`                         // Версия для Optimistic Locking (см. UpdateGuild)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Guild) Reset() {
@@ -844,11 +1384,60 @@ func (x *Guild) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Channel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+func (x *Guild) GetBannerUrl() string {
+	if x != nil {
+		return x.BannerUrl
+	}
+	return ""
+}
+
+func (x *Guild) GetSplashUrl() string {
+	if x != nil {
+		return x.SplashUrl
+	}
+	return ""
+}
+
+func (x *Guild) GetSystemChannelId() string {
+	if x != nil {
+		return x.SystemChannelId
+	}
+	return ""
+}
+
+func (x *Guild) GetRulesChannelId() string {
+	if x != nil {
+		return x.RulesChannelId
+	}
+	return ""
+}
+
+func (x *Guild) GetAfkChannelId() string {
+	if x != nil {
+		return x.AfkChannelId
+	}
+	return ""
+}
+
+func (x *Guild) GetAfkTimeout() int32 {
+	if x != nil {
+		return x.AfkTimeout
+	}
+	return 0
+}
+
+func (x *Guild) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type Channel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Type          ChannelType            `protobuf:"varint,4,opt,name=type,proto3,enum=kitsulan.v1.ChannelType" json:"type,omitempty"`
 	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -921,13 +1510,22 @@ func (x *Channel) GetPosition() int32 {
 }
 
 type Member struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Nickname      string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	IsOnline      bool                   `protobuf:"varint,5,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarUrl      string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Nickname       string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	IsOnline       bool                   `protobuf:"varint,5,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	JoinedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	GuildAvatarUrl string                 `protobuf:"bytes,7,opt,name=guild_avatar_url,json=guildAvatarUrl,proto3" json:"guild_avatar_url,omitempty"` // Аватар в этой гильдии (пусто = глобальный)
+	GuildId        string                 `protobuf:"bytes,8,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// Окончание тайм-аута (пусто = тайм-аута нет или он истёк)
+	TimeoutUntil *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timeout_until,json=timeoutUntil,proto3" json:"timeout_until,omitempty"`
+	IsMuted      bool                   `protobuf:"varint,10,opt,name=is_muted,json=isMuted,proto3" json:"is_muted,omitempty"`
+	IsDeafened   bool                   `protobuf:"varint,11,opt,name=is_deafened,json=isDeafened,proto3" json:"is_deafened,omitempty"`
+	// Может ли говорить в голосе: нет серверного mute и действующего тайм-аута.
+	// Голосовой сервер пускает участника в эфир только при can_speak.
+	CanSpeak      bool `protobuf:"varint,12,opt,name=can_speak,json=canSpeak,proto3" json:"can_speak,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1004,6 +1602,48 @@ func (x *Member) GetJoinedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Member) GetGuildAvatarUrl() string {
+	if x != nil {
+		return x.GuildAvatarUrl
+	}
+	return ""
+}
+
+func (x *Member) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *Member) GetTimeoutUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeoutUntil
+	}
+	return nil
+}
+
+func (x *Member) GetIsMuted() bool {
+	if x != nil {
+		return x.IsMuted
+	}
+	return false
+}
+
+func (x *Member) GetIsDeafened() bool {
+	if x != nil {
+		return x.IsDeafened
+	}
+	return false
+}
+
+func (x *Member) GetCanSpeak() bool {
+	if x != nil {
+		return x.CanSpeak
+	}
+	return false
+}
+
 type CreateGuildRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1188,6 +1828,123 @@ func (x *GetGuildResponse) GetGuild() *Guild {
 	return nil
 }
 
+type UpdateGuildRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	// Новые значения. Применяются только поля, перечисленные в update_mask.
+	Guild *Guild `protobuf:"bytes,2,opt,name=guild,proto3" json:"guild,omitempty"`
+	// Пути полей Guild: name, description, icon_url, banner_url, splash_url,
+	// color, system_channel_id, rules_channel_id, afk_channel_id, afk_timeout.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Guild.version, на основе которой сделано изменение.
+	// 0 — не проверять (last write wins в рамках текущей версии).
+	Version       uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuildRequest) Reset() {
+	*x = UpdateGuildRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuildRequest) ProtoMessage() {}
+
+func (x *UpdateGuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuildRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuildRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateGuildRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *UpdateGuildRequest) GetGuild() *Guild {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
+func (x *UpdateGuildRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateGuildRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateGuildResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guild         *Guild                 `protobuf:"bytes,1,opt,name=guild,proto3" json:"guild,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuildResponse) Reset() {
+	*x = UpdateGuildResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuildResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuildResponse) ProtoMessage() {}
+
+func (x *UpdateGuildResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuildResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGuildResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateGuildResponse) GetGuild() *Guild {
+	if x != nil {
+		return x.Guild
+	}
+	return nil
+}
+
 type ListMyGuildsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1196,7 +1953,7 @@ type ListMyGuildsRequest struct {
 
 func (x *ListMyGuildsRequest) Reset() {
 	*x = ListMyGuildsRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[20]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1208,7 +1965,7 @@ func (x *ListMyGuildsRequest) String() string {
 func (*ListMyGuildsRequest) ProtoMessage() {}
 
 func (x *ListMyGuildsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[20]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,7 +1978,7 @@ func (x *ListMyGuildsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMyGuildsRequest.ProtoReflect.Descriptor instead.
 func (*ListMyGuildsRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{20}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{22}
 }
 
 type ListMyGuildsResponse struct {
@@ -1233,7 +1990,7 @@ type ListMyGuildsResponse struct {
 
 func (x *ListMyGuildsResponse) Reset() {
 	*x = ListMyGuildsResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[21]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +2002,7 @@ func (x *ListMyGuildsResponse) String() string {
 func (*ListMyGuildsResponse) ProtoMessage() {}
 
 func (x *ListMyGuildsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[21]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +2015,7 @@ func (x *ListMyGuildsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMyGuildsResponse.ProtoReflect.Descriptor instead.
 func (*ListMyGuildsResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{21}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListMyGuildsResponse) GetGuilds() []*Guild {
@@ -1277,7 +2034,7 @@ type DeleteGuildRequest struct {
 
 func (x *DeleteGuildRequest) Reset() {
 	*x = DeleteGuildRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[22]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1289,7 +2046,7 @@ func (x *DeleteGuildRequest) String() string {
 func (*DeleteGuildRequest) ProtoMessage() {}
 
 func (x *DeleteGuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[22]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,7 +2059,7 @@ func (x *DeleteGuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteGuildRequest.ProtoReflect.Descriptor instead.
 func (*DeleteGuildRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{22}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteGuildRequest) GetGuildId() string {
@@ -1320,7 +2077,7 @@ type DeleteGuildResponse struct {
 
 func (x *DeleteGuildResponse) Reset() {
 	*x = DeleteGuildResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[23]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1332,7 +2089,7 @@ func (x *DeleteGuildResponse) String() string {
 func (*DeleteGuildResponse) ProtoMessage() {}
 
 func (x *DeleteGuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[23]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,7 +2102,7 @@ func (x *DeleteGuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteGuildResponse.ProtoReflect.Descriptor instead.
 func (*DeleteGuildResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{23}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{25}
 }
 
 type CreateInviteRequest struct {
@@ -1359,7 +2116,7 @@ type CreateInviteRequest struct {
 
 func (x *CreateInviteRequest) Reset() {
 	*x = CreateInviteRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[24]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1371,7 +2128,7 @@ func (x *CreateInviteRequest) String() string {
 func (*CreateInviteRequest) ProtoMessage() {}
 
 func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[24]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +2141,7 @@ func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
 func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{24}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateInviteRequest) GetGuildId() string {
@@ -1418,7 +2175,7 @@ type CreateInviteResponse struct {
 
 func (x *CreateInviteResponse) Reset() {
 	*x = CreateInviteResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[25]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1430,7 +2187,7 @@ func (x *CreateInviteResponse) String() string {
 func (*CreateInviteResponse) ProtoMessage() {}
 
 func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[25]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,7 +2200,7 @@ func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
 func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{25}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CreateInviteResponse) GetCode() string {
@@ -1469,7 +2226,7 @@ type JoinByInviteRequest struct {
 
 func (x *JoinByInviteRequest) Reset() {
 	*x = JoinByInviteRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[26]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1481,7 +2238,7 @@ func (x *JoinByInviteRequest) String() string {
 func (*JoinByInviteRequest) ProtoMessage() {}
 
 func (x *JoinByInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[26]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1494,7 +2251,7 @@ func (x *JoinByInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinByInviteRequest.ProtoReflect.Descriptor instead.
 func (*JoinByInviteRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{26}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *JoinByInviteRequest) GetCode() string {
@@ -1513,7 +2270,7 @@ type JoinByInviteResponse struct {
 
 func (x *JoinByInviteResponse) Reset() {
 	*x = JoinByInviteResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[27]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1525,7 +2282,7 @@ func (x *JoinByInviteResponse) String() string {
 func (*JoinByInviteResponse) ProtoMessage() {}
 
 func (x *JoinByInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[27]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1538,7 +2295,7 @@ func (x *JoinByInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinByInviteResponse.ProtoReflect.Descriptor instead.
 func (*JoinByInviteResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{27}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *JoinByInviteResponse) GetGuild() *Guild {
@@ -1557,7 +2314,7 @@ type LeaveGuildRequest struct {
 
 func (x *LeaveGuildRequest) Reset() {
 	*x = LeaveGuildRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[28]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1569,7 +2326,7 @@ func (x *LeaveGuildRequest) String() string {
 func (*LeaveGuildRequest) ProtoMessage() {}
 
 func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[28]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +2339,7 @@ func (x *LeaveGuildRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGuildRequest.ProtoReflect.Descriptor instead.
 func (*LeaveGuildRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{28}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *LeaveGuildRequest) GetGuildId() string {
@@ -1600,7 +2357,7 @@ type LeaveGuildResponse struct {
 
 func (x *LeaveGuildResponse) Reset() {
 	*x = LeaveGuildResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[29]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1612,7 +2369,7 @@ func (x *LeaveGuildResponse) String() string {
 func (*LeaveGuildResponse) ProtoMessage() {}
 
 func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[29]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1625,33 +2382,32 @@ func (x *LeaveGuildResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveGuildResponse.ProtoReflect.Descriptor instead.
 func (*LeaveGuildResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{29}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{31}
 }
 
-type CreateChannelRequest struct {
+type KickMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          ChannelType            `protobuf:"varint,3,opt,name=type,proto3,enum=kitsulan.v1.ChannelType" json:"type,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[30]
+func (x *KickMemberRequest) Reset() {
+	*x = KickMemberRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *KickMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*KickMemberRequest) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[30]
+func (x *KickMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1662,54 +2418,143 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use KickMemberRequest.ProtoReflect.Descriptor instead.
+func (*KickMemberRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateChannelRequest) GetGuildId() string {
+func (x *KickMemberRequest) GetGuildId() string {
 	if x != nil {
 		return x.GuildId
 	}
 	return ""
 }
 
-func (x *CreateChannelRequest) GetName() string {
+func (x *KickMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateChannelRequest) GetType() ChannelType {
-	if x != nil {
-		return x.Type
-	}
-	return ChannelType_CHANNEL_TYPE_UNSPECIFIED
-}
-
-type CreateChannelResponse struct {
+type KickMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateChannelResponse) Reset() {
-	*x = CreateChannelResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[31]
+func (x *KickMemberResponse) Reset() {
+	*x = KickMemberResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateChannelResponse) String() string {
+func (x *KickMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelResponse) ProtoMessage() {}
+func (*KickMemberResponse) ProtoMessage() {}
 
-func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[31]
+func (x *KickMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickMemberResponse.ProtoReflect.Descriptor instead.
+func (*KickMemberResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+type CreateChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          ChannelType            `protobuf:"varint,3,opt,name=type,proto3,enum=kitsulan.v1.ChannelType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelRequest) ProtoMessage() {}
+
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateChannelRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateChannelRequest) GetType() ChannelType {
+	if x != nil {
+		return x.Type
+	}
+	return ChannelType_CHANNEL_TYPE_UNSPECIFIED
+}
+
+type CreateChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *Channel               `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChannelResponse) Reset() {
+	*x = CreateChannelResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChannelResponse) ProtoMessage() {}
+
+func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,7 +2567,7 @@ func (x *CreateChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateChannelResponse.ProtoReflect.Descriptor instead.
 func (*CreateChannelResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{31}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateChannelResponse) GetChannel() *Channel {
@@ -1741,7 +2586,7 @@ type DeleteChannelRequest struct {
 
 func (x *DeleteChannelRequest) Reset() {
 	*x = DeleteChannelRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[32]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1753,7 +2598,7 @@ func (x *DeleteChannelRequest) String() string {
 func (*DeleteChannelRequest) ProtoMessage() {}
 
 func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[32]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +2611,7 @@ func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
 func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{32}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DeleteChannelRequest) GetChannelId() string {
@@ -1784,7 +2629,7 @@ type DeleteChannelResponse struct {
 
 func (x *DeleteChannelResponse) Reset() {
 	*x = DeleteChannelResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[33]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1796,7 +2641,7 @@ func (x *DeleteChannelResponse) String() string {
 func (*DeleteChannelResponse) ProtoMessage() {}
 
 func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[33]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1809,7 +2654,7 @@ func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
 func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{33}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{37}
 }
 
 type ListChannelsRequest struct {
@@ -1821,7 +2666,7 @@ type ListChannelsRequest struct {
 
 func (x *ListChannelsRequest) Reset() {
 	*x = ListChannelsRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[34]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1833,7 +2678,7 @@ func (x *ListChannelsRequest) String() string {
 func (*ListChannelsRequest) ProtoMessage() {}
 
 func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[34]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,7 +2691,7 @@ func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
 func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{34}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ListChannelsRequest) GetGuildId() string {
@@ -1865,7 +2710,7 @@ type ListChannelsResponse struct {
 
 func (x *ListChannelsResponse) Reset() {
 	*x = ListChannelsResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[35]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1877,7 +2722,7 @@ func (x *ListChannelsResponse) String() string {
 func (*ListChannelsResponse) ProtoMessage() {}
 
 func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[35]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +2735,7 @@ func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{35}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListChannelsResponse) GetChannels() []*Channel {
@@ -1909,7 +2754,7 @@ type ListMembersRequest struct {
 
 func (x *ListMembersRequest) Reset() {
 	*x = ListMembersRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[36]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1921,7 +2766,7 @@ func (x *ListMembersRequest) String() string {
 func (*ListMembersRequest) ProtoMessage() {}
 
 func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[36]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,7 +2779,7 @@ func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
 func (*ListMembersRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{36}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListMembersRequest) GetGuildId() string {
@@ -1953,7 +2798,7 @@ type ListMembersResponse struct {
 
 func (x *ListMembersResponse) Reset() {
 	*x = ListMembersResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[37]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1965,7 +2810,7 @@ func (x *ListMembersResponse) String() string {
 func (*ListMembersResponse) ProtoMessage() {}
 
 func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[37]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,7 +2823,7 @@ func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
 func (*ListMembersResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{37}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListMembersResponse) GetMembers() []*Member {
@@ -1988,35 +2833,31 @@ func (x *ListMembersResponse) GetMembers() []*Member {
 	return nil
 }
 
-type ChatMessage struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ChannelId       string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	AuthorUsername  string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
-	AuthorAvatarUrl string                 `protobuf:"bytes,5,opt,name=author_avatar_url,json=authorAvatarUrl,proto3" json:"author_avatar_url,omitempty"`
-	Content         string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	EditedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Пустая строка сбрасывает значение к глобальному профилю.
+type UpdateMyMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	Nickname      *string                `protobuf:"bytes,2,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	AvatarUrl     *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatMessage) Reset() {
-	*x = ChatMessage{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[38]
+func (x *UpdateMyMemberRequest) Reset() {
+	*x = UpdateMyMemberRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatMessage) String() string {
+func (x *UpdateMyMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatMessage) ProtoMessage() {}
+func (*UpdateMyMemberRequest) ProtoMessage() {}
 
-func (x *ChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[38]
+func (x *UpdateMyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,96 +2868,122 @@ func (x *ChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
-func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UpdateMyMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ChatMessage) GetId() string {
+func (x *UpdateMyMemberRequest) GetGuildId() string {
 	if x != nil {
-		return x.Id
+		return x.GuildId
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
+func (x *UpdateMyMemberRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetAuthorId() string {
-	if x != nil {
-		return x.AuthorId
+func (x *UpdateMyMemberRequest) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetAuthorUsername() string {
+type UpdateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname      *string                `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	AvatarUrl     *string                `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRequest) Reset() {
+	*x = UpdateMemberRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[43]
 	if x != nil {
-		return x.AuthorUsername
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ChatMessage) GetAuthorAvatarUrl() string {
+// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateMemberRequest) GetGuildId() string {
 	if x != nil {
-		return x.AuthorAvatarUrl
+		return x.GuildId
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetContent() string {
+func (x *UpdateMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Content
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ChatMessage) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *UpdateMemberRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
 	}
-	return nil
+	return ""
 }
 
-func (x *ChatMessage) GetEditedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EditedAt
+func (x *UpdateMemberRequest) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
 	}
-	return nil
+	return ""
 }
 
-// ChatEvent — конверт для server-streaming событий.
-// Используем oneof чтобы в будущем добавить typing, delete, edit без breaking
-// change.
-type ChatEvent struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*ChatEvent_MessageCreated
-	//	*ChatEvent_MessageDeleted
-	Payload       isChatEvent_Payload `protobuf_oneof:"payload"`
+type UpdateMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatEvent) Reset() {
-	*x = ChatEvent{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[39]
+func (x *UpdateMemberResponse) Reset() {
+	*x = UpdateMemberResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatEvent) String() string {
+func (x *UpdateMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatEvent) ProtoMessage() {}
+func (*UpdateMemberResponse) ProtoMessage() {}
 
-func (x *ChatEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[39]
+func (x *UpdateMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,75 +2994,7304 @@ func (x *ChatEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
-func (*ChatEvent) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UpdateMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemberResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ChatEvent) GetPayload() isChatEvent_Payload {
+func (x *UpdateMemberResponse) GetMember() *Member {
 	if x != nil {
-		return x.Payload
+		return x.Member
 	}
 	return nil
 }
 
-func (x *ChatEvent) GetMessageCreated() *ChatMessage {
-	if x != nil {
-		if x, ok := x.Payload.(*ChatEvent_MessageCreated); ok {
-			return x.MessageCreated
-		}
-	}
+type TimeoutMemberRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuildId string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// До какого момента действует тайм-аут (максимум 28 дней).
+	// Пусто или время в прошлом — снять тайм-аут досрочно.
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeoutMemberRequest) Reset() {
+	*x = TimeoutMemberRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeoutMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeoutMemberRequest) ProtoMessage() {}
+
+func (x *TimeoutMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeoutMemberRequest.ProtoReflect.Descriptor instead.
+func (*TimeoutMemberRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TimeoutMemberRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *TimeoutMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TimeoutMemberRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type TimeoutMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeoutMemberResponse) Reset() {
+	*x = TimeoutMemberResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeoutMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeoutMemberResponse) ProtoMessage() {}
+
+func (x *TimeoutMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeoutMemberResponse.ProtoReflect.Descriptor instead.
+func (*TimeoutMemberResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TimeoutMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type SetMemberVoiceStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Muted         *bool                  `protobuf:"varint,3,opt,name=muted,proto3,oneof" json:"muted,omitempty"`
+	Deafened      *bool                  `protobuf:"varint,4,opt,name=deafened,proto3,oneof" json:"deafened,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberVoiceStateRequest) Reset() {
+	*x = SetMemberVoiceStateRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberVoiceStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberVoiceStateRequest) ProtoMessage() {}
+
+func (x *SetMemberVoiceStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberVoiceStateRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberVoiceStateRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetMemberVoiceStateRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *SetMemberVoiceStateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMemberVoiceStateRequest) GetMuted() bool {
+	if x != nil && x.Muted != nil {
+		return *x.Muted
+	}
+	return false
+}
+
+func (x *SetMemberVoiceStateRequest) GetDeafened() bool {
+	if x != nil && x.Deafened != nil {
+		return *x.Deafened
+	}
+	return false
+}
+
+type SetMemberVoiceStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberVoiceStateResponse) Reset() {
+	*x = SetMemberVoiceStateResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberVoiceStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberVoiceStateResponse) ProtoMessage() {}
+
+func (x *SetMemberVoiceStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberVoiceStateResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberVoiceStateResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetMemberVoiceStateResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ChatMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId       string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername  string                 `protobuf:"bytes,4,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	AuthorAvatarUrl string                 `protobuf:"bytes,5,opt,name=author_avatar_url,json=authorAvatarUrl,proto3" json:"author_avatar_url,omitempty"` // С учётом аватара в гильдии
+	Content         string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// Имя для отображения: ник в гильдии, иначе display name, иначе username
+	AuthorDisplayName string `protobuf:"bytes,9,opt,name=author_display_name,json=authorDisplayName,proto3" json:"author_display_name,omitempty"`
+	// Заполнено только для системных сообщений (content при этом пустой)
+	System *SystemMessage `protobuf:"bytes,10,opt,name=system,proto3" json:"system,omitempty"`
+	// Растёт при каждой правке. Клиент игнорирует апдейты со старой версией.
+	EditVersion uint32 `protobuf:"varint,11,opt,name=edit_version,json=editVersion,proto3" json:"edit_version,omitempty"`
+	// Агрегированные реакции в порядке первого появления
+	Reactions []*ReactionSummary `protobuf:"bytes,12,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// Превью сообщения, на которое это ответ
+	ReferencedMessage *MessageReference `protobuf:"bytes,13,opt,name=referenced_message,json=referencedMessage,proto3" json:"referenced_message,omitempty"`
+	// Ветка, начатая от этого сообщения
+	ThreadId string `protobuf:"bytes,14,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Pinned   bool   `protobuf:"varint,15,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Порядковый номер в канале (для read state и синхронизации)
+	Seq int64 `protobuf:"varint,16,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Упоминания, прошедшие проверку: участники гильдии и упоминаемые роли.
+	// В тексте — <@user_id>, <@&role_id>, @everyone, @here.
+	MentionUserIds  []string `protobuf:"bytes,17,rep,name=mention_user_ids,json=mentionUserIds,proto3" json:"mention_user_ids,omitempty"`
+	MentionRoleIds  []string `protobuf:"bytes,18,rep,name=mention_role_ids,json=mentionRoleIds,proto3" json:"mention_role_ids,omitempty"`
+	MentionEveryone bool     `protobuf:"varint,19,opt,name=mention_everyone,json=mentionEveryone,proto3" json:"mention_everyone,omitempty"`
+	MentionHere     bool     `protobuf:"varint,20,opt,name=mention_here,json=mentionHere,proto3" json:"mention_here,omitempty"`
+	// content размечен Markdown-подмножеством; ast — его разбор сервером.
+	// Клиент рендерит ast и не парсит content сам.
+	Markdown bool          `protobuf:"varint,21,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	Ast      []*MarkupNode `protobuf:"bytes,22,rep,name=ast,proto3" json:"ast,omitempty"`
+	// Превью ссылок. Появляются позже отправки — приходят в message_updated.
+	Embeds []*Embed `protobuf:"bytes,23,rep,name=embeds,proto3" json:"embeds,omitempty"`
+	// Кнопки и меню; нажатия — через InteractWithComponent
+	Components []*ActionRow `protobuf:"bytes,24,rep,name=components,proto3" json:"components,omitempty"`
+	// Видно только одному пользователю: не хранится в истории, seq = 0,
+	// после переподключения не восстанавливается
+	Ephemeral bool `protobuf:"varint,25,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	// Nonce из SendMessageRequest — только в ответе и message_created,
+	// чтобы клиент сопоставил сообщение со своим черновиком
+	Nonce string `protobuf:"bytes,26,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Автор заблокирован вызывающим — клиент сворачивает сообщение.
+	// Только в ответах на запросы; real-time события клиент проверяет сам
+	AuthorBlocked bool `protobuf:"varint,27,opt,name=author_blocked,json=authorBlocked,proto3" json:"author_blocked,omitempty"`
+	// Копия объявления из канала, на который подписан этот канал. Меняется и
+	// удаляется вместе с оригиналом, сама по себе не редактируется.
+	Crosspost     *CrosspostSource `protobuf:"bytes,28,opt,name=crosspost,proto3" json:"crosspost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ChatMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAuthorAvatarUrl() string {
+	if x != nil {
+		return x.AuthorAvatarUrl
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetAuthorDisplayName() string {
+	if x != nil {
+		return x.AuthorDisplayName
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetSystem() *SystemMessage {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetEditVersion() uint32 {
+	if x != nil {
+		return x.EditVersion
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetReactions() []*ReactionSummary {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetReferencedMessage() *MessageReference {
+	if x != nil {
+		return x.ReferencedMessage
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *ChatMessage) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ChatMessage) GetMentionUserIds() []string {
+	if x != nil {
+		return x.MentionUserIds
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetMentionRoleIds() []string {
+	if x != nil {
+		return x.MentionRoleIds
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetMentionEveryone() bool {
+	if x != nil {
+		return x.MentionEveryone
+	}
+	return false
+}
+
+func (x *ChatMessage) GetMentionHere() bool {
+	if x != nil {
+		return x.MentionHere
+	}
+	return false
+}
+
+func (x *ChatMessage) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+func (x *ChatMessage) GetAst() []*MarkupNode {
+	if x != nil {
+		return x.Ast
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetEmbeds() []*Embed {
+	if x != nil {
+		return x.Embeds
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetComponents() []*ActionRow {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetEphemeral() bool {
+	if x != nil {
+		return x.Ephemeral
+	}
+	return false
+}
+
+func (x *ChatMessage) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetAuthorBlocked() bool {
+	if x != nil {
+		return x.AuthorBlocked
+	}
+	return false
+}
+
+func (x *ChatMessage) GetCrosspost() *CrosspostSource {
+	if x != nil {
+		return x.Crosspost
+	}
+	return nil
+}
+
+// CrosspostSource — откуда скопировано объявление. Названия — на момент публикации.
+type CrosspostSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	GuildName     string                 `protobuf:"bytes,2,opt,name=guild_name,json=guildName,proto3" json:"guild_name,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelName   string                 `protobuf:"bytes,4,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	MessageId     string                 `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Оригинал
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrosspostSource) Reset() {
+	*x = CrosspostSource{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrosspostSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrosspostSource) ProtoMessage() {}
+
+func (x *CrosspostSource) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrosspostSource.ProtoReflect.Descriptor instead.
+func (*CrosspostSource) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CrosspostSource) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *CrosspostSource) GetGuildName() string {
+	if x != nil {
+		return x.GuildName
+	}
+	return ""
+}
+
+func (x *CrosspostSource) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CrosspostSource) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *CrosspostSource) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+// ActionRow — до 5 кнопок или одно меню выбора. В сообщении не больше 5 строк.
+type ActionRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Components    []*Component           `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionRow) Reset() {
+	*x = ActionRow{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionRow) ProtoMessage() {}
+
+func (x *ActionRow) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionRow.ProtoReflect.Descriptor instead.
+func (*ActionRow) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ActionRow) GetComponents() []*Component {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// Component — кнопка или меню выбора.
+type Component struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Type     ComponentType          `protobuf:"varint,1,opt,name=type,proto3,enum=kitsulan.v1.ComponentType" json:"type,omitempty"`
+	CustomId string                 `protobuf:"bytes,2,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"` // До 100 символов, уникален в сообщении; у ссылок пуст
+	Disabled bool                   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Кнопка: нужен label или emoji
+	Label string      `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"` // До 80 символов
+	Style ButtonStyle `protobuf:"varint,5,opt,name=style,proto3,enum=kitsulan.v1.ButtonStyle" json:"style,omitempty"`
+	Url   string      `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"` // Только BUTTON_STYLE_LINK, http(s)
+	Emoji string      `protobuf:"bytes,7,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	// Меню выбора: 1–25 вариантов
+	Placeholder   string          `protobuf:"bytes,8,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	MinValues     int32           `protobuf:"varint,9,opt,name=min_values,json=minValues,proto3" json:"min_values,omitempty"`  // default 1
+	MaxValues     int32           `protobuf:"varint,10,opt,name=max_values,json=maxValues,proto3" json:"max_values,omitempty"` // default 1
+	Options       []*SelectOption `protobuf:"bytes,11,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Component) Reset() {
+	*x = Component{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Component) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Component) ProtoMessage() {}
+
+func (x *Component) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Component.ProtoReflect.Descriptor instead.
+func (*Component) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Component) GetType() ComponentType {
+	if x != nil {
+		return x.Type
+	}
+	return ComponentType_COMPONENT_TYPE_UNSPECIFIED
+}
+
+func (x *Component) GetCustomId() string {
+	if x != nil {
+		return x.CustomId
+	}
+	return ""
+}
+
+func (x *Component) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Component) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Component) GetStyle() ButtonStyle {
+	if x != nil {
+		return x.Style
+	}
+	return ButtonStyle_BUTTON_STYLE_UNSPECIFIED
+}
+
+func (x *Component) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Component) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *Component) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *Component) GetMinValues() int32 {
+	if x != nil {
+		return x.MinValues
+	}
+	return 0
+}
+
+func (x *Component) GetMaxValues() int32 {
+	if x != nil {
+		return x.MaxValues
+	}
+	return 0
+}
+
+func (x *Component) GetOptions() []*SelectOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type SelectOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Уникально в меню
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Default       bool                   `protobuf:"varint,4,opt,name=default,proto3" json:"default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectOption) Reset() {
+	*x = SelectOption{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectOption) ProtoMessage() {}
+
+func (x *SelectOption) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectOption.ProtoReflect.Descriptor instead.
+func (*SelectOption) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SelectOption) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SelectOption) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SelectOption) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SelectOption) GetDefault() bool {
+	if x != nil {
+		return x.Default
+	}
+	return false
+}
+
+// Embed — превью ссылки (OpenGraph/oEmbed), собранное сервером.
+type Embed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // Ссылка из текста сообщения
+	Type          EmbedType              `protobuf:"varint,2,opt,name=type,proto3,enum=kitsulan.v1.EmbedType" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SiteName      string                 `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,6,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	ImageWidth    int32                  `protobuf:"varint,8,opt,name=image_width,json=imageWidth,proto3" json:"image_width,omitempty"`
+	ImageHeight   int32                  `protobuf:"varint,9,opt,name=image_height,json=imageHeight,proto3" json:"image_height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Embed) Reset() {
+	*x = Embed{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Embed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Embed) ProtoMessage() {}
+
+func (x *Embed) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Embed.ProtoReflect.Descriptor instead.
+func (*Embed) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Embed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Embed) GetType() EmbedType {
+	if x != nil {
+		return x.Type
+	}
+	return EmbedType_EMBED_TYPE_UNSPECIFIED
+}
+
+func (x *Embed) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Embed) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Embed) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
+func (x *Embed) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Embed) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Embed) GetImageWidth() int32 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *Embed) GetImageHeight() int32 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+// MarkupNode — узел разобранной разметки. Заполнены только поля для своего type.
+// Текст уже очищен от управляющих символов, url — всегда http(s).
+type MarkupNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MarkupNodeType         `protobuf:"varint,1,opt,name=type,proto3,enum=kitsulan.v1.MarkupNodeType" json:"type,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`          // TEXT, INLINE_CODE, CODE_BLOCK
+	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`          // CODE_BLOCK
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`            // LINK
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`              // USER_MENTION, ROLE_MENTION, CUSTOM_EMOJI
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`          // CUSTOM_EMOJI
+	Animated      bool                   `protobuf:"varint,7,opt,name=animated,proto3" json:"animated,omitempty"` // CUSTOM_EMOJI
+	Children      []*MarkupNode          `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`  // BOLD, ITALIC, SPOILER, QUOTE, LINK
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkupNode) Reset() {
+	*x = MarkupNode{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkupNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkupNode) ProtoMessage() {}
+
+func (x *MarkupNode) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkupNode.ProtoReflect.Descriptor instead.
+func (*MarkupNode) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MarkupNode) GetType() MarkupNodeType {
+	if x != nil {
+		return x.Type
+	}
+	return MarkupNodeType_MARKUP_NODE_TYPE_UNSPECIFIED
+}
+
+func (x *MarkupNode) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *MarkupNode) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *MarkupNode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MarkupNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MarkupNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MarkupNode) GetAnimated() bool {
+	if x != nil {
+		return x.Animated
+	}
+	return false
+}
+
+func (x *MarkupNode) GetChildren() []*MarkupNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// MessageReference — компактное превью цитируемого сообщения.
+type MessageReference struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	AuthorId       string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorUsername string                 `protobuf:"bytes,3,opt,name=author_username,json=authorUsername,proto3" json:"author_username,omitempty"`
+	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`  // Обрезан до 100 символов
+	Deleted        bool                   `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"` // Оригинал удалён: остальные поля пустые
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MessageReference) Reset() {
+	*x = MessageReference{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReference) ProtoMessage() {}
+
+func (x *MessageReference) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReference.ProtoReflect.Descriptor instead.
+func (*MessageReference) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MessageReference) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageReference) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *MessageReference) GetAuthorUsername() string {
+	if x != nil {
+		return x.AuthorUsername
+	}
+	return ""
+}
+
+func (x *MessageReference) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageReference) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ReactionSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Me            bool                   `protobuf:"varint,3,opt,name=me,proto3" json:"me,omitempty"` // Текущий пользователь поставил эту реакцию
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionSummary) Reset() {
+	*x = ReactionSummary{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionSummary) ProtoMessage() {}
+
+func (x *ReactionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionSummary.ProtoReflect.Descriptor instead.
+func (*ReactionSummary) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReactionSummary) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionSummary) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReactionSummary) GetMe() bool {
+	if x != nil {
+		return x.Me
+	}
+	return false
+}
+
+// SystemMessage — структурированное системное событие.
+// Текст клиент собирает сам по type, чтобы его можно было локализовать.
+type SystemMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          SystemMessageType      `protobuf:"varint,1,opt,name=type,proto3,enum=kitsulan.v1.SystemMessageType" json:"type,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                                                          // Кто совершил действие
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`                                                       // Над кем/чем (user_id, message_id), если отличается
+	Params        map[string]string      `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Доп. параметры для шаблона
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemMessage) Reset() {
+	*x = SystemMessage{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemMessage) ProtoMessage() {}
+
+func (x *SystemMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemMessage.ProtoReflect.Descriptor instead.
+func (*SystemMessage) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SystemMessage) GetType() SystemMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return SystemMessageType_SYSTEM_MESSAGE_TYPE_UNSPECIFIED
+}
+
+func (x *SystemMessage) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *SystemMessage) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SystemMessage) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// ChatEvent — конверт для server-streaming событий.
+// Используем oneof чтобы в будущем добавить typing, delete, edit без breaking
+// change.
+type ChatEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ChatEvent_MessageCreated
+	//	*ChatEvent_MessageDeleted
+	//	*ChatEvent_GuildUpdated
+	//	*ChatEvent_MemberUpdated
+	//	*ChatEvent_MessageUpdated
+	//	*ChatEvent_MessagesBulkDeleted
+	//	*ChatEvent_ReactionAdded
+	//	*ChatEvent_ReactionRemoved
+	//	*ChatEvent_ReactionsCleared
+	//	*ChatEvent_ThreadCreated
+	//	*ChatEvent_ThreadUpdated
+	//	*ChatEvent_MessagePinUpdated
+	//	*ChatEvent_TypingStarted
+	//	*ChatEvent_ReadStateUpdated
+	//	*ChatEvent_InteractionCreated
+	//	*ChatEvent_DmChannelUpdated
+	//	*ChatEvent_DmChannelRemoved
+	//	*ChatEvent_RelationshipUpdated
+	//	*ChatEvent_RelationshipRemoved
+	Payload       isChatEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatEvent) Reset() {
+	*x = ChatEvent{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatEvent) ProtoMessage() {}
+
+func (x *ChatEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
+func (*ChatEvent) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ChatEvent) GetPayload() isChatEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessageCreated() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_MessageCreated); ok {
+			return x.MessageCreated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessageDeleted() *MessageDeleted {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_MessageDeleted); ok {
+			return x.MessageDeleted
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetGuildUpdated() *Guild {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_GuildUpdated); ok {
+			return x.GuildUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMemberUpdated() *Member {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_MemberUpdated); ok {
+			return x.MemberUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessageUpdated() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_MessageUpdated); ok {
+			return x.MessageUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessagesBulkDeleted() *MessagesBulkDeleted {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_MessagesBulkDeleted); ok {
+			return x.MessagesBulkDeleted
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReactionAdded() *ReactionEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_ReactionAdded); ok {
+			return x.ReactionAdded
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReactionRemoved() *ReactionEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_ReactionRemoved); ok {
+			return x.ReactionRemoved
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReactionsCleared() *ReactionsCleared {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_ReactionsCleared); ok {
+			return x.ReactionsCleared
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetThreadCreated() *Thread {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_ThreadCreated); ok {
+			return x.ThreadCreated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetThreadUpdated() *Thread {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_ThreadUpdated); ok {
+			return x.ThreadUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetMessagePinUpdated() *MessagePinUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_MessagePinUpdated); ok {
+			return x.MessagePinUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetTypingStarted() *TypingStarted {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_TypingStarted); ok {
+			return x.TypingStarted
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetReadStateUpdated() *ReadStateUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_ReadStateUpdated); ok {
+			return x.ReadStateUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetInteractionCreated() *InteractionCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_InteractionCreated); ok {
+			return x.InteractionCreated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetDmChannelUpdated() *DMChannel {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_DmChannelUpdated); ok {
+			return x.DmChannelUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetDmChannelRemoved() *DMChannelRemoved {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_DmChannelRemoved); ok {
+			return x.DmChannelRemoved
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetRelationshipUpdated() *Relationship {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_RelationshipUpdated); ok {
+			return x.RelationshipUpdated
+		}
+	}
+	return nil
+}
+
+func (x *ChatEvent) GetRelationshipRemoved() *RelationshipRemoved {
+	if x != nil {
+		if x, ok := x.Payload.(*ChatEvent_RelationshipRemoved); ok {
+			return x.RelationshipRemoved
+		}
+	}
+	return nil
+}
+
+type isChatEvent_Payload interface {
+	isChatEvent_Payload()
+}
+
+type ChatEvent_MessageCreated struct {
+	MessageCreated *ChatMessage `protobuf:"bytes,1,opt,name=message_created,json=messageCreated,proto3,oneof"` // В личных каналах — ещё и участникам, в SubscribeUserEvents
+}
+
+type ChatEvent_MessageDeleted struct {
+	MessageDeleted *MessageDeleted `protobuf:"bytes,2,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+}
+
+type ChatEvent_GuildUpdated struct {
+	GuildUpdated *Guild `protobuf:"bytes,3,opt,name=guild_updated,json=guildUpdated,proto3,oneof"`
+}
+
+type ChatEvent_MemberUpdated struct {
+	MemberUpdated *Member `protobuf:"bytes,4,opt,name=member_updated,json=memberUpdated,proto3,oneof"`
+}
+
+type ChatEvent_MessageUpdated struct {
+	MessageUpdated *ChatMessage `protobuf:"bytes,5,opt,name=message_updated,json=messageUpdated,proto3,oneof"`
+}
+
+type ChatEvent_MessagesBulkDeleted struct {
+	MessagesBulkDeleted *MessagesBulkDeleted `protobuf:"bytes,6,opt,name=messages_bulk_deleted,json=messagesBulkDeleted,proto3,oneof"`
+}
+
+type ChatEvent_ReactionAdded struct {
+	ReactionAdded *ReactionEvent `protobuf:"bytes,7,opt,name=reaction_added,json=reactionAdded,proto3,oneof"`
+}
+
+type ChatEvent_ReactionRemoved struct {
+	ReactionRemoved *ReactionEvent `protobuf:"bytes,8,opt,name=reaction_removed,json=reactionRemoved,proto3,oneof"`
+}
+
+type ChatEvent_ReactionsCleared struct {
+	ReactionsCleared *ReactionsCleared `protobuf:"bytes,9,opt,name=reactions_cleared,json=reactionsCleared,proto3,oneof"`
+}
+
+type ChatEvent_ThreadCreated struct {
+	ThreadCreated *Thread `protobuf:"bytes,10,opt,name=thread_created,json=threadCreated,proto3,oneof"` // В родительский канал
+}
+
+type ChatEvent_ThreadUpdated struct {
+	ThreadUpdated *Thread `protobuf:"bytes,11,opt,name=thread_updated,json=threadUpdated,proto3,oneof"` // В родительский канал и в саму ветку
+}
+
+type ChatEvent_MessagePinUpdated struct {
+	MessagePinUpdated *MessagePinUpdated `protobuf:"bytes,12,opt,name=message_pin_updated,json=messagePinUpdated,proto3,oneof"`
+}
+
+type ChatEvent_TypingStarted struct {
+	TypingStarted *TypingStarted `protobuf:"bytes,13,opt,name=typing_started,json=typingStarted,proto3,oneof"` // Автору события не отправляется
+}
+
+type ChatEvent_ReadStateUpdated struct {
+	ReadStateUpdated *ReadStateUpdated `protobuf:"bytes,14,opt,name=read_state_updated,json=readStateUpdated,proto3,oneof"` // Только в SubscribeUserEvents
+}
+
+type ChatEvent_InteractionCreated struct {
+	InteractionCreated *InteractionCreated `protobuf:"bytes,15,opt,name=interaction_created,json=interactionCreated,proto3,oneof"` // Только автору сообщения, в SubscribeUserEvents
+}
+
+type ChatEvent_DmChannelUpdated struct {
+	DmChannelUpdated *DMChannel `protobuf:"bytes,16,opt,name=dm_channel_updated,json=dmChannelUpdated,proto3,oneof"` // Участникам, в SubscribeUserEvents: открыт, переименован, изменён состав
+}
+
+type ChatEvent_DmChannelRemoved struct {
+	DmChannelRemoved *DMChannelRemoved `protobuf:"bytes,17,opt,name=dm_channel_removed,json=dmChannelRemoved,proto3,oneof"` // Ушедшему участнику, в SubscribeUserEvents
+}
+
+type ChatEvent_RelationshipUpdated struct {
+	RelationshipUpdated *Relationship `protobuf:"bytes,18,opt,name=relationship_updated,json=relationshipUpdated,proto3,oneof"` // Обеим сторонам (каждой — её запись), в SubscribeUserEvents
+}
+
+type ChatEvent_RelationshipRemoved struct {
+	RelationshipRemoved *RelationshipRemoved `protobuf:"bytes,19,opt,name=relationship_removed,json=relationshipRemoved,proto3,oneof"` // В SubscribeUserEvents
+}
+
+func (*ChatEvent_MessageCreated) isChatEvent_Payload() {}
+
+func (*ChatEvent_MessageDeleted) isChatEvent_Payload() {}
+
+func (*ChatEvent_GuildUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_MemberUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_MessageUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_MessagesBulkDeleted) isChatEvent_Payload() {}
+
+func (*ChatEvent_ReactionAdded) isChatEvent_Payload() {}
+
+func (*ChatEvent_ReactionRemoved) isChatEvent_Payload() {}
+
+func (*ChatEvent_ReactionsCleared) isChatEvent_Payload() {}
+
+func (*ChatEvent_ThreadCreated) isChatEvent_Payload() {}
+
+func (*ChatEvent_ThreadUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_MessagePinUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_TypingStarted) isChatEvent_Payload() {}
+
+func (*ChatEvent_ReadStateUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_InteractionCreated) isChatEvent_Payload() {}
+
+func (*ChatEvent_DmChannelUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_DmChannelRemoved) isChatEvent_Payload() {}
+
+func (*ChatEvent_RelationshipUpdated) isChatEvent_Payload() {}
+
+func (*ChatEvent_RelationshipRemoved) isChatEvent_Payload() {}
+
+// InteractionCreated — пользователь нажал компонент сообщения бота.
+// Бот отвечает RespondToInteraction с interaction_id и token до expires_at.
+type InteractionCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InteractionId string                 `protobuf:"bytes,1,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	GuildId       string                 `protobuf:"bytes,5,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Кто нажал
+	CustomId      string                 `protobuf:"bytes,7,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
+	Values        []string               `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty"` // Выбранные значения меню
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractionCreated) Reset() {
+	*x = InteractionCreated{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractionCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractionCreated) ProtoMessage() {}
+
+func (x *InteractionCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractionCreated.ProtoReflect.Descriptor instead.
+func (*InteractionCreated) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InteractionCreated) GetInteractionId() string {
+	if x != nil {
+		return x.InteractionId
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetCustomId() string {
+	if x != nil {
+		return x.CustomId
+	}
+	return ""
+}
+
+func (x *InteractionCreated) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *InteractionCreated) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// TypingStarted — индикатор набора. Клиент гасит его по expires_at
+// или при получении сообщения от этого пользователя.
+type TypingStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypingStarted) Reset() {
+	*x = TypingStarted{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypingStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingStarted) ProtoMessage() {}
+
+func (x *TypingStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingStarted.ProtoReflect.Descriptor instead.
+func (*TypingStarted) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *TypingStarted) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *TypingStarted) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TypingStarted) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type MessagePinUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // Кто закрепил/открепил
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessagePinUpdated) Reset() {
+	*x = MessagePinUpdated{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagePinUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePinUpdated) ProtoMessage() {}
+
+func (x *MessagePinUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePinUpdated.ProtoReflect.Descriptor instead.
+func (*MessagePinUpdated) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *MessagePinUpdated) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessagePinUpdated) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *MessagePinUpdated) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *MessagePinUpdated) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ReactionEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,4,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionEvent) Reset() {
+	*x = ReactionEvent{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionEvent) ProtoMessage() {}
+
+func (x *ReactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionEvent.ProtoReflect.Descriptor instead.
+func (*ReactionEvent) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReactionEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ReactionEvent) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReactionEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReactionEvent) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type ReactionsCleared struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"` // Пусто — сняты все реакции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionsCleared) Reset() {
+	*x = ReactionsCleared{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionsCleared) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionsCleared) ProtoMessage() {}
+
+func (x *ReactionsCleared) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionsCleared.ProtoReflect.Descriptor instead.
+func (*ReactionsCleared) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReactionsCleared) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ReactionsCleared) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReactionsCleared) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type MessageDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // Не совпадает с автором, если удалил модератор
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageDeleted) Reset() {
+	*x = MessageDeleted{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageDeleted) ProtoMessage() {}
+
+func (x *MessageDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageDeleted.ProtoReflect.Descriptor instead.
+func (*MessageDeleted) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MessageDeleted) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageDeleted) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *MessageDeleted) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+// MessagesBulkDeleted — одно событие на всю пачку вместо N message_deleted.
+type MessagesBulkDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageIds    []string               `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessagesBulkDeleted) Reset() {
+	*x = MessagesBulkDeleted{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessagesBulkDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesBulkDeleted) ProtoMessage() {}
+
+func (x *MessagesBulkDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesBulkDeleted.ProtoReflect.Descriptor instead.
+func (*MessagesBulkDeleted) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MessagesBulkDeleted) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *MessagesBulkDeleted) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *MessagesBulkDeleted) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+// MessageEdit — предыдущая редакция сообщения.
+type MessageEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	EditorId      string                 `protobuf:"bytes,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                             // Текст до правки
+	EditVersion   uint32                 `protobuf:"varint,5,opt,name=edit_version,json=editVersion,proto3" json:"edit_version,omitempty"` // edit_version сообщения до правки
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageEdit) Reset() {
+	*x = MessageEdit{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEdit) ProtoMessage() {}
+
+func (x *MessageEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEdit.ProtoReflect.Descriptor instead.
+func (*MessageEdit) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MessageEdit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MessageEdit) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *MessageEdit) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
+	}
+	return ""
+}
+
+func (x *MessageEdit) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *MessageEdit) GetEditVersion() uint32 {
+	if x != nil {
+		return x.EditVersion
+	}
+	return 0
+}
+
+func (x *MessageEdit) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type SendMessageRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId        string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ReplyToMessageId string                 `protobuf:"bytes,3,opt,name=reply_to_message_id,json=replyToMessageId,proto3" json:"reply_to_message_id,omitempty"` // Ответ на сообщение из этого же канала
+	// Текст размечен Markdown: сервер проверит его и вернёт ast.
+	// Лимит 4000 символов считается по видимому тексту, без разметки.
+	Markdown   bool         `protobuf:"varint,4,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	Components []*ActionRow `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+	// Ключ идемпотентности (до 64 символов), генерирует клиент. Повтор с тем же
+	// nonce в течение 10 минут вернёт уже созданное сообщение, а не дубликат.
+	Nonce         string `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SendMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetReplyToMessageId() string {
+	if x != nil {
+		return x.ReplyToMessageId
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+func (x *SendMessageRequest) GetComponents() []*ActionRow {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type SendMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SendMessageResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+// Курсоры взаимоисключающие; без курсора — последние сообщения канала.
+type GetHistoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId       string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                             // max 100, default 50
+	BeforeMessageId string                 `protobuf:"bytes,3,opt,name=before_message_id,json=beforeMessageId,proto3" json:"before_message_id,omitempty"` // сообщения старше указанного
+	BeforeSeq       int64                  `protobuf:"varint,4,opt,name=before_seq,json=beforeSeq,proto3" json:"before_seq,omitempty"`                    // сообщения с seq меньше
+	AfterSeq        int64                  `protobuf:"varint,5,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`                       // сообщения с seq больше
+	AroundMessageId string                 `protobuf:"bytes,6,opt,name=around_message_id,json=aroundMessageId,proto3" json:"around_message_id,omitempty"` // окно вокруг сообщения, включая его
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetHistoryRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetBeforeMessageId() string {
+	if x != nil {
+		return x.BeforeMessageId
+	}
+	return ""
+}
+
+func (x *GetHistoryRequest) GetBeforeSeq() int64 {
+	if x != nil {
+		return x.BeforeSeq
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetAfterSeq() int64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetAroundMessageId() string {
+	if x != nil {
+		return x.AroundMessageId
+	}
+	return ""
+}
+
+type GetHistoryResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Messages     []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`                                // от старых к новым
+	HasMore      bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                  // есть сообщения старше (не заполняется для after_seq)
+	HasMoreAfter bool                   `protobuf:"varint,3,opt,name=has_more_after,json=hasMoreAfter,proto3" json:"has_more_after,omitempty"` // есть сообщения новее (только after_seq и around_message_id)
+	// Курсор для SyncChannel: правки и удаления после него страница не отражает
+	SyncSeq       int64 `protobuf:"varint,4,opt,name=sync_seq,json=syncSeq,proto3" json:"sync_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetHistoryResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *GetHistoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetHistoryResponse) GetHasMoreAfter() bool {
+	if x != nil {
+		return x.HasMoreAfter
+	}
+	return false
+}
+
+func (x *GetHistoryResponse) GetSyncSeq() int64 {
+	if x != nil {
+		return x.SyncSeq
+	}
+	return 0
+}
+
+// sync_seq не совпадает с seq сообщений: он растёт при каждом создании,
+// правке и удалении. Реакции его не двигают — они приходят только событиями.
+type SyncChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	SyncSeq       int64                  `protobuf:"varint,2,opt,name=sync_seq,json=syncSeq,proto3" json:"sync_seq,omitempty"` // из GetHistoryResponse или прошлого SyncChannelResponse
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                    // max 500, default 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncChannelRequest) Reset() {
+	*x = SyncChannelRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChannelRequest) ProtoMessage() {}
+
+func (x *SyncChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChannelRequest.ProtoReflect.Descriptor instead.
+func (*SyncChannelRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SyncChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SyncChannelRequest) GetSyncSeq() int64 {
+	if x != nil {
+		return x.SyncSeq
+	}
+	return 0
+}
+
+func (x *SyncChannelRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SyncChannelResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Messages          []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // новые и изменённые, актуальная версия
+	DeletedMessageIds []string               `protobuf:"bytes,2,rep,name=deleted_message_ids,json=deletedMessageIds,proto3" json:"deleted_message_ids,omitempty"`
+	SyncSeq           int64                  `protobuf:"varint,3,opt,name=sync_seq,json=syncSeq,proto3" json:"sync_seq,omitempty"` // передать в следующий SyncChannel
+	HasMore           bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"` // изменений больше limit — повторить с sync_seq
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SyncChannelResponse) Reset() {
+	*x = SyncChannelResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChannelResponse) ProtoMessage() {}
+
+func (x *SyncChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChannelResponse.ProtoReflect.Descriptor instead.
+func (*SyncChannelResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SyncChannelResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SyncChannelResponse) GetDeletedMessageIds() []string {
+	if x != nil {
+		return x.DeletedMessageIds
+	}
+	return nil
+}
+
+func (x *SyncChannelResponse) GetSyncSeq() int64 {
+	if x != nil {
+		return x.SyncSeq
+	}
+	return 0
+}
+
+func (x *SyncChannelResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type SubscribeChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeChannelRequest) Reset() {
+	*x = SubscribeChannelRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChannelRequest) ProtoMessage() {}
+
+func (x *SubscribeChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChannelRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SubscribeChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type EditMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageRequest) Reset() {
+	*x = EditMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageRequest) ProtoMessage() {}
+
+func (x *EditMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageRequest.ProtoReflect.Descriptor instead.
+func (*EditMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EditMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EditMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type EditMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditMessageResponse) Reset() {
+	*x = EditMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessageResponse) ProtoMessage() {}
+
+func (x *EditMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessageResponse.ProtoReflect.Descriptor instead.
+func (*EditMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *EditMessageResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ListMessageEditsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageEditsRequest) Reset() {
+	*x = ListMessageEditsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageEditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageEditsRequest) ProtoMessage() {}
+
+func (x *ListMessageEditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageEditsRequest.ProtoReflect.Descriptor instead.
+func (*ListMessageEditsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListMessageEditsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type ListMessageEditsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edits         []*MessageEdit         `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageEditsResponse) Reset() {
+	*x = ListMessageEditsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageEditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageEditsResponse) ProtoMessage() {}
+
+func (x *ListMessageEditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageEditsResponse.ProtoReflect.Descriptor instead.
+func (*ListMessageEditsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListMessageEditsResponse) GetEdits() []*MessageEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+type DeleteMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Причина модерации, до 255 символов
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageRequest) ProtoMessage() {}
+
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DeleteMessageRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMessageResponse) Reset() {
+	*x = DeleteMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessageResponse) ProtoMessage() {}
+
+func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+// Либо явный список message_ids, либо фильтры (можно комбинировать).
+// Хотя бы одно условие обязательно — случайно очистить весь канал нельзя.
+type BulkDeleteMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	MessageIds    []string               `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	After         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	LastN         int32                  `protobuf:"varint,6,opt,name=last_n,json=lastN,proto3" json:"last_n,omitempty"` // Только последние N подходящих сообщений
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteMessagesRequest) Reset() {
+	*x = BulkDeleteMessagesRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteMessagesRequest) ProtoMessage() {}
+
+func (x *BulkDeleteMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteMessagesRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BulkDeleteMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BulkDeleteMessagesRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *BulkDeleteMessagesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *BulkDeleteMessagesRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *BulkDeleteMessagesRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *BulkDeleteMessagesRequest) GetLastN() int32 {
+	if x != nil {
+		return x.LastN
+	}
+	return 0
+}
+
+func (x *BulkDeleteMessagesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BulkDeleteMessagesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DeletedMessageIds []string               `protobuf:"bytes,1,rep,name=deleted_message_ids,json=deletedMessageIds,proto3" json:"deleted_message_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BulkDeleteMessagesResponse) Reset() {
+	*x = BulkDeleteMessagesResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteMessagesResponse) ProtoMessage() {}
+
+func (x *BulkDeleteMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteMessagesResponse.ProtoReflect.Descriptor instead.
+func (*BulkDeleteMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BulkDeleteMessagesResponse) GetDeletedMessageIds() []string {
+	if x != nil {
+		return x.DeletedMessageIds
+	}
+	return nil
+}
+
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"` // Unicode эмодзи или ID кастомного
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AddReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{84}
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Пусто — своя реакция
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RemoveReactionRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+type RemoveAllReactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"` // Пусто — все реакции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAllReactionsRequest) Reset() {
+	*x = RemoveAllReactionsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAllReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAllReactionsRequest) ProtoMessage() {}
+
+func (x *RemoveAllReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAllReactionsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAllReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RemoveAllReactionsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RemoveAllReactionsRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveAllReactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAllReactionsResponse) Reset() {
+	*x = RemoveAllReactionsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAllReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAllReactionsResponse) ProtoMessage() {}
+
+func (x *RemoveAllReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAllReactionsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAllReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{88}
+}
+
+type ListReactorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                 // max 100, default 25
+	AfterUserId   string                 `protobuf:"bytes,4,opt,name=after_user_id,json=afterUserId,proto3" json:"after_user_id,omitempty"` // курсор пагинации
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsRequest) Reset() {
+	*x = ListReactorsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsRequest) ProtoMessage() {}
+
+func (x *ListReactorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsRequest.ProtoReflect.Descriptor instead.
+func (*ListReactorsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListReactorsRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ListReactorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReactorsRequest) GetAfterUserId() string {
+	if x != nil {
+		return x.AfterUserId
+	}
+	return ""
+}
+
+type ListReactorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReactorsResponse) Reset() {
+	*x = ListReactorsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReactorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReactorsResponse) ProtoMessage() {}
+
+func (x *ListReactorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReactorsResponse.ProtoReflect.Descriptor instead.
+func (*ListReactorsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListReactorsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type PinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageResponse) Reset() {
+	*x = PinMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageResponse) ProtoMessage() {}
+
+func (x *PinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageResponse.ProtoReflect.Descriptor instead.
+func (*PinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{92}
+}
+
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UnpinMessageRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type UnpinMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageResponse) Reset() {
+	*x = UnpinMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageResponse) ProtoMessage() {}
+
+func (x *UnpinMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageResponse.ProtoReflect.Descriptor instead.
+func (*UnpinMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{94}
+}
+
+type ListPinnedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesRequest) Reset() {
+	*x = ListPinnedMessagesRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesRequest) ProtoMessage() {}
+
+func (x *ListPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListPinnedMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListPinnedMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesResponse) Reset() {
+	*x = ListPinnedMessagesResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesResponse) ProtoMessage() {}
+
+func (x *ListPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListPinnedMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SendTypingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTypingRequest) Reset() {
+	*x = SendTypingRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTypingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTypingRequest) ProtoMessage() {}
+
+func (x *SendTypingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTypingRequest.ProtoReflect.Descriptor instead.
+func (*SendTypingRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SendTypingRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type SendTypingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTypingResponse) Reset() {
+	*x = SendTypingResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTypingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTypingResponse) ProtoMessage() {}
+
+func (x *SendTypingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTypingResponse.ProtoReflect.Descriptor instead.
+func (*SendTypingResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{98}
+}
+
+type AckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Seq           int64                  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"` // 0 — последнее сообщение канала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckRequest) Reset() {
+	*x = AckRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckRequest) ProtoMessage() {}
+
+func (x *AckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckRequest.ProtoReflect.Descriptor instead.
+func (*AckRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AckRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *AckRequest) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type AckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastReadSeq   int64                  `protobuf:"varint,1,opt,name=last_read_seq,json=lastReadSeq,proto3" json:"last_read_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckResponse) Reset() {
+	*x = AckResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckResponse) ProtoMessage() {}
+
+func (x *AckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckResponse.ProtoReflect.Descriptor instead.
+func (*AckResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AckResponse) GetLastReadSeq() int64 {
+	if x != nil {
+		return x.LastReadSeq
+	}
+	return 0
+}
+
+type GetUnreadSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadSummaryRequest) Reset() {
+	*x = GetUnreadSummaryRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadSummaryRequest) ProtoMessage() {}
+
+func (x *GetUnreadSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{101}
+}
+
+type GetUnreadSummaryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только каналы с непрочитанным
+	Channels      []*ChannelUnread `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Guilds        []*GuildUnread   `protobuf:"bytes,2,rep,name=guilds,proto3" json:"guilds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadSummaryResponse) Reset() {
+	*x = GetUnreadSummaryResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadSummaryResponse) ProtoMessage() {}
+
+func (x *GetUnreadSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetUnreadSummaryResponse) GetChannels() []*ChannelUnread {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *GetUnreadSummaryResponse) GetGuilds() []*GuildUnread {
+	if x != nil {
+		return x.Guilds
+	}
+	return nil
+}
+
+type ChannelUnread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	GuildId       string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // Пусто у личных каналов
+	LastReadSeq   int64                  `protobuf:"varint,3,opt,name=last_read_seq,json=lastReadSeq,proto3" json:"last_read_seq,omitempty"`
+	LastSeq       int64                  `protobuf:"varint,4,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,5,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount  int64                  `protobuf:"varint,6,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelUnread) Reset() {
+	*x = ChannelUnread{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelUnread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelUnread) ProtoMessage() {}
+
+func (x *ChannelUnread) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelUnread.ProtoReflect.Descriptor instead.
+func (*ChannelUnread) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ChannelUnread) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelUnread) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *ChannelUnread) GetLastReadSeq() int64 {
+	if x != nil {
+		return x.LastReadSeq
+	}
+	return 0
+}
+
+func (x *ChannelUnread) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *ChannelUnread) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *ChannelUnread) GetMentionCount() int64 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+type GuildUnread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	UnreadCount   int64                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	MentionCount  int64                  `protobuf:"varint,3,opt,name=mention_count,json=mentionCount,proto3" json:"mention_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuildUnread) Reset() {
+	*x = GuildUnread{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuildUnread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuildUnread) ProtoMessage() {}
+
+func (x *GuildUnread) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuildUnread.ProtoReflect.Descriptor instead.
+func (*GuildUnread) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GuildUnread) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *GuildUnread) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GuildUnread) GetMentionCount() int64 {
+	if x != nil {
+		return x.MentionCount
+	}
+	return 0
+}
+
+type SubscribeUserEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeUserEventsRequest) Reset() {
+	*x = SubscribeUserEventsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeUserEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{105}
+}
+
+type ListRecentMentionsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Limit   int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                   // По умолчанию 25, максимум 100
+	GuildId string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"` // Пусто — все гильдии
+	// Курсор: created_at последнего полученного сообщения
+	Before        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecentMentionsRequest) Reset() {
+	*x = ListRecentMentionsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentMentionsRequest) ProtoMessage() {}
+
+func (x *ListRecentMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecentMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListRecentMentionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRecentMentionsRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *ListRecentMentionsRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListRecentMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecentMentionsResponse) Reset() {
+	*x = ListRecentMentionsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecentMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecentMentionsResponse) ProtoMessage() {}
+
+func (x *ListRecentMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecentMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecentMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListRecentMentionsResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type SearchMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`       // Нужен guild_id или channel_id
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // Сужает поиск до канала и его веток
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                          // Слова через пробел; пусто — только фильтры
+	AuthorId      string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	After         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	HasAttachment bool                   `protobuf:"varint,7,opt,name=has_attachment,json=hasAttachment,proto3" json:"has_attachment,omitempty"`
+	HasLink       bool                   `protobuf:"varint,8,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	Pinned        bool                   `protobuf:"varint,9,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	MentionsMe    bool                   `protobuf:"varint,10,opt,name=mentions_me,json=mentionsMe,proto3" json:"mentions_me,omitempty"`
+	Limit         int32                  `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`   // По умолчанию 25, максимум 100
+	Offset        int32                  `protobuf:"varint,12,opt,name=offset,proto3" json:"offset,omitempty"` // Максимум 5000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SearchMessagesRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetHasAttachment() bool {
+	if x != nil {
+		return x.HasAttachment
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetHasLink() bool {
+	if x != nil {
+		return x.HasLink
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetMentionsMe() bool {
+	if x != nil {
+		return x.MentionsMe
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"` // Новые сверху
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SearchMessagesResponse) GetMessages() []*ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type InteractWithComponentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	CustomId      string                 `protobuf:"bytes,2,opt,name=custom_id,json=customId,proto3" json:"custom_id,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"` // Для меню выбора
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractWithComponentRequest) Reset() {
+	*x = InteractWithComponentRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractWithComponentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractWithComponentRequest) ProtoMessage() {}
+
+func (x *InteractWithComponentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractWithComponentRequest.ProtoReflect.Descriptor instead.
+func (*InteractWithComponentRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *InteractWithComponentRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *InteractWithComponentRequest) GetCustomId() string {
+	if x != nil {
+		return x.CustomId
+	}
+	return ""
+}
+
+func (x *InteractWithComponentRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type InteractWithComponentResponse struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Type  InteractionResponseType `protobuf:"varint,1,opt,name=type,proto3,enum=kitsulan.v1.InteractionResponseType" json:"type,omitempty"`
+	// EPHEMERAL_REPLY — ephemeral-сообщение бота (оно же приходит в стримы канала
+	// нажавшего, клиент сводит их по id); UPDATE_MESSAGE — сообщение после обновления
+	Message       *ChatMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractWithComponentResponse) Reset() {
+	*x = InteractWithComponentResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractWithComponentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractWithComponentResponse) ProtoMessage() {}
+
+func (x *InteractWithComponentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractWithComponentResponse.ProtoReflect.Descriptor instead.
+func (*InteractWithComponentResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *InteractWithComponentResponse) GetType() InteractionResponseType {
+	if x != nil {
+		return x.Type
+	}
+	return InteractionResponseType_INTERACTION_RESPONSE_TYPE_UNSPECIFIED
+}
+
+func (x *InteractWithComponentResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type RespondToInteractionRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	InteractionId string                  `protobuf:"bytes,1,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+	Token         string                  `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Type          InteractionResponseType `protobuf:"varint,3,opt,name=type,proto3,enum=kitsulan.v1.InteractionResponseType" json:"type,omitempty"`
+	Content       string                  `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Markdown      bool                    `protobuf:"varint,5,opt,name=markdown,proto3" json:"markdown,omitempty"`    // Только для EPHEMERAL_REPLY; обновление сохраняет тип текста сообщения
+	Components    []*ActionRow            `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"` // UPDATE_MESSAGE: новые компоненты целиком
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToInteractionRequest) Reset() {
+	*x = RespondToInteractionRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToInteractionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToInteractionRequest) ProtoMessage() {}
+
+func (x *RespondToInteractionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToInteractionRequest.ProtoReflect.Descriptor instead.
+func (*RespondToInteractionRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RespondToInteractionRequest) GetInteractionId() string {
+	if x != nil {
+		return x.InteractionId
+	}
+	return ""
+}
+
+func (x *RespondToInteractionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RespondToInteractionRequest) GetType() InteractionResponseType {
+	if x != nil {
+		return x.Type
+	}
+	return InteractionResponseType_INTERACTION_RESPONSE_TYPE_UNSPECIFIED
+}
+
+func (x *RespondToInteractionRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RespondToInteractionRequest) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+func (x *RespondToInteractionRequest) GetComponents() []*ActionRow {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type RespondToInteractionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToInteractionResponse) Reset() {
+	*x = RespondToInteractionResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToInteractionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToInteractionResponse) ProtoMessage() {}
+
+func (x *RespondToInteractionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToInteractionResponse.ProtoReflect.Descriptor instead.
+func (*RespondToInteractionResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{113}
+}
+
+type SendEphemeralMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Кому показать
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Markdown      bool                   `protobuf:"varint,4,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEphemeralMessageRequest) Reset() {
+	*x = SendEphemeralMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEphemeralMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEphemeralMessageRequest) ProtoMessage() {}
+
+func (x *SendEphemeralMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEphemeralMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendEphemeralMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SendEphemeralMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SendEphemeralMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendEphemeralMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SendEphemeralMessageRequest) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+type SendEphemeralMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEphemeralMessageResponse) Reset() {
+	*x = SendEphemeralMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEphemeralMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEphemeralMessageResponse) ProtoMessage() {}
+
+func (x *SendEphemeralMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEphemeralMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendEphemeralMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *SendEphemeralMessageResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ScheduledMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Markdown      bool                   `protobuf:"varint,5,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	Status        ScheduledMessageStatus `protobuf:"varint,7,opt,name=status,proto3,enum=kitsulan.v1.ScheduledMessageStatus" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"` // Только у FAILED
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMessage) ProtoMessage() {}
+
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ScheduledMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+func (x *ScheduledMessage) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetStatus() ScheduledMessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ScheduleMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Markdown      bool                   `protobuf:"varint,3,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"` // В будущем, но не дальше 30 дней
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageRequest) Reset() {
+	*x = ScheduleMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageRequest) ProtoMessage() {}
+
+func (x *ScheduleMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ScheduleMessageRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ScheduleMessageRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ScheduleMessageRequest) GetMarkdown() bool {
+	if x != nil {
+		return x.Markdown
+	}
+	return false
+}
+
+func (x *ScheduleMessageRequest) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+type ScheduleMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageResponse) Reset() {
+	*x = ScheduleMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageResponse) ProtoMessage() {}
+
+func (x *ScheduleMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ScheduleMessageResponse) GetScheduledMessage() *ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessage
+	}
+	return nil
+}
+
+type ListScheduledMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesRequest) Reset() {
+	*x = ListScheduledMessagesRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesRequest) ProtoMessage() {}
+
+func (x *ListScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListScheduledMessagesRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListScheduledMessagesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessages []*ScheduledMessage    `protobuf:"bytes,1,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages,omitempty"` // По send_at
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesResponse) Reset() {
+	*x = ListScheduledMessagesResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesResponse) ProtoMessage() {}
+
+func (x *ListScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListScheduledMessagesResponse) GetScheduledMessages() []*ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessages
+	}
+	return nil
+}
+
+type CancelScheduledMessageRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessageId string                 `protobuf:"bytes,1,opt,name=scheduled_message_id,json=scheduledMessageId,proto3" json:"scheduled_message_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CancelScheduledMessageRequest) GetScheduledMessageId() string {
+	if x != nil {
+		return x.ScheduledMessageId
+	}
+	return ""
+}
+
+type CancelScheduledMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageResponse) Reset() {
+	*x = CancelScheduledMessageResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageResponse) ProtoMessage() {}
+
+func (x *CancelScheduledMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{122}
+}
+
+// ChannelFollow — подписка target-канала на канал объявлений source.
+type ChannelFollow struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SourceChannelId   string                 `protobuf:"bytes,1,opt,name=source_channel_id,json=sourceChannelId,proto3" json:"source_channel_id,omitempty"`
+	SourceChannelName string                 `protobuf:"bytes,2,opt,name=source_channel_name,json=sourceChannelName,proto3" json:"source_channel_name,omitempty"`
+	SourceGuildId     string                 `protobuf:"bytes,3,opt,name=source_guild_id,json=sourceGuildId,proto3" json:"source_guild_id,omitempty"`
+	TargetChannelId   string                 `protobuf:"bytes,4,opt,name=target_channel_id,json=targetChannelId,proto3" json:"target_channel_id,omitempty"`
+	TargetChannelName string                 `protobuf:"bytes,5,opt,name=target_channel_name,json=targetChannelName,proto3" json:"target_channel_name,omitempty"`
+	TargetGuildId     string                 `protobuf:"bytes,6,opt,name=target_guild_id,json=targetGuildId,proto3" json:"target_guild_id,omitempty"`
+	CreatedBy         string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChannelFollow) Reset() {
+	*x = ChannelFollow{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelFollow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelFollow) ProtoMessage() {}
+
+func (x *ChannelFollow) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelFollow.ProtoReflect.Descriptor instead.
+func (*ChannelFollow) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ChannelFollow) GetSourceChannelId() string {
+	if x != nil {
+		return x.SourceChannelId
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetSourceChannelName() string {
+	if x != nil {
+		return x.SourceChannelName
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetSourceGuildId() string {
+	if x != nil {
+		return x.SourceGuildId
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetTargetChannelId() string {
+	if x != nil {
+		return x.TargetChannelId
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetTargetChannelName() string {
+	if x != nil {
+		return x.TargetChannelName
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetTargetGuildId() string {
+	if x != nil {
+		return x.TargetGuildId
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChannelFollow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FollowChannelRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId       string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`                     // Канал объявлений
+	TargetChannelId string                 `protobuf:"bytes,2,opt,name=target_channel_id,json=targetChannelId,proto3" json:"target_channel_id,omitempty"` // Куда копировать: текстовый канал или канал объявлений
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FollowChannelRequest) Reset() {
+	*x = FollowChannelRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowChannelRequest) ProtoMessage() {}
+
+func (x *FollowChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowChannelRequest.ProtoReflect.Descriptor instead.
+func (*FollowChannelRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *FollowChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *FollowChannelRequest) GetTargetChannelId() string {
+	if x != nil {
+		return x.TargetChannelId
+	}
+	return ""
+}
+
+type FollowChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Follow        *ChannelFollow         `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowChannelResponse) Reset() {
+	*x = FollowChannelResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowChannelResponse) ProtoMessage() {}
+
+func (x *FollowChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowChannelResponse.ProtoReflect.Descriptor instead.
+func (*FollowChannelResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *FollowChannelResponse) GetFollow() *ChannelFollow {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+type UnfollowChannelRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId       string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TargetChannelId string                 `protobuf:"bytes,2,opt,name=target_channel_id,json=targetChannelId,proto3" json:"target_channel_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnfollowChannelRequest) Reset() {
+	*x = UnfollowChannelRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowChannelRequest) ProtoMessage() {}
+
+func (x *UnfollowChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowChannelRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowChannelRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UnfollowChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *UnfollowChannelRequest) GetTargetChannelId() string {
+	if x != nil {
+		return x.TargetChannelId
+	}
+	return ""
+}
+
+type UnfollowChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowChannelResponse) Reset() {
+	*x = UnfollowChannelResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowChannelResponse) ProtoMessage() {}
+
+func (x *UnfollowChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowChannelResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowChannelResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{127}
+}
+
+type ListChannelFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelFollowsRequest) Reset() {
+	*x = ListChannelFollowsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelFollowsRequest) ProtoMessage() {}
+
+func (x *ListChannelFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelFollowsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListChannelFollowsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListChannelFollowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Following     []*ChannelFollow       `protobuf:"bytes,1,rep,name=following,proto3" json:"following,omitempty"` // Источники, на которые подписан канал
+	Followers     []*ChannelFollow       `protobuf:"bytes,2,rep,name=followers,proto3" json:"followers,omitempty"` // Подписчики канала объявлений
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelFollowsResponse) Reset() {
+	*x = ListChannelFollowsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelFollowsResponse) ProtoMessage() {}
+
+func (x *ListChannelFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelFollowsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListChannelFollowsResponse) GetFollowing() []*ChannelFollow {
+	if x != nil {
+		return x.Following
+	}
+	return nil
+}
+
+func (x *ListChannelFollowsResponse) GetFollowers() []*ChannelFollow {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+// ReadStateUpdated — позиция чтения изменилась (с этого или другого устройства).
+type ReadStateUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	LastReadSeq   int64                  `protobuf:"varint,2,opt,name=last_read_seq,json=lastReadSeq,proto3" json:"last_read_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadStateUpdated) Reset() {
+	*x = ReadStateUpdated{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadStateUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStateUpdated) ProtoMessage() {}
+
+func (x *ReadStateUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStateUpdated.ProtoReflect.Descriptor instead.
+func (*ReadStateUpdated) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ReadStateUpdated) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ReadStateUpdated) GetLastReadSeq() int64 {
+	if x != nil {
+		return x.LastReadSeq
+	}
+	return 0
+}
+
+type Thread struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GuildId          string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ParentId         string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name             string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	StarterMessageId string                 `protobuf:"bytes,6,opt,name=starter_message_id,json=starterMessageId,proto3" json:"starter_message_id,omitempty"` // Пусто, если ветка создана без сообщения
+	Archived         bool                   `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
+	Locked           bool                   `protobuf:"varint,8,opt,name=locked,proto3" json:"locked,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Thread) Reset() {
+	*x = Thread{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Thread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thread) ProtoMessage() {}
+
+func (x *Thread) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thread.ProtoReflect.Descriptor instead.
+func (*Thread) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Thread) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Thread) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *Thread) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Thread) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Thread) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Thread) GetStarterMessageId() string {
+	if x != nil {
+		return x.StarterMessageId
+	}
+	return ""
+}
+
+func (x *Thread) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Thread) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Thread) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Thread) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type StartThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MessageId     string                 `protobuf:"bytes,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // Опционально: сообщение из channel_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartThreadRequest) Reset() {
+	*x = StartThreadRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartThreadRequest) ProtoMessage() {}
+
+func (x *StartThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartThreadRequest.ProtoReflect.Descriptor instead.
+func (*StartThreadRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *StartThreadRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *StartThreadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartThreadRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type StartThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Thread        *Thread                `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartThreadResponse) Reset() {
+	*x = StartThreadResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartThreadResponse) ProtoMessage() {}
+
+func (x *StartThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartThreadResponse.ProtoReflect.Descriptor instead.
+func (*StartThreadResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StartThreadResponse) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+type JoinThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      string                 `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinThreadRequest) Reset() {
+	*x = JoinThreadRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinThreadRequest) ProtoMessage() {}
+
+func (x *JoinThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinThreadRequest.ProtoReflect.Descriptor instead.
+func (*JoinThreadRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *JoinThreadRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+type JoinThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinThreadResponse) Reset() {
+	*x = JoinThreadResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinThreadResponse) ProtoMessage() {}
+
+func (x *JoinThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinThreadResponse.ProtoReflect.Descriptor instead.
+func (*JoinThreadResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{135}
+}
+
+type LeaveThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      string                 `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveThreadRequest) Reset() {
+	*x = LeaveThreadRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveThreadRequest) ProtoMessage() {}
+
+func (x *LeaveThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveThreadRequest.ProtoReflect.Descriptor instead.
+func (*LeaveThreadRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *LeaveThreadRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+type LeaveThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveThreadResponse) Reset() {
+	*x = LeaveThreadResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveThreadResponse) ProtoMessage() {}
+
+func (x *LeaveThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveThreadResponse.ProtoReflect.Descriptor instead.
+func (*LeaveThreadResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{137}
+}
+
+type UpdateThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      string                 `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Archived      *bool                  `protobuf:"varint,3,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	Locked        *bool                  `protobuf:"varint,4,opt,name=locked,proto3,oneof" json:"locked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateThreadRequest) Reset() {
+	*x = UpdateThreadRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateThreadRequest) ProtoMessage() {}
+
+func (x *UpdateThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateThreadRequest.ProtoReflect.Descriptor instead.
+func (*UpdateThreadRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *UpdateThreadRequest) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *UpdateThreadRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateThreadRequest) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *UpdateThreadRequest) GetLocked() bool {
+	if x != nil && x.Locked != nil {
+		return *x.Locked
+	}
+	return false
+}
+
+type UpdateThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Thread        *Thread                `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateThreadResponse) Reset() {
+	*x = UpdateThreadResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateThreadResponse) ProtoMessage() {}
+
+func (x *UpdateThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateThreadResponse.ProtoReflect.Descriptor instead.
+func (*UpdateThreadResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *UpdateThreadResponse) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+type ListActiveThreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveThreadsRequest) Reset() {
+	*x = ListActiveThreadsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActiveThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveThreadsRequest) ProtoMessage() {}
+
+func (x *ListActiveThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveThreadsRequest.ProtoReflect.Descriptor instead.
+func (*ListActiveThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListActiveThreadsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ListActiveThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveThreadsResponse) Reset() {
+	*x = ListActiveThreadsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActiveThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveThreadsResponse) ProtoMessage() {}
+
+func (x *ListActiveThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveThreadsResponse.ProtoReflect.Descriptor instead.
+func (*ListActiveThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListActiveThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type DMChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Group         bool                   `protobuf:"varint,2,opt,name=group,proto3" json:"group,omitempty"`                    // group_dm; иначе dm на двоих
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                       // Только у group_dm; пусто — показывать участников
+	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`  // Только у group_dm
+	Recipients    []*User                `protobuf:"bytes,5,rep,name=recipients,proto3" json:"recipients,omitempty"`           // Включая вызывающего
+	LastSeq       int64                  `protobuf:"varint,6,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"` // Seq последнего сообщения (0 — сообщений нет)
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DMChannel) Reset() {
+	*x = DMChannel{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DMChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DMChannel) ProtoMessage() {}
+
+func (x *DMChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DMChannel.ProtoReflect.Descriptor instead.
+func (*DMChannel) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DMChannel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DMChannel) GetGroup() bool {
+	if x != nil {
+		return x.Group
+	}
+	return false
+}
+
+func (x *DMChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DMChannel) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *DMChannel) GetRecipients() []*User {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *DMChannel) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *DMChannel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// DMChannelRemoved — пользователь вышел или исключён из личного канала.
+type DMChannelRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DMChannelRemoved) Reset() {
+	*x = DMChannelRemoved{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DMChannelRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DMChannelRemoved) ProtoMessage() {}
+
+func (x *DMChannelRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DMChannelRemoved.ProtoReflect.Descriptor instead.
+func (*DMChannelRemoved) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DMChannelRemoved) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type OpenDMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // Собеседники, без вызывающего
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                      // Опционально, только для группового
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDMRequest) Reset() {
+	*x = OpenDMRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDMRequest) ProtoMessage() {}
+
+func (x *OpenDMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDMRequest.ProtoReflect.Descriptor instead.
+func (*OpenDMRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *OpenDMRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *OpenDMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OpenDMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *DMChannel             `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenDMResponse) Reset() {
+	*x = OpenDMResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenDMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenDMResponse) ProtoMessage() {}
+
+func (x *OpenDMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenDMResponse.ProtoReflect.Descriptor instead.
+func (*OpenDMResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *OpenDMResponse) GetChannel() *DMChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ListMyDMsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyDMsRequest) Reset() {
+	*x = ListMyDMsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDMsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDMsRequest) ProtoMessage() {}
+
+func (x *ListMyDMsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDMsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyDMsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{146}
+}
+
+type ListMyDMsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*DMChannel           `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyDMsResponse) Reset() {
+	*x = ListMyDMsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyDMsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyDMsResponse) ProtoMessage() {}
+
+func (x *ListMyDMsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyDMsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyDMsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListMyDMsResponse) GetChannels() []*DMChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type AddDMRecipientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDMRecipientRequest) Reset() {
+	*x = AddDMRecipientRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDMRecipientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDMRecipientRequest) ProtoMessage() {}
+
+func (x *AddDMRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDMRecipientRequest.ProtoReflect.Descriptor instead.
+func (*AddDMRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *AddDMRecipientRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *AddDMRecipientRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddDMRecipientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *DMChannel             `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDMRecipientResponse) Reset() {
+	*x = AddDMRecipientResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDMRecipientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDMRecipientResponse) ProtoMessage() {}
+
+func (x *AddDMRecipientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDMRecipientResponse.ProtoReflect.Descriptor instead.
+func (*AddDMRecipientResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AddDMRecipientResponse) GetChannel() *DMChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type RemoveDMRecipientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Свой ID — выйти из канала
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDMRecipientRequest) Reset() {
+	*x = RemoveDMRecipientRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDMRecipientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDMRecipientRequest) ProtoMessage() {}
+
+func (x *RemoveDMRecipientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDMRecipientRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDMRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RemoveDMRecipientRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RemoveDMRecipientRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveDMRecipientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDMRecipientResponse) Reset() {
+	*x = RemoveDMRecipientResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDMRecipientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDMRecipientResponse) ProtoMessage() {}
+
+func (x *RemoveDMRecipientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDMRecipientResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDMRecipientResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{151}
+}
+
+type RenameGroupDMRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameGroupDMRequest) Reset() {
+	*x = RenameGroupDMRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameGroupDMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGroupDMRequest) ProtoMessage() {}
+
+func (x *RenameGroupDMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGroupDMRequest.ProtoReflect.Descriptor instead.
+func (*RenameGroupDMRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *RenameGroupDMRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *RenameGroupDMRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameGroupDMResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *DMChannel             `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameGroupDMResponse) Reset() {
+	*x = RenameGroupDMResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameGroupDMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameGroupDMResponse) ProtoMessage() {}
+
+func (x *RenameGroupDMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameGroupDMResponse.ProtoReflect.Descriptor instead.
+func (*RenameGroupDMResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *RenameGroupDMResponse) GetChannel() *DMChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// Relationship — отношение вызывающего к user.
+type Relationship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"` // is_online заполняется только у друзей
+	Type          RelationshipType       `protobuf:"varint,2,opt,name=type,proto3,enum=kitsulan.v1.RelationshipType" json:"type,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *Relationship) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Relationship) GetType() RelationshipType {
+	if x != nil {
+		return x.Type
+	}
+	return RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+}
+
+func (x *Relationship) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// RelationshipRemoved — отношения с пользователем больше нет.
+type RelationshipRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationshipRemoved) Reset() {
+	*x = RelationshipRemoved{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationshipRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationshipRemoved) ProtoMessage() {}
+
+func (x *RelationshipRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationshipRemoved.ProtoReflect.Descriptor instead.
+func (*RelationshipRemoved) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *RelationshipRemoved) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelationshipsRequest) Reset() {
+	*x = ListRelationshipsRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{156}
+}
+
+type ListRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*Relationship        `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRelationshipsResponse) Reset() {
+	*x = ListRelationshipsResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ListRelationshipsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
 	return nil
 }
 
-func (x *ChatEvent) GetMessageDeleted() *MessageDeleted {
+type SendFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestRequest) Reset() {
+	*x = SendFriendRequestRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestRequest) ProtoMessage() {}
+
+func (x *SendFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[158]
 	if x != nil {
-		if x, ok := x.Payload.(*ChatEvent_MessageDeleted); ok {
-			return x.MessageDeleted
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *SendFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SendFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestResponse) Reset() {
+	*x = SendFriendRequestResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestResponse) ProtoMessage() {}
+
+func (x *SendFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *SendFriendRequestResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
 	}
 	return nil
 }
 
-type isChatEvent_Payload interface {
-	isChatEvent_Payload()
+type AcceptFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type ChatEvent_MessageCreated struct {
-	MessageCreated *ChatMessage `protobuf:"bytes,1,opt,name=message_created,json=messageCreated,proto3,oneof"`
+func (x *AcceptFriendRequestRequest) Reset() {
+	*x = AcceptFriendRequestRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestRequest) ProtoMessage() {}
+
+func (x *AcceptFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *AcceptFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AcceptFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequestResponse) Reset() {
+	*x = AcceptFriendRequestResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestResponse) ProtoMessage() {}
+
+func (x *AcceptFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *AcceptFriendRequestResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type DeclineFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineFriendRequestRequest) Reset() {
+	*x = DeclineFriendRequestRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineFriendRequestRequest) ProtoMessage() {}
+
+func (x *DeclineFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclineFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *DeclineFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeclineFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineFriendRequestResponse) Reset() {
+	*x = DeclineFriendRequestResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineFriendRequestResponse) ProtoMessage() {}
+
+func (x *DeclineFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclineFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{163}
+}
+
+type CancelFriendRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFriendRequestRequest) Reset() {
+	*x = CancelFriendRequestRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFriendRequestRequest) ProtoMessage() {}
+
+func (x *CancelFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *CancelFriendRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelFriendRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFriendRequestResponse) Reset() {
+	*x = CancelFriendRequestResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFriendRequestResponse) ProtoMessage() {}
+
+func (x *CancelFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ChatEvent_MessageDeleted struct {
-	MessageDeleted *MessageDeleted `protobuf:"bytes,2,opt,name=message_deleted,json=messageDeleted,proto3,oneof"`
+// Deprecated: Use CancelFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (*ChatEvent_MessageCreated) isChatEvent_Payload() {}
-
-func (*ChatEvent_MessageDeleted) isChatEvent_Payload() {}
-
-type MessageDeleted struct {
+type RemoveFriendRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MessageId     string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MessageDeleted) Reset() {
-	*x = MessageDeleted{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[40]
+func (x *RemoveFriendRequest) Reset() {
+	*x = RemoveFriendRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MessageDeleted) String() string {
+func (x *RemoveFriendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageDeleted) ProtoMessage() {}
+func (*RemoveFriendRequest) ProtoMessage() {}
 
-func (x *MessageDeleted) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[40]
+func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2206,48 +10302,39 @@ func (x *MessageDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageDeleted.ProtoReflect.Descriptor instead.
-func (*MessageDeleted) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *MessageDeleted) GetMessageId() string {
-	if x != nil {
-		return x.MessageId
-	}
-	return ""
+// Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *MessageDeleted) GetChannelId() string {
+func (x *RemoveFriendRequest) GetUserId() string {
 	if x != nil {
-		return x.ChannelId
+		return x.UserId
 	}
 	return ""
 }
 
-type SendMessageRequest struct {
+type RemoveFriendResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[41]
+func (x *RemoveFriendResponse) Reset() {
+	*x = RemoveFriendResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *RemoveFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*RemoveFriendResponse) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[41]
+func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,47 +10345,33 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *SendMessageRequest) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
-
-func (x *SendMessageRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+// Deprecated: Use RemoveFriendResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{167}
 }
 
-type SendMessageResponse struct {
+type BlockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       *ChatMessage           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendMessageResponse) Reset() {
-	*x = SendMessageResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[42]
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendMessageResponse) String() string {
+func (x *BlockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageResponse) ProtoMessage() {}
+func (*BlockUserRequest) ProtoMessage() {}
 
-func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[42]
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,42 +10382,40 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendMessageResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *SendMessageResponse) GetMessage() *ChatMessage {
+func (x *BlockUserRequest) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetHistoryRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId       string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	Limit           int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                             // max 100, default 50
-	BeforeMessageId string                 `protobuf:"bytes,3,opt,name=before_message_id,json=beforeMessageId,proto3" json:"before_message_id,omitempty"` // курсор пагинации (пустой = с конца)
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationship  *Relationship          `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryRequest) Reset() {
-	*x = GetHistoryRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[43]
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryRequest) String() string {
+func (x *BlockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryRequest) ProtoMessage() {}
+func (*BlockUserResponse) ProtoMessage() {}
 
-func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[43]
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2355,55 +10426,40 @@ func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *GetHistoryRequest) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
-
-func (x *GetHistoryRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *GetHistoryRequest) GetBeforeMessageId() string {
+func (x *BlockUserResponse) GetRelationship() *Relationship {
 	if x != nil {
-		return x.BeforeMessageId
+		return x.Relationship
 	}
-	return ""
+	return nil
 }
 
-type GetHistoryResponse struct {
+type UnblockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Messages      []*ChatMessage         `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryResponse) Reset() {
-	*x = GetHistoryResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[44]
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryResponse) String() string {
+func (x *UnblockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryResponse) ProtoMessage() {}
+func (*UnblockUserRequest) ProtoMessage() {}
 
-func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[44]
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,47 +10470,39 @@ func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *GetHistoryResponse) GetMessages() []*ChatMessage {
-	if x != nil {
-		return x.Messages
-	}
-	return nil
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *GetHistoryResponse) GetHasMore() bool {
+func (x *UnblockUserRequest) GetUserId() string {
 	if x != nil {
-		return x.HasMore
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-type SubscribeChannelRequest struct {
+type UnblockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeChannelRequest) Reset() {
-	*x = SubscribeChannelRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[45]
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_kitsulan_v1_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeChannelRequest) String() string {
+func (x *UnblockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeChannelRequest) ProtoMessage() {}
+func (*UnblockUserResponse) ProtoMessage() {}
 
-func (x *SubscribeChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[45]
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kitsulan_v1_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2465,16 +10513,9 @@ func (x *SubscribeChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeChannelRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeChannelRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *SubscribeChannelRequest) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{171}
 }
 
 type SetupRealmRequest struct {
@@ -2487,7 +10528,7 @@ type SetupRealmRequest struct {
 
 func (x *SetupRealmRequest) Reset() {
 	*x = SetupRealmRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[46]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2499,7 +10540,7 @@ func (x *SetupRealmRequest) String() string {
 func (*SetupRealmRequest) ProtoMessage() {}
 
 func (x *SetupRealmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[46]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2512,7 +10553,7 @@ func (x *SetupRealmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetupRealmRequest.ProtoReflect.Descriptor instead.
 func (*SetupRealmRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{46}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *SetupRealmRequest) GetDomain() string {
@@ -2539,7 +10580,7 @@ type SetupRealmResponse struct {
 
 func (x *SetupRealmResponse) Reset() {
 	*x = SetupRealmResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[47]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2551,7 +10592,7 @@ func (x *SetupRealmResponse) String() string {
 func (*SetupRealmResponse) ProtoMessage() {}
 
 func (x *SetupRealmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[47]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2564,7 +10605,7 @@ func (x *SetupRealmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetupRealmResponse.ProtoReflect.Descriptor instead.
 func (*SetupRealmResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{47}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *SetupRealmResponse) GetRealmId() string {
@@ -2589,7 +10630,7 @@ type GetRealmStatusRequest struct {
 
 func (x *GetRealmStatusRequest) Reset() {
 	*x = GetRealmStatusRequest{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[48]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2601,7 +10642,7 @@ func (x *GetRealmStatusRequest) String() string {
 func (*GetRealmStatusRequest) ProtoMessage() {}
 
 func (x *GetRealmStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[48]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +10655,7 @@ func (x *GetRealmStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRealmStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetRealmStatusRequest) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{48}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{174}
 }
 
 type GetRealmStatusResponse struct {
@@ -2627,7 +10668,7 @@ type GetRealmStatusResponse struct {
 
 func (x *GetRealmStatusResponse) Reset() {
 	*x = GetRealmStatusResponse{}
-	mi := &file_kitsulan_v1_service_proto_msgTypes[49]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2639,7 +10680,7 @@ func (x *GetRealmStatusResponse) String() string {
 func (*GetRealmStatusResponse) ProtoMessage() {}
 
 func (x *GetRealmStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_kitsulan_v1_service_proto_msgTypes[49]
+	mi := &file_kitsulan_v1_service_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2652,7 +10693,7 @@ func (x *GetRealmStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRealmStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetRealmStatusResponse) Descriptor() ([]byte, []int) {
-	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{49}
+	return file_kitsulan_v1_service_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *GetRealmStatusResponse) GetIsInitialized() bool {
@@ -2673,14 +10714,15 @@ var File_kitsulan_v1_service_proto protoreflect.FileDescriptor
 
 const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19kitsulan/v1/service.proto\x12\vkitsulan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x80\x01\n" +
+	"\x19kitsulan/v1/service.proto\x12\vkitsulan.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x10\n" +
 	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x1b\n" +
-	"\tis_online\x18\x05 \x01(\bR\bisOnline\"_\n" +
+	"\tis_online\x18\x05 \x01(\bR\bisOnline\x122\n" +
+	"\tdm_policy\x18\x06 \x01(\x0e2\x15.kitsulan.v1.DMPolicyR\bdmPolicy\"_\n" +
 	"\x0fRegisterRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
@@ -2703,21 +10745,24 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\x11GetProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
 	"\x12GetProfileResponse\x12%\n" +
-	"\x04user\x18\x01 \x01(\v2\x11.kitsulan.v1.UserR\x04user\"\x96\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.kitsulan.v1.UserR\x04user\"\xdd\x01\n" +
 	"\x14UpdateProfileRequest\x12\x1f\n" +
 	"\bnickname\x18\x01 \x01(\tH\x00R\bnickname\x88\x01\x01\x12\x15\n" +
 	"\x03bio\x18\x02 \x01(\tH\x01R\x03bio\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"avatar_url\x18\x03 \x01(\tH\x02R\tavatarUrl\x88\x01\x01B\v\n" +
+	"avatar_url\x18\x03 \x01(\tH\x02R\tavatarUrl\x88\x01\x01\x127\n" +
+	"\tdm_policy\x18\x04 \x01(\x0e2\x15.kitsulan.v1.DMPolicyH\x03R\bdmPolicy\x88\x01\x01B\v\n" +
 	"\t_nicknameB\x06\n" +
 	"\x04_bioB\r\n" +
-	"\v_avatar_url\">\n" +
+	"\v_avatar_urlB\f\n" +
+	"\n" +
+	"_dm_policy\">\n" +
 	"\x15UpdateProfileResponse\x12%\n" +
 	"\x04user\x18\x01 \x01(\v2\x11.kitsulan.v1.UserR\x04user\"*\n" +
 	"\x12SearchUsersRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\">\n" +
 	"\x13SearchUsersResponse\x12'\n" +
-	"\x05users\x18\x01 \x03(\v2\x11.kitsulan.v1.UserR\x05users\"\xf7\x01\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.kitsulan.v1.UserR\x05users\"\xec\x03\n" +
 	"\x05Guild\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -2727,13 +10772,24 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\bowner_id\x18\x06 \x01(\tR\aownerId\x12!\n" +
 	"\fmember_count\x18\a \x01(\x05R\vmemberCount\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x92\x01\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"banner_url\x18\t \x01(\tR\tbannerUrl\x12\x1d\n" +
+	"\n" +
+	"splash_url\x18\n" +
+	" \x01(\tR\tsplashUrl\x12*\n" +
+	"\x11system_channel_id\x18\v \x01(\tR\x0fsystemChannelId\x12(\n" +
+	"\x10rules_channel_id\x18\f \x01(\tR\x0erulesChannelId\x12$\n" +
+	"\x0eafk_channel_id\x18\r \x01(\tR\fafkChannelId\x12\x1f\n" +
+	"\vafk_timeout\x18\x0e \x01(\x05R\n" +
+	"afkTimeout\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\rR\aversion\"\x92\x01\n" +
 	"\aChannel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12,\n" +
 	"\x04type\x18\x04 \x01(\x0e2\x18.kitsulan.v1.ChannelTypeR\x04type\x12\x1a\n" +
-	"\bposition\x18\x05 \x01(\x05R\bposition\"\xce\x01\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\"\xad\x03\n" +
 	"\x06Member\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
@@ -2741,7 +10797,15 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x1a\n" +
 	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x1b\n" +
 	"\tis_online\x18\x05 \x01(\bR\bisOnline\x127\n" +
-	"\tjoined_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"J\n" +
+	"\tjoined_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12(\n" +
+	"\x10guild_avatar_url\x18\a \x01(\tR\x0eguildAvatarUrl\x12\x19\n" +
+	"\bguild_id\x18\b \x01(\tR\aguildId\x12?\n" +
+	"\rtimeout_until\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ftimeoutUntil\x12\x19\n" +
+	"\bis_muted\x18\n" +
+	" \x01(\bR\aisMuted\x12\x1f\n" +
+	"\vis_deafened\x18\v \x01(\bR\n" +
+	"isDeafened\x12\x1b\n" +
+	"\tcan_speak\x18\f \x01(\bR\bcanSpeak\"J\n" +
 	"\x12CreateGuildRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"?\n" +
@@ -2750,6 +10814,14 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\x0fGetGuildRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId\"<\n" +
 	"\x10GetGuildResponse\x12(\n" +
+	"\x05guild\x18\x01 \x01(\v2\x12.kitsulan.v1.GuildR\x05guild\"\xb0\x01\n" +
+	"\x12UpdateGuildRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12(\n" +
+	"\x05guild\x18\x02 \x01(\v2\x12.kitsulan.v1.GuildR\x05guild\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\rR\aversion\"?\n" +
+	"\x13UpdateGuildResponse\x12(\n" +
 	"\x05guild\x18\x01 \x01(\v2\x12.kitsulan.v1.GuildR\x05guild\"\x15\n" +
 	"\x13ListMyGuildsRequest\"B\n" +
 	"\x14ListMyGuildsResponse\x12*\n" +
@@ -2770,7 +10842,11 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\x05guild\x18\x01 \x01(\v2\x12.kitsulan.v1.GuildR\x05guild\".\n" +
 	"\x11LeaveGuildRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId\"\x14\n" +
-	"\x12LeaveGuildResponse\"s\n" +
+	"\x12LeaveGuildResponse\"G\n" +
+	"\x11KickMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
+	"\x12KickMemberResponse\"s\n" +
 	"\x14CreateChannelRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
@@ -2788,7 +10864,39 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\x12ListMembersRequest\x12\x19\n" +
 	"\bguild_id\x18\x01 \x01(\tR\aguildId\"D\n" +
 	"\x13ListMembersResponse\x12-\n" +
-	"\amembers\x18\x01 \x03(\v2\x13.kitsulan.v1.MemberR\amembers\"\xbc\x02\n" +
+	"\amembers\x18\x01 \x03(\v2\x13.kitsulan.v1.MemberR\amembers\"\x93\x01\n" +
+	"\x15UpdateMyMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1f\n" +
+	"\bnickname\x18\x02 \x01(\tH\x00R\bnickname\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tH\x01R\tavatarUrl\x88\x01\x01B\v\n" +
+	"\t_nicknameB\r\n" +
+	"\v_avatar_url\"\xaa\x01\n" +
+	"\x13UpdateMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x00R\bnickname\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tH\x01R\tavatarUrl\x88\x01\x01B\v\n" +
+	"\t_nicknameB\r\n" +
+	"\v_avatar_url\"C\n" +
+	"\x14UpdateMemberResponse\x12+\n" +
+	"\x06member\x18\x01 \x01(\v2\x13.kitsulan.v1.MemberR\x06member\"|\n" +
+	"\x14TimeoutMemberRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"D\n" +
+	"\x15TimeoutMemberResponse\x12+\n" +
+	"\x06member\x18\x01 \x01(\v2\x13.kitsulan.v1.MemberR\x06member\"\xa3\x01\n" +
+	"\x1aSetMemberVoiceStateRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05muted\x18\x03 \x01(\bH\x00R\x05muted\x88\x01\x01\x12\x1f\n" +
+	"\bdeafened\x18\x04 \x01(\bH\x01R\bdeafened\x88\x01\x01B\b\n" +
+	"\x06_mutedB\v\n" +
+	"\t_deafened\"J\n" +
+	"\x1bSetMemberVoiceStateResponse\x12+\n" +
+	"\x06member\x18\x01 \x01(\v2\x13.kitsulan.v1.MemberR\x06member\"\xf8\b\n" +
 	"\vChatMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2799,33 +10907,545 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\acontent\x18\x06 \x01(\tR\acontent\x129\n" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
-	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xa3\x01\n" +
+	"\tedited_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12.\n" +
+	"\x13author_display_name\x18\t \x01(\tR\x11authorDisplayName\x122\n" +
+	"\x06system\x18\n" +
+	" \x01(\v2\x1a.kitsulan.v1.SystemMessageR\x06system\x12!\n" +
+	"\fedit_version\x18\v \x01(\rR\veditVersion\x12:\n" +
+	"\treactions\x18\f \x03(\v2\x1c.kitsulan.v1.ReactionSummaryR\treactions\x12L\n" +
+	"\x12referenced_message\x18\r \x01(\v2\x1d.kitsulan.v1.MessageReferenceR\x11referencedMessage\x12\x1b\n" +
+	"\tthread_id\x18\x0e \x01(\tR\bthreadId\x12\x16\n" +
+	"\x06pinned\x18\x0f \x01(\bR\x06pinned\x12\x10\n" +
+	"\x03seq\x18\x10 \x01(\x03R\x03seq\x12(\n" +
+	"\x10mention_user_ids\x18\x11 \x03(\tR\x0ementionUserIds\x12(\n" +
+	"\x10mention_role_ids\x18\x12 \x03(\tR\x0ementionRoleIds\x12)\n" +
+	"\x10mention_everyone\x18\x13 \x01(\bR\x0fmentionEveryone\x12!\n" +
+	"\fmention_here\x18\x14 \x01(\bR\vmentionHere\x12\x1a\n" +
+	"\bmarkdown\x18\x15 \x01(\bR\bmarkdown\x12)\n" +
+	"\x03ast\x18\x16 \x03(\v2\x17.kitsulan.v1.MarkupNodeR\x03ast\x12*\n" +
+	"\x06embeds\x18\x17 \x03(\v2\x12.kitsulan.v1.EmbedR\x06embeds\x126\n" +
+	"\n" +
+	"components\x18\x18 \x03(\v2\x16.kitsulan.v1.ActionRowR\n" +
+	"components\x12\x1c\n" +
+	"\tephemeral\x18\x19 \x01(\bR\tephemeral\x12\x14\n" +
+	"\x05nonce\x18\x1a \x01(\tR\x05nonce\x12%\n" +
+	"\x0eauthor_blocked\x18\x1b \x01(\bR\rauthorBlocked\x12:\n" +
+	"\tcrosspost\x18\x1c \x01(\v2\x1c.kitsulan.v1.CrosspostSourceR\tcrosspost\"\xac\x01\n" +
+	"\x0fCrosspostSource\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1d\n" +
+	"\n" +
+	"guild_name\x18\x02 \x01(\tR\tguildName\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x03 \x01(\tR\tchannelId\x12!\n" +
+	"\fchannel_name\x18\x04 \x01(\tR\vchannelName\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\"C\n" +
+	"\tActionRow\x126\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x16.kitsulan.v1.ComponentR\n" +
+	"components\"\xf7\x02\n" +
+	"\tComponent\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.kitsulan.v1.ComponentTypeR\x04type\x12\x1b\n" +
+	"\tcustom_id\x18\x02 \x01(\tR\bcustomId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\x12.\n" +
+	"\x05style\x18\x05 \x01(\x0e2\x18.kitsulan.v1.ButtonStyleR\x05style\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12\x14\n" +
+	"\x05emoji\x18\a \x01(\tR\x05emoji\x12 \n" +
+	"\vplaceholder\x18\b \x01(\tR\vplaceholder\x12\x1d\n" +
+	"\n" +
+	"min_values\x18\t \x01(\x05R\tminValues\x12\x1d\n" +
+	"\n" +
+	"max_values\x18\n" +
+	" \x01(\x05R\tmaxValues\x123\n" +
+	"\aoptions\x18\v \x03(\v2\x19.kitsulan.v1.SelectOptionR\aoptions\"v\n" +
+	"\fSelectOption\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\adefault\x18\x04 \x01(\bR\adefault\"\x9c\x02\n" +
+	"\x05Embed\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12*\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x16.kitsulan.v1.EmbedTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tsite_name\x18\x05 \x01(\tR\bsiteName\x12\x1f\n" +
+	"\vauthor_name\x18\x06 \x01(\tR\n" +
+	"authorName\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x1f\n" +
+	"\vimage_width\x18\b \x01(\x05R\n" +
+	"imageWidth\x12!\n" +
+	"\fimage_height\x18\t \x01(\x05R\vimageHeight\"\xec\x01\n" +
+	"\n" +
+	"MarkupNode\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.kitsulan.v1.MarkupNodeTypeR\x04type\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x03 \x01(\tR\x04lang\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x1a\n" +
+	"\banimated\x18\a \x01(\bR\banimated\x123\n" +
+	"\bchildren\x18\b \x03(\v2\x17.kitsulan.v1.MarkupNodeR\bchildren\"\xab\x01\n" +
+	"\x10MessageReference\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12'\n" +
+	"\x0fauthor_username\x18\x03 \x01(\tR\x0eauthorUsername\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\bR\adeleted\"M\n" +
+	"\x0fReactionSummary\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x0e\n" +
+	"\x02me\x18\x03 \x01(\bR\x02me\"\xf6\x01\n" +
+	"\rSystemMessage\x122\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1e.kitsulan.v1.SystemMessageTypeR\x04type\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12>\n" +
+	"\x06params\x18\x04 \x03(\v2&.kitsulan.v1.SystemMessage.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\v\n" +
 	"\tChatEvent\x12C\n" +
 	"\x0fmessage_created\x18\x01 \x01(\v2\x18.kitsulan.v1.ChatMessageH\x00R\x0emessageCreated\x12F\n" +
-	"\x0fmessage_deleted\x18\x02 \x01(\v2\x1b.kitsulan.v1.MessageDeletedH\x00R\x0emessageDeletedB\t\n" +
-	"\apayload\"N\n" +
+	"\x0fmessage_deleted\x18\x02 \x01(\v2\x1b.kitsulan.v1.MessageDeletedH\x00R\x0emessageDeleted\x129\n" +
+	"\rguild_updated\x18\x03 \x01(\v2\x12.kitsulan.v1.GuildH\x00R\fguildUpdated\x12<\n" +
+	"\x0emember_updated\x18\x04 \x01(\v2\x13.kitsulan.v1.MemberH\x00R\rmemberUpdated\x12C\n" +
+	"\x0fmessage_updated\x18\x05 \x01(\v2\x18.kitsulan.v1.ChatMessageH\x00R\x0emessageUpdated\x12V\n" +
+	"\x15messages_bulk_deleted\x18\x06 \x01(\v2 .kitsulan.v1.MessagesBulkDeletedH\x00R\x13messagesBulkDeleted\x12C\n" +
+	"\x0ereaction_added\x18\a \x01(\v2\x1a.kitsulan.v1.ReactionEventH\x00R\rreactionAdded\x12G\n" +
+	"\x10reaction_removed\x18\b \x01(\v2\x1a.kitsulan.v1.ReactionEventH\x00R\x0freactionRemoved\x12L\n" +
+	"\x11reactions_cleared\x18\t \x01(\v2\x1d.kitsulan.v1.ReactionsClearedH\x00R\x10reactionsCleared\x12<\n" +
+	"\x0ethread_created\x18\n" +
+	" \x01(\v2\x13.kitsulan.v1.ThreadH\x00R\rthreadCreated\x12<\n" +
+	"\x0ethread_updated\x18\v \x01(\v2\x13.kitsulan.v1.ThreadH\x00R\rthreadUpdated\x12P\n" +
+	"\x13message_pin_updated\x18\f \x01(\v2\x1e.kitsulan.v1.MessagePinUpdatedH\x00R\x11messagePinUpdated\x12C\n" +
+	"\x0etyping_started\x18\r \x01(\v2\x1a.kitsulan.v1.TypingStartedH\x00R\rtypingStarted\x12M\n" +
+	"\x12read_state_updated\x18\x0e \x01(\v2\x1d.kitsulan.v1.ReadStateUpdatedH\x00R\x10readStateUpdated\x12R\n" +
+	"\x13interaction_created\x18\x0f \x01(\v2\x1f.kitsulan.v1.InteractionCreatedH\x00R\x12interactionCreated\x12F\n" +
+	"\x12dm_channel_updated\x18\x10 \x01(\v2\x16.kitsulan.v1.DMChannelH\x00R\x10dmChannelUpdated\x12M\n" +
+	"\x12dm_channel_removed\x18\x11 \x01(\v2\x1d.kitsulan.v1.DMChannelRemovedH\x00R\x10dmChannelRemoved\x12N\n" +
+	"\x14relationship_updated\x18\x12 \x01(\v2\x19.kitsulan.v1.RelationshipH\x00R\x13relationshipUpdated\x12U\n" +
+	"\x14relationship_removed\x18\x13 \x01(\v2 .kitsulan.v1.RelationshipRemovedH\x00R\x13relationshipRemovedB\t\n" +
+	"\apayload\"\xb3\x02\n" +
+	"\x12InteractionCreated\x12%\n" +
+	"\x0einteraction_id\x18\x01 \x01(\tR\rinteractionId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x04 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bguild_id\x18\x05 \x01(\tR\aguildId\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tcustom_id\x18\a \x01(\tR\bcustomId\x12\x16\n" +
+	"\x06values\x18\b \x03(\tR\x06values\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x82\x01\n" +
+	"\rTypingStarted\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x84\x01\n" +
+	"\x11MessagePinUpdated\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\"|\n" +
+	"\rReactionEvent\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x04 \x01(\tR\x05emoji\"f\n" +
+	"\x10ReactionsCleared\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x14\n" +
+	"\x05emoji\x18\x03 \x01(\tR\x05emoji\"m\n" +
 	"\x0eMessageDeleted\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x02 \x01(\tR\tchannelId\"M\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x03 \x01(\tR\tdeletedBy\"t\n" +
+	"\x13MessagesBulkDeleted\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vmessage_ids\x18\x02 \x03(\tR\n" +
+	"messageIds\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x03 \x01(\tR\tdeletedBy\"\xcf\x01\n" +
+	"\vMessageEdit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\teditor_id\x18\x03 \x01(\tR\beditorId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12!\n" +
+	"\fedit_version\x18\x05 \x01(\rR\veditVersion\x127\n" +
+	"\tedited_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\"\xe6\x01\n" +
 	"\x12SendMessageRequest\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\"I\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12-\n" +
+	"\x13reply_to_message_id\x18\x03 \x01(\tR\x10replyToMessageId\x12\x1a\n" +
+	"\bmarkdown\x18\x04 \x01(\bR\bmarkdown\x126\n" +
+	"\n" +
+	"components\x18\x05 \x03(\v2\x16.kitsulan.v1.ActionRowR\n" +
+	"components\x12\x14\n" +
+	"\x05nonce\x18\x06 \x01(\tR\x05nonce\"I\n" +
 	"\x13SendMessageResponse\x122\n" +
-	"\amessage\x18\x01 \x01(\v2\x18.kitsulan.v1.ChatMessageR\amessage\"t\n" +
+	"\amessage\x18\x01 \x01(\v2\x18.kitsulan.v1.ChatMessageR\amessage\"\xdc\x01\n" +
 	"\x11GetHistoryRequest\x12\x1d\n" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12*\n" +
-	"\x11before_message_id\x18\x03 \x01(\tR\x0fbeforeMessageId\"e\n" +
+	"\x11before_message_id\x18\x03 \x01(\tR\x0fbeforeMessageId\x12\x1d\n" +
+	"\n" +
+	"before_seq\x18\x04 \x01(\x03R\tbeforeSeq\x12\x1b\n" +
+	"\tafter_seq\x18\x05 \x01(\x03R\bafterSeq\x12*\n" +
+	"\x11around_message_id\x18\x06 \x01(\tR\x0faroundMessageId\"\xa6\x01\n" +
 	"\x12GetHistoryResponse\x124\n" +
 	"\bmessages\x18\x01 \x03(\v2\x18.kitsulan.v1.ChatMessageR\bmessages\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"8\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12$\n" +
+	"\x0ehas_more_after\x18\x03 \x01(\bR\fhasMoreAfter\x12\x19\n" +
+	"\bsync_seq\x18\x04 \x01(\x03R\asyncSeq\"d\n" +
+	"\x12SyncChannelRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bsync_seq\x18\x02 \x01(\x03R\asyncSeq\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xb1\x01\n" +
+	"\x13SyncChannelResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.kitsulan.v1.ChatMessageR\bmessages\x12.\n" +
+	"\x13deleted_message_ids\x18\x02 \x03(\tR\x11deletedMessageIds\x12\x19\n" +
+	"\bsync_seq\x18\x03 \x01(\x03R\asyncSeq\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"8\n" +
 	"\x17SubscribeChannelRequest\x12\x1d\n" +
 	"\n" +
-	"channel_id\x18\x01 \x01(\tR\tchannelId\"N\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"M\n" +
+	"\x12EditMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"I\n" +
+	"\x13EditMessageResponse\x122\n" +
+	"\amessage\x18\x01 \x01(\v2\x18.kitsulan.v1.ChatMessageR\amessage\"8\n" +
+	"\x17ListMessageEditsRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"J\n" +
+	"\x18ListMessageEditsResponse\x12.\n" +
+	"\x05edits\x18\x01 \x03(\v2\x18.kitsulan.v1.MessageEditR\x05edits\"M\n" +
+	"\x14DeleteMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x17\n" +
+	"\x15DeleteMessageResponse\"\x8d\x02\n" +
+	"\x19BulkDeleteMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vmessage_ids\x18\x02 \x03(\tR\n" +
+	"messageIds\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x120\n" +
+	"\x05after\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\x122\n" +
+	"\x06before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x15\n" +
+	"\x06last_n\x18\x06 \x01(\x05R\x05lastN\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"L\n" +
+	"\x1aBulkDeleteMessagesResponse\x12.\n" +
+	"\x13deleted_message_ids\x18\x01 \x03(\tR\x11deletedMessageIds\"I\n" +
+	"\x12AddReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\"\x15\n" +
+	"\x13AddReactionResponse\"e\n" +
+	"\x15RemoveReactionRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x18\n" +
+	"\x16RemoveReactionResponse\"P\n" +
+	"\x19RemoveAllReactionsRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\"\x1c\n" +
+	"\x1aRemoveAllReactionsResponse\"\x84\x01\n" +
+	"\x13ListReactorsRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\"\n" +
+	"\rafter_user_id\x18\x04 \x01(\tR\vafterUserId\"?\n" +
+	"\x14ListReactorsResponse\x12'\n" +
+	"\x05users\x18\x01 \x03(\v2\x11.kitsulan.v1.UserR\x05users\"2\n" +
+	"\x11PinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"\x14\n" +
+	"\x12PinMessageResponse\"4\n" +
+	"\x13UnpinMessageRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\"\x16\n" +
+	"\x14UnpinMessageResponse\":\n" +
+	"\x19ListPinnedMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"R\n" +
+	"\x1aListPinnedMessagesResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.kitsulan.v1.ChatMessageR\bmessages\"2\n" +
+	"\x11SendTypingRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"\x14\n" +
+	"\x12SendTypingResponse\"=\n" +
+	"\n" +
+	"AckRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x03R\x03seq\"1\n" +
+	"\vAckResponse\x12\"\n" +
+	"\rlast_read_seq\x18\x01 \x01(\x03R\vlastReadSeq\"\x19\n" +
+	"\x17GetUnreadSummaryRequest\"\x84\x01\n" +
+	"\x18GetUnreadSummaryResponse\x126\n" +
+	"\bchannels\x18\x01 \x03(\v2\x1a.kitsulan.v1.ChannelUnreadR\bchannels\x120\n" +
+	"\x06guilds\x18\x02 \x03(\v2\x18.kitsulan.v1.GuildUnreadR\x06guilds\"\xd0\x01\n" +
+	"\rChannelUnread\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\"\n" +
+	"\rlast_read_seq\x18\x03 \x01(\x03R\vlastReadSeq\x12\x19\n" +
+	"\blast_seq\x18\x04 \x01(\x03R\alastSeq\x12!\n" +
+	"\funread_count\x18\x05 \x01(\x03R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x06 \x01(\x03R\fmentionCount\"p\n" +
+	"\vGuildUnread\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x03R\vunreadCount\x12#\n" +
+	"\rmention_count\x18\x03 \x01(\x03R\fmentionCount\"\x1c\n" +
+	"\x1aSubscribeUserEventsRequest\"\x80\x01\n" +
+	"\x19ListRecentMentionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x122\n" +
+	"\x06before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"R\n" +
+	"\x1aListRecentMentionsResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.kitsulan.v1.ChatMessageR\bmessages\"\x93\x03\n" +
+	"\x15SearchMessagesRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x120\n" +
+	"\x05after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05after\x122\n" +
+	"\x06before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12%\n" +
+	"\x0ehas_attachment\x18\a \x01(\bR\rhasAttachment\x12\x19\n" +
+	"\bhas_link\x18\b \x01(\bR\ahasLink\x12\x16\n" +
+	"\x06pinned\x18\t \x01(\bR\x06pinned\x12\x1f\n" +
+	"\vmentions_me\x18\n" +
+	" \x01(\bR\n" +
+	"mentionsMe\x12\x14\n" +
+	"\x05limit\x18\v \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\f \x01(\x05R\x06offset\"i\n" +
+	"\x16SearchMessagesResponse\x124\n" +
+	"\bmessages\x18\x01 \x03(\v2\x18.kitsulan.v1.ChatMessageR\bmessages\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"r\n" +
+	"\x1cInteractWithComponentRequest\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12\x1b\n" +
+	"\tcustom_id\x18\x02 \x01(\tR\bcustomId\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\x8d\x01\n" +
+	"\x1dInteractWithComponentResponse\x128\n" +
+	"\x04type\x18\x01 \x01(\x0e2$.kitsulan.v1.InteractionResponseTypeR\x04type\x122\n" +
+	"\amessage\x18\x02 \x01(\v2\x18.kitsulan.v1.ChatMessageR\amessage\"\x82\x02\n" +
+	"\x1bRespondToInteractionRequest\x12%\n" +
+	"\x0einteraction_id\x18\x01 \x01(\tR\rinteractionId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x128\n" +
+	"\x04type\x18\x03 \x01(\x0e2$.kitsulan.v1.InteractionResponseTypeR\x04type\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1a\n" +
+	"\bmarkdown\x18\x05 \x01(\bR\bmarkdown\x126\n" +
+	"\n" +
+	"components\x18\x06 \x03(\v2\x16.kitsulan.v1.ActionRowR\n" +
+	"components\"\x1e\n" +
+	"\x1cRespondToInteractionResponse\"\x8b\x01\n" +
+	"\x1bSendEphemeralMessageRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1a\n" +
+	"\bmarkdown\x18\x04 \x01(\bR\bmarkdown\"R\n" +
+	"\x1cSendEphemeralMessageResponse\x122\n" +
+	"\amessage\x18\x01 \x01(\v2\x18.kitsulan.v1.ChatMessageR\amessage\"\xd7\x02\n" +
+	"\x10ScheduledMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1a\n" +
+	"\bmarkdown\x18\x05 \x01(\bR\bmarkdown\x123\n" +
+	"\asend_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\x12;\n" +
+	"\x06status\x18\a \x01(\x0e2#.kitsulan.v1.ScheduledMessageStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa2\x01\n" +
+	"\x16ScheduleMessageRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1a\n" +
+	"\bmarkdown\x18\x03 \x01(\bR\bmarkdown\x123\n" +
+	"\asend_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\"e\n" +
+	"\x17ScheduleMessageResponse\x12J\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2\x1d.kitsulan.v1.ScheduledMessageR\x10scheduledMessage\"=\n" +
+	"\x1cListScheduledMessagesRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"m\n" +
+	"\x1dListScheduledMessagesResponse\x12L\n" +
+	"\x12scheduled_messages\x18\x01 \x03(\v2\x1d.kitsulan.v1.ScheduledMessageR\x11scheduledMessages\"Q\n" +
+	"\x1dCancelScheduledMessageRequest\x120\n" +
+	"\x14scheduled_message_id\x18\x01 \x01(\tR\x12scheduledMessageId\" \n" +
+	"\x1eCancelScheduledMessageResponse\"\xf1\x02\n" +
+	"\rChannelFollow\x12*\n" +
+	"\x11source_channel_id\x18\x01 \x01(\tR\x0fsourceChannelId\x12.\n" +
+	"\x13source_channel_name\x18\x02 \x01(\tR\x11sourceChannelName\x12&\n" +
+	"\x0fsource_guild_id\x18\x03 \x01(\tR\rsourceGuildId\x12*\n" +
+	"\x11target_channel_id\x18\x04 \x01(\tR\x0ftargetChannelId\x12.\n" +
+	"\x13target_channel_name\x18\x05 \x01(\tR\x11targetChannelName\x12&\n" +
+	"\x0ftarget_guild_id\x18\x06 \x01(\tR\rtargetGuildId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"a\n" +
+	"\x14FollowChannelRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12*\n" +
+	"\x11target_channel_id\x18\x02 \x01(\tR\x0ftargetChannelId\"K\n" +
+	"\x15FollowChannelResponse\x122\n" +
+	"\x06follow\x18\x01 \x01(\v2\x1a.kitsulan.v1.ChannelFollowR\x06follow\"c\n" +
+	"\x16UnfollowChannelRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12*\n" +
+	"\x11target_channel_id\x18\x02 \x01(\tR\x0ftargetChannelId\"\x19\n" +
+	"\x17UnfollowChannelResponse\":\n" +
+	"\x19ListChannelFollowsRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"\x90\x01\n" +
+	"\x1aListChannelFollowsResponse\x128\n" +
+	"\tfollowing\x18\x01 \x03(\v2\x1a.kitsulan.v1.ChannelFollowR\tfollowing\x128\n" +
+	"\tfollowers\x18\x02 \x03(\v2\x1a.kitsulan.v1.ChannelFollowR\tfollowers\"U\n" +
+	"\x10ReadStateUpdated\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\"\n" +
+	"\rlast_read_seq\x18\x02 \x01(\x03R\vlastReadSeq\"\xd9\x02\n" +
+	"\x06Thread\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12,\n" +
+	"\x12starter_message_id\x18\x06 \x01(\tR\x10starterMessageId\x12\x1a\n" +
+	"\barchived\x18\a \x01(\bR\barchived\x12\x16\n" +
+	"\x06locked\x18\b \x01(\bR\x06locked\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\"f\n" +
+	"\x12StartThreadRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x03 \x01(\tR\tmessageId\"B\n" +
+	"\x13StartThreadResponse\x12+\n" +
+	"\x06thread\x18\x01 \x01(\v2\x13.kitsulan.v1.ThreadR\x06thread\"0\n" +
+	"\x11JoinThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\tR\bthreadId\"\x14\n" +
+	"\x12JoinThreadResponse\"1\n" +
+	"\x12LeaveThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\tR\bthreadId\"\x15\n" +
+	"\x13LeaveThreadResponse\"\xaa\x01\n" +
+	"\x13UpdateThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\tR\bthreadId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\x03 \x01(\bH\x01R\barchived\x88\x01\x01\x12\x1b\n" +
+	"\x06locked\x18\x04 \x01(\bH\x02R\x06locked\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_archivedB\t\n" +
+	"\a_locked\"C\n" +
+	"\x14UpdateThreadResponse\x12+\n" +
+	"\x06thread\x18\x01 \x01(\v2\x13.kitsulan.v1.ThreadR\x06thread\"9\n" +
+	"\x18ListActiveThreadsRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\"J\n" +
+	"\x19ListActiveThreadsResponse\x12-\n" +
+	"\athreads\x18\x01 \x03(\v2\x13.kitsulan.v1.ThreadR\athreads\"\xe9\x01\n" +
+	"\tDMChannel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05group\x18\x02 \x01(\bR\x05group\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x121\n" +
+	"\n" +
+	"recipients\x18\x05 \x03(\v2\x11.kitsulan.v1.UserR\n" +
+	"recipients\x12\x19\n" +
+	"\blast_seq\x18\x06 \x01(\x03R\alastSeq\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"1\n" +
+	"\x10DMChannelRemoved\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\">\n" +
+	"\rOpenDMRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
+	"\x0eOpenDMResponse\x120\n" +
+	"\achannel\x18\x01 \x01(\v2\x16.kitsulan.v1.DMChannelR\achannel\"\x12\n" +
+	"\x10ListMyDMsRequest\"G\n" +
+	"\x11ListMyDMsResponse\x122\n" +
+	"\bchannels\x18\x01 \x03(\v2\x16.kitsulan.v1.DMChannelR\bchannels\"O\n" +
+	"\x15AddDMRecipientRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x16AddDMRecipientResponse\x120\n" +
+	"\achannel\x18\x01 \x01(\v2\x16.kitsulan.v1.DMChannelR\achannel\"R\n" +
+	"\x18RemoveDMRecipientRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
+	"\x19RemoveDMRecipientResponse\"I\n" +
+	"\x14RenameGroupDMRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"I\n" +
+	"\x15RenameGroupDMResponse\x120\n" +
+	"\achannel\x18\x01 \x01(\v2\x16.kitsulan.v1.DMChannelR\achannel\"\x9a\x01\n" +
+	"\fRelationship\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.kitsulan.v1.UserR\x04user\x121\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1d.kitsulan.v1.RelationshipTypeR\x04type\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\".\n" +
+	"\x13RelationshipRemoved\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1a\n" +
+	"\x18ListRelationshipsRequest\"\\\n" +
+	"\x19ListRelationshipsResponse\x12?\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x19.kitsulan.v1.RelationshipR\rrelationships\"3\n" +
+	"\x18SendFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"Z\n" +
+	"\x19SendFriendRequestResponse\x12=\n" +
+	"\frelationship\x18\x01 \x01(\v2\x19.kitsulan.v1.RelationshipR\frelationship\"5\n" +
+	"\x1aAcceptFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\\\n" +
+	"\x1bAcceptFriendRequestResponse\x12=\n" +
+	"\frelationship\x18\x01 \x01(\v2\x19.kitsulan.v1.RelationshipR\frelationship\"6\n" +
+	"\x1bDeclineFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1e\n" +
+	"\x1cDeclineFriendRequestResponse\"5\n" +
+	"\x1aCancelFriendRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1d\n" +
+	"\x1bCancelFriendRequestResponse\".\n" +
+	"\x13RemoveFriendRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x16\n" +
+	"\x14RemoveFriendResponse\"+\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x11BlockUserResponse\x12=\n" +
+	"\frelationship\x18\x01 \x01(\v2\x19.kitsulan.v1.RelationshipR\frelationship\"-\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x15\n" +
+	"\x13UnblockUserResponse\"N\n" +
 	"\x11SetupRealmRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"N\n" +
@@ -2836,11 +11456,71 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\x15GetRealmStatusRequest\"Y\n" +
 	"\x16GetRealmStatusResponse\x12%\n" +
 	"\x0eis_initialized\x18\x01 \x01(\bR\risInitialized\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion*Z\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion*p\n" +
+	"\bDMPolicy\x12\x19\n" +
+	"\x15DM_POLICY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12DM_POLICY_EVERYONE\x10\x01\x12\x1b\n" +
+	"\x17DM_POLICY_GUILD_MEMBERS\x10\x02\x12\x14\n" +
+	"\x10DM_POLICY_NOBODY\x10\x03*y\n" +
 	"\vChannelType\x12\x1c\n" +
 	"\x18CHANNEL_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11CHANNEL_TYPE_TEXT\x10\x01\x12\x16\n" +
-	"\x12CHANNEL_TYPE_VOICE\x10\x022\xeb\x01\n" +
+	"\x12CHANNEL_TYPE_VOICE\x10\x02\x12\x1d\n" +
+	"\x19CHANNEL_TYPE_ANNOUNCEMENT\x10\x03*e\n" +
+	"\rComponentType\x12\x1e\n" +
+	"\x1aCOMPONENT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15COMPONENT_TYPE_BUTTON\x10\x01\x12\x19\n" +
+	"\x15COMPONENT_TYPE_SELECT\x10\x02*\xab\x01\n" +
+	"\vButtonStyle\x12\x1c\n" +
+	"\x18BUTTON_STYLE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BUTTON_STYLE_PRIMARY\x10\x01\x12\x1a\n" +
+	"\x16BUTTON_STYLE_SECONDARY\x10\x02\x12\x18\n" +
+	"\x14BUTTON_STYLE_SUCCESS\x10\x03\x12\x17\n" +
+	"\x13BUTTON_STYLE_DANGER\x10\x04\x12\x15\n" +
+	"\x11BUTTON_STYLE_LINK\x10\x05*}\n" +
+	"\tEmbedType\x12\x1a\n" +
+	"\x16EMBED_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fEMBED_TYPE_LINK\x10\x01\x12\x14\n" +
+	"\x10EMBED_TYPE_IMAGE\x10\x02\x12\x14\n" +
+	"\x10EMBED_TYPE_VIDEO\x10\x03\x12\x13\n" +
+	"\x0fEMBED_TYPE_RICH\x10\x04*\xe1\x03\n" +
+	"\x0eMarkupNodeType\x12 \n" +
+	"\x1cMARKUP_NODE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15MARKUP_NODE_TYPE_TEXT\x10\x01\x12\x1f\n" +
+	"\x1bMARKUP_NODE_TYPE_LINE_BREAK\x10\x02\x12\x19\n" +
+	"\x15MARKUP_NODE_TYPE_BOLD\x10\x03\x12\x1b\n" +
+	"\x17MARKUP_NODE_TYPE_ITALIC\x10\x04\x12\x1c\n" +
+	"\x18MARKUP_NODE_TYPE_SPOILER\x10\x05\x12 \n" +
+	"\x1cMARKUP_NODE_TYPE_INLINE_CODE\x10\x06\x12\x1f\n" +
+	"\x1bMARKUP_NODE_TYPE_CODE_BLOCK\x10\a\x12\x1a\n" +
+	"\x16MARKUP_NODE_TYPE_QUOTE\x10\b\x12\x19\n" +
+	"\x15MARKUP_NODE_TYPE_LINK\x10\t\x12!\n" +
+	"\x1dMARKUP_NODE_TYPE_USER_MENTION\x10\n" +
+	"\x12!\n" +
+	"\x1dMARKUP_NODE_TYPE_ROLE_MENTION\x10\v\x12\x1d\n" +
+	"\x19MARKUP_NODE_TYPE_EVERYONE\x10\f\x12\x19\n" +
+	"\x15MARKUP_NODE_TYPE_HERE\x10\r\x12!\n" +
+	"\x1dMARKUP_NODE_TYPE_CUSTOM_EMOJI\x10\x0e*\xcd\x01\n" +
+	"\x11SystemMessageType\x12#\n" +
+	"\x1fSYSTEM_MESSAGE_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSYSTEM_MESSAGE_TYPE_MEMBER_JOIN\x10\x01\x12$\n" +
+	" SYSTEM_MESSAGE_TYPE_MEMBER_LEAVE\x10\x02\x12#\n" +
+	"\x1fSYSTEM_MESSAGE_TYPE_MEMBER_KICK\x10\x03\x12#\n" +
+	"\x1fSYSTEM_MESSAGE_TYPE_MESSAGE_PIN\x10\x04*\xa1\x01\n" +
+	"\x17InteractionResponseType\x12)\n" +
+	"%INTERACTION_RESPONSE_TYPE_UNSPECIFIED\x10\x00\x12-\n" +
+	")INTERACTION_RESPONSE_TYPE_EPHEMERAL_REPLY\x10\x01\x12,\n" +
+	"(INTERACTION_RESPONSE_TYPE_UPDATE_MESSAGE\x10\x02*\x8d\x01\n" +
+	"\x16ScheduledMessageStatus\x12(\n" +
+	"$SCHEDULED_MESSAGE_STATUS_UNSPECIFIED\x10\x00\x12$\n" +
+	" SCHEDULED_MESSAGE_STATUS_PENDING\x10\x01\x12#\n" +
+	"\x1fSCHEDULED_MESSAGE_STATUS_FAILED\x10\x02*\xc2\x01\n" +
+	"\x10RelationshipType\x12!\n" +
+	"\x1dRELATIONSHIP_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RELATIONSHIP_TYPE_FRIEND\x10\x01\x12&\n" +
+	"\"RELATIONSHIP_TYPE_INCOMING_REQUEST\x10\x02\x12&\n" +
+	"\"RELATIONSHIP_TYPE_OUTGOING_REQUEST\x10\x03\x12\x1d\n" +
+	"\x19RELATIONSHIP_TYPE_BLOCKED\x10\x042\xeb\x01\n" +
 	"\vAuthService\x12G\n" +
 	"\bRegister\x12\x1c.kitsulan.v1.RegisterRequest\x1a\x1d.kitsulan.v1.RegisterResponse\x12>\n" +
 	"\x05Login\x12\x19.kitsulan.v1.LoginRequest\x1a\x1a.kitsulan.v1.LoginResponse\x12S\n" +
@@ -2849,25 +11529,83 @@ const file_kitsulan_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"GetProfile\x12\x1e.kitsulan.v1.GetProfileRequest\x1a\x1f.kitsulan.v1.GetProfileResponse\x12V\n" +
 	"\rUpdateProfile\x12!.kitsulan.v1.UpdateProfileRequest\x1a\".kitsulan.v1.UpdateProfileResponse\x12P\n" +
-	"\vSearchUsers\x12\x1f.kitsulan.v1.SearchUsersRequest\x1a .kitsulan.v1.SearchUsersResponse2\xa0\a\n" +
+	"\vSearchUsers\x12\x1f.kitsulan.v1.SearchUsersRequest\x1a .kitsulan.v1.SearchUsersResponse2\xb1\v\n" +
 	"\fGuildService\x12P\n" +
 	"\vCreateGuild\x12\x1f.kitsulan.v1.CreateGuildRequest\x1a .kitsulan.v1.CreateGuildResponse\x12G\n" +
-	"\bGetGuild\x12\x1c.kitsulan.v1.GetGuildRequest\x1a\x1d.kitsulan.v1.GetGuildResponse\x12S\n" +
+	"\bGetGuild\x12\x1c.kitsulan.v1.GetGuildRequest\x1a\x1d.kitsulan.v1.GetGuildResponse\x12P\n" +
+	"\vUpdateGuild\x12\x1f.kitsulan.v1.UpdateGuildRequest\x1a .kitsulan.v1.UpdateGuildResponse\x12S\n" +
 	"\fListMyGuilds\x12 .kitsulan.v1.ListMyGuildsRequest\x1a!.kitsulan.v1.ListMyGuildsResponse\x12P\n" +
 	"\vDeleteGuild\x12\x1f.kitsulan.v1.DeleteGuildRequest\x1a .kitsulan.v1.DeleteGuildResponse\x12S\n" +
 	"\fCreateInvite\x12 .kitsulan.v1.CreateInviteRequest\x1a!.kitsulan.v1.CreateInviteResponse\x12S\n" +
 	"\fJoinByInvite\x12 .kitsulan.v1.JoinByInviteRequest\x1a!.kitsulan.v1.JoinByInviteResponse\x12M\n" +
 	"\n" +
-	"LeaveGuild\x12\x1e.kitsulan.v1.LeaveGuildRequest\x1a\x1f.kitsulan.v1.LeaveGuildResponse\x12V\n" +
+	"LeaveGuild\x12\x1e.kitsulan.v1.LeaveGuildRequest\x1a\x1f.kitsulan.v1.LeaveGuildResponse\x12M\n" +
+	"\n" +
+	"KickMember\x12\x1e.kitsulan.v1.KickMemberRequest\x1a\x1f.kitsulan.v1.KickMemberResponse\x12V\n" +
 	"\rCreateChannel\x12!.kitsulan.v1.CreateChannelRequest\x1a\".kitsulan.v1.CreateChannelResponse\x12V\n" +
 	"\rDeleteChannel\x12!.kitsulan.v1.DeleteChannelRequest\x1a\".kitsulan.v1.DeleteChannelResponse\x12S\n" +
 	"\fListChannels\x12 .kitsulan.v1.ListChannelsRequest\x1a!.kitsulan.v1.ListChannelsResponse\x12P\n" +
-	"\vListMembers\x12\x1f.kitsulan.v1.ListMembersRequest\x1a .kitsulan.v1.ListMembersResponse2\x82\x02\n" +
+	"\vListMembers\x12\x1f.kitsulan.v1.ListMembersRequest\x1a .kitsulan.v1.ListMembersResponse\x12W\n" +
+	"\x0eUpdateMyMember\x12\".kitsulan.v1.UpdateMyMemberRequest\x1a!.kitsulan.v1.UpdateMemberResponse\x12S\n" +
+	"\fUpdateMember\x12 .kitsulan.v1.UpdateMemberRequest\x1a!.kitsulan.v1.UpdateMemberResponse\x12V\n" +
+	"\rTimeoutMember\x12!.kitsulan.v1.TimeoutMemberRequest\x1a\".kitsulan.v1.TimeoutMemberResponse\x12h\n" +
+	"\x13SetMemberVoiceState\x12'.kitsulan.v1.SetMemberVoiceStateRequest\x1a(.kitsulan.v1.SetMemberVoiceStateResponse2\xe8\x15\n" +
 	"\vChatService\x12P\n" +
 	"\vSendMessage\x12\x1f.kitsulan.v1.SendMessageRequest\x1a .kitsulan.v1.SendMessageResponse\x12M\n" +
 	"\n" +
-	"GetHistory\x12\x1e.kitsulan.v1.GetHistoryRequest\x1a\x1f.kitsulan.v1.GetHistoryResponse\x12R\n" +
-	"\x10SubscribeChannel\x12$.kitsulan.v1.SubscribeChannelRequest\x1a\x16.kitsulan.v1.ChatEvent0\x012\xb8\x01\n" +
+	"GetHistory\x12\x1e.kitsulan.v1.GetHistoryRequest\x1a\x1f.kitsulan.v1.GetHistoryResponse\x12P\n" +
+	"\vSyncChannel\x12\x1f.kitsulan.v1.SyncChannelRequest\x1a .kitsulan.v1.SyncChannelResponse\x12R\n" +
+	"\x10SubscribeChannel\x12$.kitsulan.v1.SubscribeChannelRequest\x1a\x16.kitsulan.v1.ChatEvent0\x01\x12P\n" +
+	"\vEditMessage\x12\x1f.kitsulan.v1.EditMessageRequest\x1a .kitsulan.v1.EditMessageResponse\x12_\n" +
+	"\x10ListMessageEdits\x12$.kitsulan.v1.ListMessageEditsRequest\x1a%.kitsulan.v1.ListMessageEditsResponse\x12V\n" +
+	"\rDeleteMessage\x12!.kitsulan.v1.DeleteMessageRequest\x1a\".kitsulan.v1.DeleteMessageResponse\x12e\n" +
+	"\x12BulkDeleteMessages\x12&.kitsulan.v1.BulkDeleteMessagesRequest\x1a'.kitsulan.v1.BulkDeleteMessagesResponse\x12P\n" +
+	"\vAddReaction\x12\x1f.kitsulan.v1.AddReactionRequest\x1a .kitsulan.v1.AddReactionResponse\x12Y\n" +
+	"\x0eRemoveReaction\x12\".kitsulan.v1.RemoveReactionRequest\x1a#.kitsulan.v1.RemoveReactionResponse\x12e\n" +
+	"\x12RemoveAllReactions\x12&.kitsulan.v1.RemoveAllReactionsRequest\x1a'.kitsulan.v1.RemoveAllReactionsResponse\x12S\n" +
+	"\fListReactors\x12 .kitsulan.v1.ListReactorsRequest\x1a!.kitsulan.v1.ListReactorsResponse\x12M\n" +
+	"\n" +
+	"PinMessage\x12\x1e.kitsulan.v1.PinMessageRequest\x1a\x1f.kitsulan.v1.PinMessageResponse\x12S\n" +
+	"\fUnpinMessage\x12 .kitsulan.v1.UnpinMessageRequest\x1a!.kitsulan.v1.UnpinMessageResponse\x12e\n" +
+	"\x12ListPinnedMessages\x12&.kitsulan.v1.ListPinnedMessagesRequest\x1a'.kitsulan.v1.ListPinnedMessagesResponse\x12M\n" +
+	"\n" +
+	"SendTyping\x12\x1e.kitsulan.v1.SendTypingRequest\x1a\x1f.kitsulan.v1.SendTypingResponse\x128\n" +
+	"\x03Ack\x12\x17.kitsulan.v1.AckRequest\x1a\x18.kitsulan.v1.AckResponse\x12_\n" +
+	"\x10GetUnreadSummary\x12$.kitsulan.v1.GetUnreadSummaryRequest\x1a%.kitsulan.v1.GetUnreadSummaryResponse\x12X\n" +
+	"\x13SubscribeUserEvents\x12'.kitsulan.v1.SubscribeUserEventsRequest\x1a\x16.kitsulan.v1.ChatEvent0\x01\x12e\n" +
+	"\x12ListRecentMentions\x12&.kitsulan.v1.ListRecentMentionsRequest\x1a'.kitsulan.v1.ListRecentMentionsResponse\x12Y\n" +
+	"\x0eSearchMessages\x12\".kitsulan.v1.SearchMessagesRequest\x1a#.kitsulan.v1.SearchMessagesResponse\x12n\n" +
+	"\x15InteractWithComponent\x12).kitsulan.v1.InteractWithComponentRequest\x1a*.kitsulan.v1.InteractWithComponentResponse\x12k\n" +
+	"\x14RespondToInteraction\x12(.kitsulan.v1.RespondToInteractionRequest\x1a).kitsulan.v1.RespondToInteractionResponse\x12k\n" +
+	"\x14SendEphemeralMessage\x12(.kitsulan.v1.SendEphemeralMessageRequest\x1a).kitsulan.v1.SendEphemeralMessageResponse\x12\\\n" +
+	"\x0fScheduleMessage\x12#.kitsulan.v1.ScheduleMessageRequest\x1a$.kitsulan.v1.ScheduleMessageResponse\x12n\n" +
+	"\x15ListScheduledMessages\x12).kitsulan.v1.ListScheduledMessagesRequest\x1a*.kitsulan.v1.ListScheduledMessagesResponse\x12q\n" +
+	"\x16CancelScheduledMessage\x12*.kitsulan.v1.CancelScheduledMessageRequest\x1a+.kitsulan.v1.CancelScheduledMessageResponse\x12V\n" +
+	"\rFollowChannel\x12!.kitsulan.v1.FollowChannelRequest\x1a\".kitsulan.v1.FollowChannelResponse\x12\\\n" +
+	"\x0fUnfollowChannel\x12#.kitsulan.v1.UnfollowChannelRequest\x1a$.kitsulan.v1.UnfollowChannelResponse\x12e\n" +
+	"\x12ListChannelFollows\x12&.kitsulan.v1.ListChannelFollowsRequest\x1a'.kitsulan.v1.ListChannelFollowsResponse2\xbb\x03\n" +
+	"\rThreadService\x12P\n" +
+	"\vStartThread\x12\x1f.kitsulan.v1.StartThreadRequest\x1a .kitsulan.v1.StartThreadResponse\x12M\n" +
+	"\n" +
+	"JoinThread\x12\x1e.kitsulan.v1.JoinThreadRequest\x1a\x1f.kitsulan.v1.JoinThreadResponse\x12P\n" +
+	"\vLeaveThread\x12\x1f.kitsulan.v1.LeaveThreadRequest\x1a .kitsulan.v1.LeaveThreadResponse\x12S\n" +
+	"\fUpdateThread\x12 .kitsulan.v1.UpdateThreadRequest\x1a!.kitsulan.v1.UpdateThreadResponse\x12b\n" +
+	"\x11ListActiveThreads\x12%.kitsulan.v1.ListActiveThreadsRequest\x1a&.kitsulan.v1.ListActiveThreadsResponse2\xb1\x03\n" +
+	"\tDMService\x12A\n" +
+	"\x06OpenDM\x12\x1a.kitsulan.v1.OpenDMRequest\x1a\x1b.kitsulan.v1.OpenDMResponse\x12J\n" +
+	"\tListMyDMs\x12\x1d.kitsulan.v1.ListMyDMsRequest\x1a\x1e.kitsulan.v1.ListMyDMsResponse\x12Y\n" +
+	"\x0eAddDMRecipient\x12\".kitsulan.v1.AddDMRecipientRequest\x1a#.kitsulan.v1.AddDMRecipientResponse\x12b\n" +
+	"\x11RemoveDMRecipient\x12%.kitsulan.v1.RemoveDMRecipientRequest\x1a&.kitsulan.v1.RemoveDMRecipientResponse\x12V\n" +
+	"\rRenameGroupDM\x12!.kitsulan.v1.RenameGroupDMRequest\x1a\".kitsulan.v1.RenameGroupDMResponse2\x91\x06\n" +
+	"\x13RelationshipService\x12b\n" +
+	"\x11ListRelationships\x12%.kitsulan.v1.ListRelationshipsRequest\x1a&.kitsulan.v1.ListRelationshipsResponse\x12b\n" +
+	"\x11SendFriendRequest\x12%.kitsulan.v1.SendFriendRequestRequest\x1a&.kitsulan.v1.SendFriendRequestResponse\x12h\n" +
+	"\x13AcceptFriendRequest\x12'.kitsulan.v1.AcceptFriendRequestRequest\x1a(.kitsulan.v1.AcceptFriendRequestResponse\x12k\n" +
+	"\x14DeclineFriendRequest\x12(.kitsulan.v1.DeclineFriendRequestRequest\x1a).kitsulan.v1.DeclineFriendRequestResponse\x12h\n" +
+	"\x13CancelFriendRequest\x12'.kitsulan.v1.CancelFriendRequestRequest\x1a(.kitsulan.v1.CancelFriendRequestResponse\x12S\n" +
+	"\fRemoveFriend\x12 .kitsulan.v1.RemoveFriendRequest\x1a!.kitsulan.v1.RemoveFriendResponse\x12J\n" +
+	"\tBlockUser\x12\x1d.kitsulan.v1.BlockUserRequest\x1a\x1e.kitsulan.v1.BlockUserResponse\x12P\n" +
+	"\vUnblockUser\x12\x1f.kitsulan.v1.UnblockUserRequest\x1a .kitsulan.v1.UnblockUserResponse2\xb8\x01\n" +
 	"\fRealmService\x12M\n" +
 	"\n" +
 	"SetupRealm\x12\x1e.kitsulan.v1.SetupRealmRequest\x1a\x1f.kitsulan.v1.SetupRealmResponse\x12Y\n" +
@@ -2886,132 +11624,465 @@ func file_kitsulan_v1_service_proto_rawDescGZIP() []byte {
 	return file_kitsulan_v1_service_proto_rawDescData
 }
 
-var file_kitsulan_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_kitsulan_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_kitsulan_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_kitsulan_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 177)
 var file_kitsulan_v1_service_proto_goTypes = []any{
-	(ChannelType)(0),                // 0: kitsulan.v1.ChannelType
-	(*User)(nil),                    // 1: kitsulan.v1.User
-	(*RegisterRequest)(nil),         // 2: kitsulan.v1.RegisterRequest
-	(*RegisterResponse)(nil),        // 3: kitsulan.v1.RegisterResponse
-	(*LoginRequest)(nil),            // 4: kitsulan.v1.LoginRequest
-	(*LoginResponse)(nil),           // 5: kitsulan.v1.LoginResponse
-	(*RefreshTokenRequest)(nil),     // 6: kitsulan.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),    // 7: kitsulan.v1.RefreshTokenResponse
-	(*GetProfileRequest)(nil),       // 8: kitsulan.v1.GetProfileRequest
-	(*GetProfileResponse)(nil),      // 9: kitsulan.v1.GetProfileResponse
-	(*UpdateProfileRequest)(nil),    // 10: kitsulan.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),   // 11: kitsulan.v1.UpdateProfileResponse
-	(*SearchUsersRequest)(nil),      // 12: kitsulan.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),     // 13: kitsulan.v1.SearchUsersResponse
-	(*Guild)(nil),                   // 14: kitsulan.v1.Guild
-	(*Channel)(nil),                 // 15: kitsulan.v1.Channel
-	(*Member)(nil),                  // 16: kitsulan.v1.Member
-	(*CreateGuildRequest)(nil),      // 17: kitsulan.v1.CreateGuildRequest
-	(*CreateGuildResponse)(nil),     // 18: kitsulan.v1.CreateGuildResponse
-	(*GetGuildRequest)(nil),         // 19: kitsulan.v1.GetGuildRequest
-	(*GetGuildResponse)(nil),        // 20: kitsulan.v1.GetGuildResponse
-	(*ListMyGuildsRequest)(nil),     // 21: kitsulan.v1.ListMyGuildsRequest
-	(*ListMyGuildsResponse)(nil),    // 22: kitsulan.v1.ListMyGuildsResponse
-	(*DeleteGuildRequest)(nil),      // 23: kitsulan.v1.DeleteGuildRequest
-	(*DeleteGuildResponse)(nil),     // 24: kitsulan.v1.DeleteGuildResponse
-	(*CreateInviteRequest)(nil),     // 25: kitsulan.v1.CreateInviteRequest
-	(*CreateInviteResponse)(nil),    // 26: kitsulan.v1.CreateInviteResponse
-	(*JoinByInviteRequest)(nil),     // 27: kitsulan.v1.JoinByInviteRequest
-	(*JoinByInviteResponse)(nil),    // 28: kitsulan.v1.JoinByInviteResponse
-	(*LeaveGuildRequest)(nil),       // 29: kitsulan.v1.LeaveGuildRequest
-	(*LeaveGuildResponse)(nil),      // 30: kitsulan.v1.LeaveGuildResponse
-	(*CreateChannelRequest)(nil),    // 31: kitsulan.v1.CreateChannelRequest
-	(*CreateChannelResponse)(nil),   // 32: kitsulan.v1.CreateChannelResponse
-	(*DeleteChannelRequest)(nil),    // 33: kitsulan.v1.DeleteChannelRequest
-	(*DeleteChannelResponse)(nil),   // 34: kitsulan.v1.DeleteChannelResponse
-	(*ListChannelsRequest)(nil),     // 35: kitsulan.v1.ListChannelsRequest
-	(*ListChannelsResponse)(nil),    // 36: kitsulan.v1.ListChannelsResponse
-	(*ListMembersRequest)(nil),      // 37: kitsulan.v1.ListMembersRequest
-	(*ListMembersResponse)(nil),     // 38: kitsulan.v1.ListMembersResponse
-	(*ChatMessage)(nil),             // 39: kitsulan.v1.ChatMessage
-	(*ChatEvent)(nil),               // 40: kitsulan.v1.ChatEvent
-	(*MessageDeleted)(nil),          // 41: kitsulan.v1.MessageDeleted
-	(*SendMessageRequest)(nil),      // 42: kitsulan.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),     // 43: kitsulan.v1.SendMessageResponse
-	(*GetHistoryRequest)(nil),       // 44: kitsulan.v1.GetHistoryRequest
-	(*GetHistoryResponse)(nil),      // 45: kitsulan.v1.GetHistoryResponse
-	(*SubscribeChannelRequest)(nil), // 46: kitsulan.v1.SubscribeChannelRequest
-	(*SetupRealmRequest)(nil),       // 47: kitsulan.v1.SetupRealmRequest
-	(*SetupRealmResponse)(nil),      // 48: kitsulan.v1.SetupRealmResponse
-	(*GetRealmStatusRequest)(nil),   // 49: kitsulan.v1.GetRealmStatusRequest
-	(*GetRealmStatusResponse)(nil),  // 50: kitsulan.v1.GetRealmStatusResponse
-	(*timestamppb.Timestamp)(nil),   // 51: google.protobuf.Timestamp
+	(DMPolicy)(0),                          // 0: kitsulan.v1.DMPolicy
+	(ChannelType)(0),                       // 1: kitsulan.v1.ChannelType
+	(ComponentType)(0),                     // 2: kitsulan.v1.ComponentType
+	(ButtonStyle)(0),                       // 3: kitsulan.v1.ButtonStyle
+	(EmbedType)(0),                         // 4: kitsulan.v1.EmbedType
+	(MarkupNodeType)(0),                    // 5: kitsulan.v1.MarkupNodeType
+	(SystemMessageType)(0),                 // 6: kitsulan.v1.SystemMessageType
+	(InteractionResponseType)(0),           // 7: kitsulan.v1.InteractionResponseType
+	(ScheduledMessageStatus)(0),            // 8: kitsulan.v1.ScheduledMessageStatus
+	(RelationshipType)(0),                  // 9: kitsulan.v1.RelationshipType
+	(*User)(nil),                           // 10: kitsulan.v1.User
+	(*RegisterRequest)(nil),                // 11: kitsulan.v1.RegisterRequest
+	(*RegisterResponse)(nil),               // 12: kitsulan.v1.RegisterResponse
+	(*LoginRequest)(nil),                   // 13: kitsulan.v1.LoginRequest
+	(*LoginResponse)(nil),                  // 14: kitsulan.v1.LoginResponse
+	(*RefreshTokenRequest)(nil),            // 15: kitsulan.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),           // 16: kitsulan.v1.RefreshTokenResponse
+	(*GetProfileRequest)(nil),              // 17: kitsulan.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),             // 18: kitsulan.v1.GetProfileResponse
+	(*UpdateProfileRequest)(nil),           // 19: kitsulan.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),          // 20: kitsulan.v1.UpdateProfileResponse
+	(*SearchUsersRequest)(nil),             // 21: kitsulan.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),            // 22: kitsulan.v1.SearchUsersResponse
+	(*Guild)(nil),                          // 23: kitsulan.v1.Guild
+	(*Channel)(nil),                        // 24: kitsulan.v1.Channel
+	(*Member)(nil),                         // 25: kitsulan.v1.Member
+	(*CreateGuildRequest)(nil),             // 26: kitsulan.v1.CreateGuildRequest
+	(*CreateGuildResponse)(nil),            // 27: kitsulan.v1.CreateGuildResponse
+	(*GetGuildRequest)(nil),                // 28: kitsulan.v1.GetGuildRequest
+	(*GetGuildResponse)(nil),               // 29: kitsulan.v1.GetGuildResponse
+	(*UpdateGuildRequest)(nil),             // 30: kitsulan.v1.UpdateGuildRequest
+	(*UpdateGuildResponse)(nil),            // 31: kitsulan.v1.UpdateGuildResponse
+	(*ListMyGuildsRequest)(nil),            // 32: kitsulan.v1.ListMyGuildsRequest
+	(*ListMyGuildsResponse)(nil),           // 33: kitsulan.v1.ListMyGuildsResponse
+	(*DeleteGuildRequest)(nil),             // 34: kitsulan.v1.DeleteGuildRequest
+	(*DeleteGuildResponse)(nil),            // 35: kitsulan.v1.DeleteGuildResponse
+	(*CreateInviteRequest)(nil),            // 36: kitsulan.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),           // 37: kitsulan.v1.CreateInviteResponse
+	(*JoinByInviteRequest)(nil),            // 38: kitsulan.v1.JoinByInviteRequest
+	(*JoinByInviteResponse)(nil),           // 39: kitsulan.v1.JoinByInviteResponse
+	(*LeaveGuildRequest)(nil),              // 40: kitsulan.v1.LeaveGuildRequest
+	(*LeaveGuildResponse)(nil),             // 41: kitsulan.v1.LeaveGuildResponse
+	(*KickMemberRequest)(nil),              // 42: kitsulan.v1.KickMemberRequest
+	(*KickMemberResponse)(nil),             // 43: kitsulan.v1.KickMemberResponse
+	(*CreateChannelRequest)(nil),           // 44: kitsulan.v1.CreateChannelRequest
+	(*CreateChannelResponse)(nil),          // 45: kitsulan.v1.CreateChannelResponse
+	(*DeleteChannelRequest)(nil),           // 46: kitsulan.v1.DeleteChannelRequest
+	(*DeleteChannelResponse)(nil),          // 47: kitsulan.v1.DeleteChannelResponse
+	(*ListChannelsRequest)(nil),            // 48: kitsulan.v1.ListChannelsRequest
+	(*ListChannelsResponse)(nil),           // 49: kitsulan.v1.ListChannelsResponse
+	(*ListMembersRequest)(nil),             // 50: kitsulan.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),            // 51: kitsulan.v1.ListMembersResponse
+	(*UpdateMyMemberRequest)(nil),          // 52: kitsulan.v1.UpdateMyMemberRequest
+	(*UpdateMemberRequest)(nil),            // 53: kitsulan.v1.UpdateMemberRequest
+	(*UpdateMemberResponse)(nil),           // 54: kitsulan.v1.UpdateMemberResponse
+	(*TimeoutMemberRequest)(nil),           // 55: kitsulan.v1.TimeoutMemberRequest
+	(*TimeoutMemberResponse)(nil),          // 56: kitsulan.v1.TimeoutMemberResponse
+	(*SetMemberVoiceStateRequest)(nil),     // 57: kitsulan.v1.SetMemberVoiceStateRequest
+	(*SetMemberVoiceStateResponse)(nil),    // 58: kitsulan.v1.SetMemberVoiceStateResponse
+	(*ChatMessage)(nil),                    // 59: kitsulan.v1.ChatMessage
+	(*CrosspostSource)(nil),                // 60: kitsulan.v1.CrosspostSource
+	(*ActionRow)(nil),                      // 61: kitsulan.v1.ActionRow
+	(*Component)(nil),                      // 62: kitsulan.v1.Component
+	(*SelectOption)(nil),                   // 63: kitsulan.v1.SelectOption
+	(*Embed)(nil),                          // 64: kitsulan.v1.Embed
+	(*MarkupNode)(nil),                     // 65: kitsulan.v1.MarkupNode
+	(*MessageReference)(nil),               // 66: kitsulan.v1.MessageReference
+	(*ReactionSummary)(nil),                // 67: kitsulan.v1.ReactionSummary
+	(*SystemMessage)(nil),                  // 68: kitsulan.v1.SystemMessage
+	(*ChatEvent)(nil),                      // 69: kitsulan.v1.ChatEvent
+	(*InteractionCreated)(nil),             // 70: kitsulan.v1.InteractionCreated
+	(*TypingStarted)(nil),                  // 71: kitsulan.v1.TypingStarted
+	(*MessagePinUpdated)(nil),              // 72: kitsulan.v1.MessagePinUpdated
+	(*ReactionEvent)(nil),                  // 73: kitsulan.v1.ReactionEvent
+	(*ReactionsCleared)(nil),               // 74: kitsulan.v1.ReactionsCleared
+	(*MessageDeleted)(nil),                 // 75: kitsulan.v1.MessageDeleted
+	(*MessagesBulkDeleted)(nil),            // 76: kitsulan.v1.MessagesBulkDeleted
+	(*MessageEdit)(nil),                    // 77: kitsulan.v1.MessageEdit
+	(*SendMessageRequest)(nil),             // 78: kitsulan.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),            // 79: kitsulan.v1.SendMessageResponse
+	(*GetHistoryRequest)(nil),              // 80: kitsulan.v1.GetHistoryRequest
+	(*GetHistoryResponse)(nil),             // 81: kitsulan.v1.GetHistoryResponse
+	(*SyncChannelRequest)(nil),             // 82: kitsulan.v1.SyncChannelRequest
+	(*SyncChannelResponse)(nil),            // 83: kitsulan.v1.SyncChannelResponse
+	(*SubscribeChannelRequest)(nil),        // 84: kitsulan.v1.SubscribeChannelRequest
+	(*EditMessageRequest)(nil),             // 85: kitsulan.v1.EditMessageRequest
+	(*EditMessageResponse)(nil),            // 86: kitsulan.v1.EditMessageResponse
+	(*ListMessageEditsRequest)(nil),        // 87: kitsulan.v1.ListMessageEditsRequest
+	(*ListMessageEditsResponse)(nil),       // 88: kitsulan.v1.ListMessageEditsResponse
+	(*DeleteMessageRequest)(nil),           // 89: kitsulan.v1.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),          // 90: kitsulan.v1.DeleteMessageResponse
+	(*BulkDeleteMessagesRequest)(nil),      // 91: kitsulan.v1.BulkDeleteMessagesRequest
+	(*BulkDeleteMessagesResponse)(nil),     // 92: kitsulan.v1.BulkDeleteMessagesResponse
+	(*AddReactionRequest)(nil),             // 93: kitsulan.v1.AddReactionRequest
+	(*AddReactionResponse)(nil),            // 94: kitsulan.v1.AddReactionResponse
+	(*RemoveReactionRequest)(nil),          // 95: kitsulan.v1.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),         // 96: kitsulan.v1.RemoveReactionResponse
+	(*RemoveAllReactionsRequest)(nil),      // 97: kitsulan.v1.RemoveAllReactionsRequest
+	(*RemoveAllReactionsResponse)(nil),     // 98: kitsulan.v1.RemoveAllReactionsResponse
+	(*ListReactorsRequest)(nil),            // 99: kitsulan.v1.ListReactorsRequest
+	(*ListReactorsResponse)(nil),           // 100: kitsulan.v1.ListReactorsResponse
+	(*PinMessageRequest)(nil),              // 101: kitsulan.v1.PinMessageRequest
+	(*PinMessageResponse)(nil),             // 102: kitsulan.v1.PinMessageResponse
+	(*UnpinMessageRequest)(nil),            // 103: kitsulan.v1.UnpinMessageRequest
+	(*UnpinMessageResponse)(nil),           // 104: kitsulan.v1.UnpinMessageResponse
+	(*ListPinnedMessagesRequest)(nil),      // 105: kitsulan.v1.ListPinnedMessagesRequest
+	(*ListPinnedMessagesResponse)(nil),     // 106: kitsulan.v1.ListPinnedMessagesResponse
+	(*SendTypingRequest)(nil),              // 107: kitsulan.v1.SendTypingRequest
+	(*SendTypingResponse)(nil),             // 108: kitsulan.v1.SendTypingResponse
+	(*AckRequest)(nil),                     // 109: kitsulan.v1.AckRequest
+	(*AckResponse)(nil),                    // 110: kitsulan.v1.AckResponse
+	(*GetUnreadSummaryRequest)(nil),        // 111: kitsulan.v1.GetUnreadSummaryRequest
+	(*GetUnreadSummaryResponse)(nil),       // 112: kitsulan.v1.GetUnreadSummaryResponse
+	(*ChannelUnread)(nil),                  // 113: kitsulan.v1.ChannelUnread
+	(*GuildUnread)(nil),                    // 114: kitsulan.v1.GuildUnread
+	(*SubscribeUserEventsRequest)(nil),     // 115: kitsulan.v1.SubscribeUserEventsRequest
+	(*ListRecentMentionsRequest)(nil),      // 116: kitsulan.v1.ListRecentMentionsRequest
+	(*ListRecentMentionsResponse)(nil),     // 117: kitsulan.v1.ListRecentMentionsResponse
+	(*SearchMessagesRequest)(nil),          // 118: kitsulan.v1.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),         // 119: kitsulan.v1.SearchMessagesResponse
+	(*InteractWithComponentRequest)(nil),   // 120: kitsulan.v1.InteractWithComponentRequest
+	(*InteractWithComponentResponse)(nil),  // 121: kitsulan.v1.InteractWithComponentResponse
+	(*RespondToInteractionRequest)(nil),    // 122: kitsulan.v1.RespondToInteractionRequest
+	(*RespondToInteractionResponse)(nil),   // 123: kitsulan.v1.RespondToInteractionResponse
+	(*SendEphemeralMessageRequest)(nil),    // 124: kitsulan.v1.SendEphemeralMessageRequest
+	(*SendEphemeralMessageResponse)(nil),   // 125: kitsulan.v1.SendEphemeralMessageResponse
+	(*ScheduledMessage)(nil),               // 126: kitsulan.v1.ScheduledMessage
+	(*ScheduleMessageRequest)(nil),         // 127: kitsulan.v1.ScheduleMessageRequest
+	(*ScheduleMessageResponse)(nil),        // 128: kitsulan.v1.ScheduleMessageResponse
+	(*ListScheduledMessagesRequest)(nil),   // 129: kitsulan.v1.ListScheduledMessagesRequest
+	(*ListScheduledMessagesResponse)(nil),  // 130: kitsulan.v1.ListScheduledMessagesResponse
+	(*CancelScheduledMessageRequest)(nil),  // 131: kitsulan.v1.CancelScheduledMessageRequest
+	(*CancelScheduledMessageResponse)(nil), // 132: kitsulan.v1.CancelScheduledMessageResponse
+	(*ChannelFollow)(nil),                  // 133: kitsulan.v1.ChannelFollow
+	(*FollowChannelRequest)(nil),           // 134: kitsulan.v1.FollowChannelRequest
+	(*FollowChannelResponse)(nil),          // 135: kitsulan.v1.FollowChannelResponse
+	(*UnfollowChannelRequest)(nil),         // 136: kitsulan.v1.UnfollowChannelRequest
+	(*UnfollowChannelResponse)(nil),        // 137: kitsulan.v1.UnfollowChannelResponse
+	(*ListChannelFollowsRequest)(nil),      // 138: kitsulan.v1.ListChannelFollowsRequest
+	(*ListChannelFollowsResponse)(nil),     // 139: kitsulan.v1.ListChannelFollowsResponse
+	(*ReadStateUpdated)(nil),               // 140: kitsulan.v1.ReadStateUpdated
+	(*Thread)(nil),                         // 141: kitsulan.v1.Thread
+	(*StartThreadRequest)(nil),             // 142: kitsulan.v1.StartThreadRequest
+	(*StartThreadResponse)(nil),            // 143: kitsulan.v1.StartThreadResponse
+	(*JoinThreadRequest)(nil),              // 144: kitsulan.v1.JoinThreadRequest
+	(*JoinThreadResponse)(nil),             // 145: kitsulan.v1.JoinThreadResponse
+	(*LeaveThreadRequest)(nil),             // 146: kitsulan.v1.LeaveThreadRequest
+	(*LeaveThreadResponse)(nil),            // 147: kitsulan.v1.LeaveThreadResponse
+	(*UpdateThreadRequest)(nil),            // 148: kitsulan.v1.UpdateThreadRequest
+	(*UpdateThreadResponse)(nil),           // 149: kitsulan.v1.UpdateThreadResponse
+	(*ListActiveThreadsRequest)(nil),       // 150: kitsulan.v1.ListActiveThreadsRequest
+	(*ListActiveThreadsResponse)(nil),      // 151: kitsulan.v1.ListActiveThreadsResponse
+	(*DMChannel)(nil),                      // 152: kitsulan.v1.DMChannel
+	(*DMChannelRemoved)(nil),               // 153: kitsulan.v1.DMChannelRemoved
+	(*OpenDMRequest)(nil),                  // 154: kitsulan.v1.OpenDMRequest
+	(*OpenDMResponse)(nil),                 // 155: kitsulan.v1.OpenDMResponse
+	(*ListMyDMsRequest)(nil),               // 156: kitsulan.v1.ListMyDMsRequest
+	(*ListMyDMsResponse)(nil),              // 157: kitsulan.v1.ListMyDMsResponse
+	(*AddDMRecipientRequest)(nil),          // 158: kitsulan.v1.AddDMRecipientRequest
+	(*AddDMRecipientResponse)(nil),         // 159: kitsulan.v1.AddDMRecipientResponse
+	(*RemoveDMRecipientRequest)(nil),       // 160: kitsulan.v1.RemoveDMRecipientRequest
+	(*RemoveDMRecipientResponse)(nil),      // 161: kitsulan.v1.RemoveDMRecipientResponse
+	(*RenameGroupDMRequest)(nil),           // 162: kitsulan.v1.RenameGroupDMRequest
+	(*RenameGroupDMResponse)(nil),          // 163: kitsulan.v1.RenameGroupDMResponse
+	(*Relationship)(nil),                   // 164: kitsulan.v1.Relationship
+	(*RelationshipRemoved)(nil),            // 165: kitsulan.v1.RelationshipRemoved
+	(*ListRelationshipsRequest)(nil),       // 166: kitsulan.v1.ListRelationshipsRequest
+	(*ListRelationshipsResponse)(nil),      // 167: kitsulan.v1.ListRelationshipsResponse
+	(*SendFriendRequestRequest)(nil),       // 168: kitsulan.v1.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),      // 169: kitsulan.v1.SendFriendRequestResponse
+	(*AcceptFriendRequestRequest)(nil),     // 170: kitsulan.v1.AcceptFriendRequestRequest
+	(*AcceptFriendRequestResponse)(nil),    // 171: kitsulan.v1.AcceptFriendRequestResponse
+	(*DeclineFriendRequestRequest)(nil),    // 172: kitsulan.v1.DeclineFriendRequestRequest
+	(*DeclineFriendRequestResponse)(nil),   // 173: kitsulan.v1.DeclineFriendRequestResponse
+	(*CancelFriendRequestRequest)(nil),     // 174: kitsulan.v1.CancelFriendRequestRequest
+	(*CancelFriendRequestResponse)(nil),    // 175: kitsulan.v1.CancelFriendRequestResponse
+	(*RemoveFriendRequest)(nil),            // 176: kitsulan.v1.RemoveFriendRequest
+	(*RemoveFriendResponse)(nil),           // 177: kitsulan.v1.RemoveFriendResponse
+	(*BlockUserRequest)(nil),               // 178: kitsulan.v1.BlockUserRequest
+	(*BlockUserResponse)(nil),              // 179: kitsulan.v1.BlockUserResponse
+	(*UnblockUserRequest)(nil),             // 180: kitsulan.v1.UnblockUserRequest
+	(*UnblockUserResponse)(nil),            // 181: kitsulan.v1.UnblockUserResponse
+	(*SetupRealmRequest)(nil),              // 182: kitsulan.v1.SetupRealmRequest
+	(*SetupRealmResponse)(nil),             // 183: kitsulan.v1.SetupRealmResponse
+	(*GetRealmStatusRequest)(nil),          // 184: kitsulan.v1.GetRealmStatusRequest
+	(*GetRealmStatusResponse)(nil),         // 185: kitsulan.v1.GetRealmStatusResponse
+	nil,                                    // 186: kitsulan.v1.SystemMessage.ParamsEntry
+	(*timestamppb.Timestamp)(nil),          // 187: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 188: google.protobuf.FieldMask
 }
 var file_kitsulan_v1_service_proto_depIdxs = []int32{
-	1,  // 0: kitsulan.v1.GetProfileResponse.user:type_name -> kitsulan.v1.User
-	1,  // 1: kitsulan.v1.UpdateProfileResponse.user:type_name -> kitsulan.v1.User
-	1,  // 2: kitsulan.v1.SearchUsersResponse.users:type_name -> kitsulan.v1.User
-	51, // 3: kitsulan.v1.Guild.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: kitsulan.v1.Channel.type:type_name -> kitsulan.v1.ChannelType
-	51, // 5: kitsulan.v1.Member.joined_at:type_name -> google.protobuf.Timestamp
-	14, // 6: kitsulan.v1.CreateGuildResponse.guild:type_name -> kitsulan.v1.Guild
-	14, // 7: kitsulan.v1.GetGuildResponse.guild:type_name -> kitsulan.v1.Guild
-	14, // 8: kitsulan.v1.ListMyGuildsResponse.guilds:type_name -> kitsulan.v1.Guild
-	14, // 9: kitsulan.v1.JoinByInviteResponse.guild:type_name -> kitsulan.v1.Guild
-	0,  // 10: kitsulan.v1.CreateChannelRequest.type:type_name -> kitsulan.v1.ChannelType
-	15, // 11: kitsulan.v1.CreateChannelResponse.channel:type_name -> kitsulan.v1.Channel
-	15, // 12: kitsulan.v1.ListChannelsResponse.channels:type_name -> kitsulan.v1.Channel
-	16, // 13: kitsulan.v1.ListMembersResponse.members:type_name -> kitsulan.v1.Member
-	51, // 14: kitsulan.v1.ChatMessage.created_at:type_name -> google.protobuf.Timestamp
-	51, // 15: kitsulan.v1.ChatMessage.edited_at:type_name -> google.protobuf.Timestamp
-	39, // 16: kitsulan.v1.ChatEvent.message_created:type_name -> kitsulan.v1.ChatMessage
-	41, // 17: kitsulan.v1.ChatEvent.message_deleted:type_name -> kitsulan.v1.MessageDeleted
-	39, // 18: kitsulan.v1.SendMessageResponse.message:type_name -> kitsulan.v1.ChatMessage
-	39, // 19: kitsulan.v1.GetHistoryResponse.messages:type_name -> kitsulan.v1.ChatMessage
-	2,  // 20: kitsulan.v1.AuthService.Register:input_type -> kitsulan.v1.RegisterRequest
-	4,  // 21: kitsulan.v1.AuthService.Login:input_type -> kitsulan.v1.LoginRequest
-	6,  // 22: kitsulan.v1.AuthService.RefreshToken:input_type -> kitsulan.v1.RefreshTokenRequest
-	8,  // 23: kitsulan.v1.UserService.GetProfile:input_type -> kitsulan.v1.GetProfileRequest
-	10, // 24: kitsulan.v1.UserService.UpdateProfile:input_type -> kitsulan.v1.UpdateProfileRequest
-	12, // 25: kitsulan.v1.UserService.SearchUsers:input_type -> kitsulan.v1.SearchUsersRequest
-	17, // 26: kitsulan.v1.GuildService.CreateGuild:input_type -> kitsulan.v1.CreateGuildRequest
-	19, // 27: kitsulan.v1.GuildService.GetGuild:input_type -> kitsulan.v1.GetGuildRequest
-	21, // 28: kitsulan.v1.GuildService.ListMyGuilds:input_type -> kitsulan.v1.ListMyGuildsRequest
-	23, // 29: kitsulan.v1.GuildService.DeleteGuild:input_type -> kitsulan.v1.DeleteGuildRequest
-	25, // 30: kitsulan.v1.GuildService.CreateInvite:input_type -> kitsulan.v1.CreateInviteRequest
-	27, // 31: kitsulan.v1.GuildService.JoinByInvite:input_type -> kitsulan.v1.JoinByInviteRequest
-	29, // 32: kitsulan.v1.GuildService.LeaveGuild:input_type -> kitsulan.v1.LeaveGuildRequest
-	31, // 33: kitsulan.v1.GuildService.CreateChannel:input_type -> kitsulan.v1.CreateChannelRequest
-	33, // 34: kitsulan.v1.GuildService.DeleteChannel:input_type -> kitsulan.v1.DeleteChannelRequest
-	35, // 35: kitsulan.v1.GuildService.ListChannels:input_type -> kitsulan.v1.ListChannelsRequest
-	37, // 36: kitsulan.v1.GuildService.ListMembers:input_type -> kitsulan.v1.ListMembersRequest
-	42, // 37: kitsulan.v1.ChatService.SendMessage:input_type -> kitsulan.v1.SendMessageRequest
-	44, // 38: kitsulan.v1.ChatService.GetHistory:input_type -> kitsulan.v1.GetHistoryRequest
-	46, // 39: kitsulan.v1.ChatService.SubscribeChannel:input_type -> kitsulan.v1.SubscribeChannelRequest
-	47, // 40: kitsulan.v1.RealmService.SetupRealm:input_type -> kitsulan.v1.SetupRealmRequest
-	49, // 41: kitsulan.v1.RealmService.GetRealmStatus:input_type -> kitsulan.v1.GetRealmStatusRequest
-	3,  // 42: kitsulan.v1.AuthService.Register:output_type -> kitsulan.v1.RegisterResponse
-	5,  // 43: kitsulan.v1.AuthService.Login:output_type -> kitsulan.v1.LoginResponse
-	7,  // 44: kitsulan.v1.AuthService.RefreshToken:output_type -> kitsulan.v1.RefreshTokenResponse
-	9,  // 45: kitsulan.v1.UserService.GetProfile:output_type -> kitsulan.v1.GetProfileResponse
-	11, // 46: kitsulan.v1.UserService.UpdateProfile:output_type -> kitsulan.v1.UpdateProfileResponse
-	13, // 47: kitsulan.v1.UserService.SearchUsers:output_type -> kitsulan.v1.SearchUsersResponse
-	18, // 48: kitsulan.v1.GuildService.CreateGuild:output_type -> kitsulan.v1.CreateGuildResponse
-	20, // 49: kitsulan.v1.GuildService.GetGuild:output_type -> kitsulan.v1.GetGuildResponse
-	22, // 50: kitsulan.v1.GuildService.ListMyGuilds:output_type -> kitsulan.v1.ListMyGuildsResponse
-	24, // 51: kitsulan.v1.GuildService.DeleteGuild:output_type -> kitsulan.v1.DeleteGuildResponse
-	26, // 52: kitsulan.v1.GuildService.CreateInvite:output_type -> kitsulan.v1.CreateInviteResponse
-	28, // 53: kitsulan.v1.GuildService.JoinByInvite:output_type -> kitsulan.v1.JoinByInviteResponse
-	30, // 54: kitsulan.v1.GuildService.LeaveGuild:output_type -> kitsulan.v1.LeaveGuildResponse
-	32, // 55: kitsulan.v1.GuildService.CreateChannel:output_type -> kitsulan.v1.CreateChannelResponse
-	34, // 56: kitsulan.v1.GuildService.DeleteChannel:output_type -> kitsulan.v1.DeleteChannelResponse
-	36, // 57: kitsulan.v1.GuildService.ListChannels:output_type -> kitsulan.v1.ListChannelsResponse
-	38, // 58: kitsulan.v1.GuildService.ListMembers:output_type -> kitsulan.v1.ListMembersResponse
-	43, // 59: kitsulan.v1.ChatService.SendMessage:output_type -> kitsulan.v1.SendMessageResponse
-	45, // 60: kitsulan.v1.ChatService.GetHistory:output_type -> kitsulan.v1.GetHistoryResponse
-	40, // 61: kitsulan.v1.ChatService.SubscribeChannel:output_type -> kitsulan.v1.ChatEvent
-	48, // 62: kitsulan.v1.RealmService.SetupRealm:output_type -> kitsulan.v1.SetupRealmResponse
-	50, // 63: kitsulan.v1.RealmService.GetRealmStatus:output_type -> kitsulan.v1.GetRealmStatusResponse
-	42, // [42:64] is the sub-list for method output_type
-	20, // [20:42] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,   // 0: kitsulan.v1.User.dm_policy:type_name -> kitsulan.v1.DMPolicy
+	10,  // 1: kitsulan.v1.GetProfileResponse.user:type_name -> kitsulan.v1.User
+	0,   // 2: kitsulan.v1.UpdateProfileRequest.dm_policy:type_name -> kitsulan.v1.DMPolicy
+	10,  // 3: kitsulan.v1.UpdateProfileResponse.user:type_name -> kitsulan.v1.User
+	10,  // 4: kitsulan.v1.SearchUsersResponse.users:type_name -> kitsulan.v1.User
+	187, // 5: kitsulan.v1.Guild.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 6: kitsulan.v1.Channel.type:type_name -> kitsulan.v1.ChannelType
+	187, // 7: kitsulan.v1.Member.joined_at:type_name -> google.protobuf.Timestamp
+	187, // 8: kitsulan.v1.Member.timeout_until:type_name -> google.protobuf.Timestamp
+	23,  // 9: kitsulan.v1.CreateGuildResponse.guild:type_name -> kitsulan.v1.Guild
+	23,  // 10: kitsulan.v1.GetGuildResponse.guild:type_name -> kitsulan.v1.Guild
+	23,  // 11: kitsulan.v1.UpdateGuildRequest.guild:type_name -> kitsulan.v1.Guild
+	188, // 12: kitsulan.v1.UpdateGuildRequest.update_mask:type_name -> google.protobuf.FieldMask
+	23,  // 13: kitsulan.v1.UpdateGuildResponse.guild:type_name -> kitsulan.v1.Guild
+	23,  // 14: kitsulan.v1.ListMyGuildsResponse.guilds:type_name -> kitsulan.v1.Guild
+	23,  // 15: kitsulan.v1.JoinByInviteResponse.guild:type_name -> kitsulan.v1.Guild
+	1,   // 16: kitsulan.v1.CreateChannelRequest.type:type_name -> kitsulan.v1.ChannelType
+	24,  // 17: kitsulan.v1.CreateChannelResponse.channel:type_name -> kitsulan.v1.Channel
+	24,  // 18: kitsulan.v1.ListChannelsResponse.channels:type_name -> kitsulan.v1.Channel
+	25,  // 19: kitsulan.v1.ListMembersResponse.members:type_name -> kitsulan.v1.Member
+	25,  // 20: kitsulan.v1.UpdateMemberResponse.member:type_name -> kitsulan.v1.Member
+	187, // 21: kitsulan.v1.TimeoutMemberRequest.until:type_name -> google.protobuf.Timestamp
+	25,  // 22: kitsulan.v1.TimeoutMemberResponse.member:type_name -> kitsulan.v1.Member
+	25,  // 23: kitsulan.v1.SetMemberVoiceStateResponse.member:type_name -> kitsulan.v1.Member
+	187, // 24: kitsulan.v1.ChatMessage.created_at:type_name -> google.protobuf.Timestamp
+	187, // 25: kitsulan.v1.ChatMessage.edited_at:type_name -> google.protobuf.Timestamp
+	68,  // 26: kitsulan.v1.ChatMessage.system:type_name -> kitsulan.v1.SystemMessage
+	67,  // 27: kitsulan.v1.ChatMessage.reactions:type_name -> kitsulan.v1.ReactionSummary
+	66,  // 28: kitsulan.v1.ChatMessage.referenced_message:type_name -> kitsulan.v1.MessageReference
+	65,  // 29: kitsulan.v1.ChatMessage.ast:type_name -> kitsulan.v1.MarkupNode
+	64,  // 30: kitsulan.v1.ChatMessage.embeds:type_name -> kitsulan.v1.Embed
+	61,  // 31: kitsulan.v1.ChatMessage.components:type_name -> kitsulan.v1.ActionRow
+	60,  // 32: kitsulan.v1.ChatMessage.crosspost:type_name -> kitsulan.v1.CrosspostSource
+	62,  // 33: kitsulan.v1.ActionRow.components:type_name -> kitsulan.v1.Component
+	2,   // 34: kitsulan.v1.Component.type:type_name -> kitsulan.v1.ComponentType
+	3,   // 35: kitsulan.v1.Component.style:type_name -> kitsulan.v1.ButtonStyle
+	63,  // 36: kitsulan.v1.Component.options:type_name -> kitsulan.v1.SelectOption
+	4,   // 37: kitsulan.v1.Embed.type:type_name -> kitsulan.v1.EmbedType
+	5,   // 38: kitsulan.v1.MarkupNode.type:type_name -> kitsulan.v1.MarkupNodeType
+	65,  // 39: kitsulan.v1.MarkupNode.children:type_name -> kitsulan.v1.MarkupNode
+	6,   // 40: kitsulan.v1.SystemMessage.type:type_name -> kitsulan.v1.SystemMessageType
+	186, // 41: kitsulan.v1.SystemMessage.params:type_name -> kitsulan.v1.SystemMessage.ParamsEntry
+	59,  // 42: kitsulan.v1.ChatEvent.message_created:type_name -> kitsulan.v1.ChatMessage
+	75,  // 43: kitsulan.v1.ChatEvent.message_deleted:type_name -> kitsulan.v1.MessageDeleted
+	23,  // 44: kitsulan.v1.ChatEvent.guild_updated:type_name -> kitsulan.v1.Guild
+	25,  // 45: kitsulan.v1.ChatEvent.member_updated:type_name -> kitsulan.v1.Member
+	59,  // 46: kitsulan.v1.ChatEvent.message_updated:type_name -> kitsulan.v1.ChatMessage
+	76,  // 47: kitsulan.v1.ChatEvent.messages_bulk_deleted:type_name -> kitsulan.v1.MessagesBulkDeleted
+	73,  // 48: kitsulan.v1.ChatEvent.reaction_added:type_name -> kitsulan.v1.ReactionEvent
+	73,  // 49: kitsulan.v1.ChatEvent.reaction_removed:type_name -> kitsulan.v1.ReactionEvent
+	74,  // 50: kitsulan.v1.ChatEvent.reactions_cleared:type_name -> kitsulan.v1.ReactionsCleared
+	141, // 51: kitsulan.v1.ChatEvent.thread_created:type_name -> kitsulan.v1.Thread
+	141, // 52: kitsulan.v1.ChatEvent.thread_updated:type_name -> kitsulan.v1.Thread
+	72,  // 53: kitsulan.v1.ChatEvent.message_pin_updated:type_name -> kitsulan.v1.MessagePinUpdated
+	71,  // 54: kitsulan.v1.ChatEvent.typing_started:type_name -> kitsulan.v1.TypingStarted
+	140, // 55: kitsulan.v1.ChatEvent.read_state_updated:type_name -> kitsulan.v1.ReadStateUpdated
+	70,  // 56: kitsulan.v1.ChatEvent.interaction_created:type_name -> kitsulan.v1.InteractionCreated
+	152, // 57: kitsulan.v1.ChatEvent.dm_channel_updated:type_name -> kitsulan.v1.DMChannel
+	153, // 58: kitsulan.v1.ChatEvent.dm_channel_removed:type_name -> kitsulan.v1.DMChannelRemoved
+	164, // 59: kitsulan.v1.ChatEvent.relationship_updated:type_name -> kitsulan.v1.Relationship
+	165, // 60: kitsulan.v1.ChatEvent.relationship_removed:type_name -> kitsulan.v1.RelationshipRemoved
+	187, // 61: kitsulan.v1.InteractionCreated.expires_at:type_name -> google.protobuf.Timestamp
+	187, // 62: kitsulan.v1.TypingStarted.expires_at:type_name -> google.protobuf.Timestamp
+	187, // 63: kitsulan.v1.MessageEdit.edited_at:type_name -> google.protobuf.Timestamp
+	61,  // 64: kitsulan.v1.SendMessageRequest.components:type_name -> kitsulan.v1.ActionRow
+	59,  // 65: kitsulan.v1.SendMessageResponse.message:type_name -> kitsulan.v1.ChatMessage
+	59,  // 66: kitsulan.v1.GetHistoryResponse.messages:type_name -> kitsulan.v1.ChatMessage
+	59,  // 67: kitsulan.v1.SyncChannelResponse.messages:type_name -> kitsulan.v1.ChatMessage
+	59,  // 68: kitsulan.v1.EditMessageResponse.message:type_name -> kitsulan.v1.ChatMessage
+	77,  // 69: kitsulan.v1.ListMessageEditsResponse.edits:type_name -> kitsulan.v1.MessageEdit
+	187, // 70: kitsulan.v1.BulkDeleteMessagesRequest.after:type_name -> google.protobuf.Timestamp
+	187, // 71: kitsulan.v1.BulkDeleteMessagesRequest.before:type_name -> google.protobuf.Timestamp
+	10,  // 72: kitsulan.v1.ListReactorsResponse.users:type_name -> kitsulan.v1.User
+	59,  // 73: kitsulan.v1.ListPinnedMessagesResponse.messages:type_name -> kitsulan.v1.ChatMessage
+	113, // 74: kitsulan.v1.GetUnreadSummaryResponse.channels:type_name -> kitsulan.v1.ChannelUnread
+	114, // 75: kitsulan.v1.GetUnreadSummaryResponse.guilds:type_name -> kitsulan.v1.GuildUnread
+	187, // 76: kitsulan.v1.ListRecentMentionsRequest.before:type_name -> google.protobuf.Timestamp
+	59,  // 77: kitsulan.v1.ListRecentMentionsResponse.messages:type_name -> kitsulan.v1.ChatMessage
+	187, // 78: kitsulan.v1.SearchMessagesRequest.after:type_name -> google.protobuf.Timestamp
+	187, // 79: kitsulan.v1.SearchMessagesRequest.before:type_name -> google.protobuf.Timestamp
+	59,  // 80: kitsulan.v1.SearchMessagesResponse.messages:type_name -> kitsulan.v1.ChatMessage
+	7,   // 81: kitsulan.v1.InteractWithComponentResponse.type:type_name -> kitsulan.v1.InteractionResponseType
+	59,  // 82: kitsulan.v1.InteractWithComponentResponse.message:type_name -> kitsulan.v1.ChatMessage
+	7,   // 83: kitsulan.v1.RespondToInteractionRequest.type:type_name -> kitsulan.v1.InteractionResponseType
+	61,  // 84: kitsulan.v1.RespondToInteractionRequest.components:type_name -> kitsulan.v1.ActionRow
+	59,  // 85: kitsulan.v1.SendEphemeralMessageResponse.message:type_name -> kitsulan.v1.ChatMessage
+	187, // 86: kitsulan.v1.ScheduledMessage.send_at:type_name -> google.protobuf.Timestamp
+	8,   // 87: kitsulan.v1.ScheduledMessage.status:type_name -> kitsulan.v1.ScheduledMessageStatus
+	187, // 88: kitsulan.v1.ScheduledMessage.created_at:type_name -> google.protobuf.Timestamp
+	187, // 89: kitsulan.v1.ScheduleMessageRequest.send_at:type_name -> google.protobuf.Timestamp
+	126, // 90: kitsulan.v1.ScheduleMessageResponse.scheduled_message:type_name -> kitsulan.v1.ScheduledMessage
+	126, // 91: kitsulan.v1.ListScheduledMessagesResponse.scheduled_messages:type_name -> kitsulan.v1.ScheduledMessage
+	187, // 92: kitsulan.v1.ChannelFollow.created_at:type_name -> google.protobuf.Timestamp
+	133, // 93: kitsulan.v1.FollowChannelResponse.follow:type_name -> kitsulan.v1.ChannelFollow
+	133, // 94: kitsulan.v1.ListChannelFollowsResponse.following:type_name -> kitsulan.v1.ChannelFollow
+	133, // 95: kitsulan.v1.ListChannelFollowsResponse.followers:type_name -> kitsulan.v1.ChannelFollow
+	187, // 96: kitsulan.v1.Thread.created_at:type_name -> google.protobuf.Timestamp
+	187, // 97: kitsulan.v1.Thread.archived_at:type_name -> google.protobuf.Timestamp
+	141, // 98: kitsulan.v1.StartThreadResponse.thread:type_name -> kitsulan.v1.Thread
+	141, // 99: kitsulan.v1.UpdateThreadResponse.thread:type_name -> kitsulan.v1.Thread
+	141, // 100: kitsulan.v1.ListActiveThreadsResponse.threads:type_name -> kitsulan.v1.Thread
+	10,  // 101: kitsulan.v1.DMChannel.recipients:type_name -> kitsulan.v1.User
+	187, // 102: kitsulan.v1.DMChannel.created_at:type_name -> google.protobuf.Timestamp
+	152, // 103: kitsulan.v1.OpenDMResponse.channel:type_name -> kitsulan.v1.DMChannel
+	152, // 104: kitsulan.v1.ListMyDMsResponse.channels:type_name -> kitsulan.v1.DMChannel
+	152, // 105: kitsulan.v1.AddDMRecipientResponse.channel:type_name -> kitsulan.v1.DMChannel
+	152, // 106: kitsulan.v1.RenameGroupDMResponse.channel:type_name -> kitsulan.v1.DMChannel
+	10,  // 107: kitsulan.v1.Relationship.user:type_name -> kitsulan.v1.User
+	9,   // 108: kitsulan.v1.Relationship.type:type_name -> kitsulan.v1.RelationshipType
+	187, // 109: kitsulan.v1.Relationship.since:type_name -> google.protobuf.Timestamp
+	164, // 110: kitsulan.v1.ListRelationshipsResponse.relationships:type_name -> kitsulan.v1.Relationship
+	164, // 111: kitsulan.v1.SendFriendRequestResponse.relationship:type_name -> kitsulan.v1.Relationship
+	164, // 112: kitsulan.v1.AcceptFriendRequestResponse.relationship:type_name -> kitsulan.v1.Relationship
+	164, // 113: kitsulan.v1.BlockUserResponse.relationship:type_name -> kitsulan.v1.Relationship
+	11,  // 114: kitsulan.v1.AuthService.Register:input_type -> kitsulan.v1.RegisterRequest
+	13,  // 115: kitsulan.v1.AuthService.Login:input_type -> kitsulan.v1.LoginRequest
+	15,  // 116: kitsulan.v1.AuthService.RefreshToken:input_type -> kitsulan.v1.RefreshTokenRequest
+	17,  // 117: kitsulan.v1.UserService.GetProfile:input_type -> kitsulan.v1.GetProfileRequest
+	19,  // 118: kitsulan.v1.UserService.UpdateProfile:input_type -> kitsulan.v1.UpdateProfileRequest
+	21,  // 119: kitsulan.v1.UserService.SearchUsers:input_type -> kitsulan.v1.SearchUsersRequest
+	26,  // 120: kitsulan.v1.GuildService.CreateGuild:input_type -> kitsulan.v1.CreateGuildRequest
+	28,  // 121: kitsulan.v1.GuildService.GetGuild:input_type -> kitsulan.v1.GetGuildRequest
+	30,  // 122: kitsulan.v1.GuildService.UpdateGuild:input_type -> kitsulan.v1.UpdateGuildRequest
+	32,  // 123: kitsulan.v1.GuildService.ListMyGuilds:input_type -> kitsulan.v1.ListMyGuildsRequest
+	34,  // 124: kitsulan.v1.GuildService.DeleteGuild:input_type -> kitsulan.v1.DeleteGuildRequest
+	36,  // 125: kitsulan.v1.GuildService.CreateInvite:input_type -> kitsulan.v1.CreateInviteRequest
+	38,  // 126: kitsulan.v1.GuildService.JoinByInvite:input_type -> kitsulan.v1.JoinByInviteRequest
+	40,  // 127: kitsulan.v1.GuildService.LeaveGuild:input_type -> kitsulan.v1.LeaveGuildRequest
+	42,  // 128: kitsulan.v1.GuildService.KickMember:input_type -> kitsulan.v1.KickMemberRequest
+	44,  // 129: kitsulan.v1.GuildService.CreateChannel:input_type -> kitsulan.v1.CreateChannelRequest
+	46,  // 130: kitsulan.v1.GuildService.DeleteChannel:input_type -> kitsulan.v1.DeleteChannelRequest
+	48,  // 131: kitsulan.v1.GuildService.ListChannels:input_type -> kitsulan.v1.ListChannelsRequest
+	50,  // 132: kitsulan.v1.GuildService.ListMembers:input_type -> kitsulan.v1.ListMembersRequest
+	52,  // 133: kitsulan.v1.GuildService.UpdateMyMember:input_type -> kitsulan.v1.UpdateMyMemberRequest
+	53,  // 134: kitsulan.v1.GuildService.UpdateMember:input_type -> kitsulan.v1.UpdateMemberRequest
+	55,  // 135: kitsulan.v1.GuildService.TimeoutMember:input_type -> kitsulan.v1.TimeoutMemberRequest
+	57,  // 136: kitsulan.v1.GuildService.SetMemberVoiceState:input_type -> kitsulan.v1.SetMemberVoiceStateRequest
+	78,  // 137: kitsulan.v1.ChatService.SendMessage:input_type -> kitsulan.v1.SendMessageRequest
+	80,  // 138: kitsulan.v1.ChatService.GetHistory:input_type -> kitsulan.v1.GetHistoryRequest
+	82,  // 139: kitsulan.v1.ChatService.SyncChannel:input_type -> kitsulan.v1.SyncChannelRequest
+	84,  // 140: kitsulan.v1.ChatService.SubscribeChannel:input_type -> kitsulan.v1.SubscribeChannelRequest
+	85,  // 141: kitsulan.v1.ChatService.EditMessage:input_type -> kitsulan.v1.EditMessageRequest
+	87,  // 142: kitsulan.v1.ChatService.ListMessageEdits:input_type -> kitsulan.v1.ListMessageEditsRequest
+	89,  // 143: kitsulan.v1.ChatService.DeleteMessage:input_type -> kitsulan.v1.DeleteMessageRequest
+	91,  // 144: kitsulan.v1.ChatService.BulkDeleteMessages:input_type -> kitsulan.v1.BulkDeleteMessagesRequest
+	93,  // 145: kitsulan.v1.ChatService.AddReaction:input_type -> kitsulan.v1.AddReactionRequest
+	95,  // 146: kitsulan.v1.ChatService.RemoveReaction:input_type -> kitsulan.v1.RemoveReactionRequest
+	97,  // 147: kitsulan.v1.ChatService.RemoveAllReactions:input_type -> kitsulan.v1.RemoveAllReactionsRequest
+	99,  // 148: kitsulan.v1.ChatService.ListReactors:input_type -> kitsulan.v1.ListReactorsRequest
+	101, // 149: kitsulan.v1.ChatService.PinMessage:input_type -> kitsulan.v1.PinMessageRequest
+	103, // 150: kitsulan.v1.ChatService.UnpinMessage:input_type -> kitsulan.v1.UnpinMessageRequest
+	105, // 151: kitsulan.v1.ChatService.ListPinnedMessages:input_type -> kitsulan.v1.ListPinnedMessagesRequest
+	107, // 152: kitsulan.v1.ChatService.SendTyping:input_type -> kitsulan.v1.SendTypingRequest
+	109, // 153: kitsulan.v1.ChatService.Ack:input_type -> kitsulan.v1.AckRequest
+	111, // 154: kitsulan.v1.ChatService.GetUnreadSummary:input_type -> kitsulan.v1.GetUnreadSummaryRequest
+	115, // 155: kitsulan.v1.ChatService.SubscribeUserEvents:input_type -> kitsulan.v1.SubscribeUserEventsRequest
+	116, // 156: kitsulan.v1.ChatService.ListRecentMentions:input_type -> kitsulan.v1.ListRecentMentionsRequest
+	118, // 157: kitsulan.v1.ChatService.SearchMessages:input_type -> kitsulan.v1.SearchMessagesRequest
+	120, // 158: kitsulan.v1.ChatService.InteractWithComponent:input_type -> kitsulan.v1.InteractWithComponentRequest
+	122, // 159: kitsulan.v1.ChatService.RespondToInteraction:input_type -> kitsulan.v1.RespondToInteractionRequest
+	124, // 160: kitsulan.v1.ChatService.SendEphemeralMessage:input_type -> kitsulan.v1.SendEphemeralMessageRequest
+	127, // 161: kitsulan.v1.ChatService.ScheduleMessage:input_type -> kitsulan.v1.ScheduleMessageRequest
+	129, // 162: kitsulan.v1.ChatService.ListScheduledMessages:input_type -> kitsulan.v1.ListScheduledMessagesRequest
+	131, // 163: kitsulan.v1.ChatService.CancelScheduledMessage:input_type -> kitsulan.v1.CancelScheduledMessageRequest
+	134, // 164: kitsulan.v1.ChatService.FollowChannel:input_type -> kitsulan.v1.FollowChannelRequest
+	136, // 165: kitsulan.v1.ChatService.UnfollowChannel:input_type -> kitsulan.v1.UnfollowChannelRequest
+	138, // 166: kitsulan.v1.ChatService.ListChannelFollows:input_type -> kitsulan.v1.ListChannelFollowsRequest
+	142, // 167: kitsulan.v1.ThreadService.StartThread:input_type -> kitsulan.v1.StartThreadRequest
+	144, // 168: kitsulan.v1.ThreadService.JoinThread:input_type -> kitsulan.v1.JoinThreadRequest
+	146, // 169: kitsulan.v1.ThreadService.LeaveThread:input_type -> kitsulan.v1.LeaveThreadRequest
+	148, // 170: kitsulan.v1.ThreadService.UpdateThread:input_type -> kitsulan.v1.UpdateThreadRequest
+	150, // 171: kitsulan.v1.ThreadService.ListActiveThreads:input_type -> kitsulan.v1.ListActiveThreadsRequest
+	154, // 172: kitsulan.v1.DMService.OpenDM:input_type -> kitsulan.v1.OpenDMRequest
+	156, // 173: kitsulan.v1.DMService.ListMyDMs:input_type -> kitsulan.v1.ListMyDMsRequest
+	158, // 174: kitsulan.v1.DMService.AddDMRecipient:input_type -> kitsulan.v1.AddDMRecipientRequest
+	160, // 175: kitsulan.v1.DMService.RemoveDMRecipient:input_type -> kitsulan.v1.RemoveDMRecipientRequest
+	162, // 176: kitsulan.v1.DMService.RenameGroupDM:input_type -> kitsulan.v1.RenameGroupDMRequest
+	166, // 177: kitsulan.v1.RelationshipService.ListRelationships:input_type -> kitsulan.v1.ListRelationshipsRequest
+	168, // 178: kitsulan.v1.RelationshipService.SendFriendRequest:input_type -> kitsulan.v1.SendFriendRequestRequest
+	170, // 179: kitsulan.v1.RelationshipService.AcceptFriendRequest:input_type -> kitsulan.v1.AcceptFriendRequestRequest
+	172, // 180: kitsulan.v1.RelationshipService.DeclineFriendRequest:input_type -> kitsulan.v1.DeclineFriendRequestRequest
+	174, // 181: kitsulan.v1.RelationshipService.CancelFriendRequest:input_type -> kitsulan.v1.CancelFriendRequestRequest
+	176, // 182: kitsulan.v1.RelationshipService.RemoveFriend:input_type -> kitsulan.v1.RemoveFriendRequest
+	178, // 183: kitsulan.v1.RelationshipService.BlockUser:input_type -> kitsulan.v1.BlockUserRequest
+	180, // 184: kitsulan.v1.RelationshipService.UnblockUser:input_type -> kitsulan.v1.UnblockUserRequest
+	182, // 185: kitsulan.v1.RealmService.SetupRealm:input_type -> kitsulan.v1.SetupRealmRequest
+	184, // 186: kitsulan.v1.RealmService.GetRealmStatus:input_type -> kitsulan.v1.GetRealmStatusRequest
+	12,  // 187: kitsulan.v1.AuthService.Register:output_type -> kitsulan.v1.RegisterResponse
+	14,  // 188: kitsulan.v1.AuthService.Login:output_type -> kitsulan.v1.LoginResponse
+	16,  // 189: kitsulan.v1.AuthService.RefreshToken:output_type -> kitsulan.v1.RefreshTokenResponse
+	18,  // 190: kitsulan.v1.UserService.GetProfile:output_type -> kitsulan.v1.GetProfileResponse
+	20,  // 191: kitsulan.v1.UserService.UpdateProfile:output_type -> kitsulan.v1.UpdateProfileResponse
+	22,  // 192: kitsulan.v1.UserService.SearchUsers:output_type -> kitsulan.v1.SearchUsersResponse
+	27,  // 193: kitsulan.v1.GuildService.CreateGuild:output_type -> kitsulan.v1.CreateGuildResponse
+	29,  // 194: kitsulan.v1.GuildService.GetGuild:output_type -> kitsulan.v1.GetGuildResponse
+	31,  // 195: kitsulan.v1.GuildService.UpdateGuild:output_type -> kitsulan.v1.UpdateGuildResponse
+	33,  // 196: kitsulan.v1.GuildService.ListMyGuilds:output_type -> kitsulan.v1.ListMyGuildsResponse
+	35,  // 197: kitsulan.v1.GuildService.DeleteGuild:output_type -> kitsulan.v1.DeleteGuildResponse
+	37,  // 198: kitsulan.v1.GuildService.CreateInvite:output_type -> kitsulan.v1.CreateInviteResponse
+	39,  // 199: kitsulan.v1.GuildService.JoinByInvite:output_type -> kitsulan.v1.JoinByInviteResponse
+	41,  // 200: kitsulan.v1.GuildService.LeaveGuild:output_type -> kitsulan.v1.LeaveGuildResponse
+	43,  // 201: kitsulan.v1.GuildService.KickMember:output_type -> kitsulan.v1.KickMemberResponse
+	45,  // 202: kitsulan.v1.GuildService.CreateChannel:output_type -> kitsulan.v1.CreateChannelResponse
+	47,  // 203: kitsulan.v1.GuildService.DeleteChannel:output_type -> kitsulan.v1.DeleteChannelResponse
+	49,  // 204: kitsulan.v1.GuildService.ListChannels:output_type -> kitsulan.v1.ListChannelsResponse
+	51,  // 205: kitsulan.v1.GuildService.ListMembers:output_type -> kitsulan.v1.ListMembersResponse
+	54,  // 206: kitsulan.v1.GuildService.UpdateMyMember:output_type -> kitsulan.v1.UpdateMemberResponse
+	54,  // 207: kitsulan.v1.GuildService.UpdateMember:output_type -> kitsulan.v1.UpdateMemberResponse
+	56,  // 208: kitsulan.v1.GuildService.TimeoutMember:output_type -> kitsulan.v1.TimeoutMemberResponse
+	58,  // 209: kitsulan.v1.GuildService.SetMemberVoiceState:output_type -> kitsulan.v1.SetMemberVoiceStateResponse
+	79,  // 210: kitsulan.v1.ChatService.SendMessage:output_type -> kitsulan.v1.SendMessageResponse
+	81,  // 211: kitsulan.v1.ChatService.GetHistory:output_type -> kitsulan.v1.GetHistoryResponse
+	83,  // 212: kitsulan.v1.ChatService.SyncChannel:output_type -> kitsulan.v1.SyncChannelResponse
+	69,  // 213: kitsulan.v1.ChatService.SubscribeChannel:output_type -> kitsulan.v1.ChatEvent
+	86,  // 214: kitsulan.v1.ChatService.EditMessage:output_type -> kitsulan.v1.EditMessageResponse
+	88,  // 215: kitsulan.v1.ChatService.ListMessageEdits:output_type -> kitsulan.v1.ListMessageEditsResponse
+	90,  // 216: kitsulan.v1.ChatService.DeleteMessage:output_type -> kitsulan.v1.DeleteMessageResponse
+	92,  // 217: kitsulan.v1.ChatService.BulkDeleteMessages:output_type -> kitsulan.v1.BulkDeleteMessagesResponse
+	94,  // 218: kitsulan.v1.ChatService.AddReaction:output_type -> kitsulan.v1.AddReactionResponse
+	96,  // 219: kitsulan.v1.ChatService.RemoveReaction:output_type -> kitsulan.v1.RemoveReactionResponse
+	98,  // 220: kitsulan.v1.ChatService.RemoveAllReactions:output_type -> kitsulan.v1.RemoveAllReactionsResponse
+	100, // 221: kitsulan.v1.ChatService.ListReactors:output_type -> kitsulan.v1.ListReactorsResponse
+	102, // 222: kitsulan.v1.ChatService.PinMessage:output_type -> kitsulan.v1.PinMessageResponse
+	104, // 223: kitsulan.v1.ChatService.UnpinMessage:output_type -> kitsulan.v1.UnpinMessageResponse
+	106, // 224: kitsulan.v1.ChatService.ListPinnedMessages:output_type -> kitsulan.v1.ListPinnedMessagesResponse
+	108, // 225: kitsulan.v1.ChatService.SendTyping:output_type -> kitsulan.v1.SendTypingResponse
+	110, // 226: kitsulan.v1.ChatService.Ack:output_type -> kitsulan.v1.AckResponse
+	112, // 227: kitsulan.v1.ChatService.GetUnreadSummary:output_type -> kitsulan.v1.GetUnreadSummaryResponse
+	69,  // 228: kitsulan.v1.ChatService.SubscribeUserEvents:output_type -> kitsulan.v1.ChatEvent
+	117, // 229: kitsulan.v1.ChatService.ListRecentMentions:output_type -> kitsulan.v1.ListRecentMentionsResponse
+	119, // 230: kitsulan.v1.ChatService.SearchMessages:output_type -> kitsulan.v1.SearchMessagesResponse
+	121, // 231: kitsulan.v1.ChatService.InteractWithComponent:output_type -> kitsulan.v1.InteractWithComponentResponse
+	123, // 232: kitsulan.v1.ChatService.RespondToInteraction:output_type -> kitsulan.v1.RespondToInteractionResponse
+	125, // 233: kitsulan.v1.ChatService.SendEphemeralMessage:output_type -> kitsulan.v1.SendEphemeralMessageResponse
+	128, // 234: kitsulan.v1.ChatService.ScheduleMessage:output_type -> kitsulan.v1.ScheduleMessageResponse
+	130, // 235: kitsulan.v1.ChatService.ListScheduledMessages:output_type -> kitsulan.v1.ListScheduledMessagesResponse
+	132, // 236: kitsulan.v1.ChatService.CancelScheduledMessage:output_type -> kitsulan.v1.CancelScheduledMessageResponse
+	135, // 237: kitsulan.v1.ChatService.FollowChannel:output_type -> kitsulan.v1.FollowChannelResponse
+	137, // 238: kitsulan.v1.ChatService.UnfollowChannel:output_type -> kitsulan.v1.UnfollowChannelResponse
+	139, // 239: kitsulan.v1.ChatService.ListChannelFollows:output_type -> kitsulan.v1.ListChannelFollowsResponse
+	143, // 240: kitsulan.v1.ThreadService.StartThread:output_type -> kitsulan.v1.StartThreadResponse
+	145, // 241: kitsulan.v1.ThreadService.JoinThread:output_type -> kitsulan.v1.JoinThreadResponse
+	147, // 242: kitsulan.v1.ThreadService.LeaveThread:output_type -> kitsulan.v1.LeaveThreadResponse
+	149, // 243: kitsulan.v1.ThreadService.UpdateThread:output_type -> kitsulan.v1.UpdateThreadResponse
+	151, // 244: kitsulan.v1.ThreadService.ListActiveThreads:output_type -> kitsulan.v1.ListActiveThreadsResponse
+	155, // 245: kitsulan.v1.DMService.OpenDM:output_type -> kitsulan.v1.OpenDMResponse
+	157, // 246: kitsulan.v1.DMService.ListMyDMs:output_type -> kitsulan.v1.ListMyDMsResponse
+	159, // 247: kitsulan.v1.DMService.AddDMRecipient:output_type -> kitsulan.v1.AddDMRecipientResponse
+	161, // 248: kitsulan.v1.DMService.RemoveDMRecipient:output_type -> kitsulan.v1.RemoveDMRecipientResponse
+	163, // 249: kitsulan.v1.DMService.RenameGroupDM:output_type -> kitsulan.v1.RenameGroupDMResponse
+	167, // 250: kitsulan.v1.RelationshipService.ListRelationships:output_type -> kitsulan.v1.ListRelationshipsResponse
+	169, // 251: kitsulan.v1.RelationshipService.SendFriendRequest:output_type -> kitsulan.v1.SendFriendRequestResponse
+	171, // 252: kitsulan.v1.RelationshipService.AcceptFriendRequest:output_type -> kitsulan.v1.AcceptFriendRequestResponse
+	173, // 253: kitsulan.v1.RelationshipService.DeclineFriendRequest:output_type -> kitsulan.v1.DeclineFriendRequestResponse
+	175, // 254: kitsulan.v1.RelationshipService.CancelFriendRequest:output_type -> kitsulan.v1.CancelFriendRequestResponse
+	177, // 255: kitsulan.v1.RelationshipService.RemoveFriend:output_type -> kitsulan.v1.RemoveFriendResponse
+	179, // 256: kitsulan.v1.RelationshipService.BlockUser:output_type -> kitsulan.v1.BlockUserResponse
+	181, // 257: kitsulan.v1.RelationshipService.UnblockUser:output_type -> kitsulan.v1.UnblockUserResponse
+	183, // 258: kitsulan.v1.RealmService.SetupRealm:output_type -> kitsulan.v1.SetupRealmResponse
+	185, // 259: kitsulan.v1.RealmService.GetRealmStatus:output_type -> kitsulan.v1.GetRealmStatusResponse
+	187, // [187:260] is the sub-list for method output_type
+	114, // [114:187] is the sub-list for method input_type
+	114, // [114:114] is the sub-list for extension type_name
+	114, // [114:114] is the sub-list for extension extendee
+	0,   // [0:114] is the sub-list for field type_name
 }
 
 func init() { file_kitsulan_v1_service_proto_init() }
@@ -3020,19 +12091,40 @@ func file_kitsulan_v1_service_proto_init() {
 		return
 	}
 	file_kitsulan_v1_service_proto_msgTypes[9].OneofWrappers = []any{}
-	file_kitsulan_v1_service_proto_msgTypes[39].OneofWrappers = []any{
+	file_kitsulan_v1_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_kitsulan_v1_service_proto_msgTypes[43].OneofWrappers = []any{}
+	file_kitsulan_v1_service_proto_msgTypes[47].OneofWrappers = []any{}
+	file_kitsulan_v1_service_proto_msgTypes[59].OneofWrappers = []any{
 		(*ChatEvent_MessageCreated)(nil),
 		(*ChatEvent_MessageDeleted)(nil),
-	}
+		(*ChatEvent_GuildUpdated)(nil),
+		(*ChatEvent_MemberUpdated)(nil),
+		(*ChatEvent_MessageUpdated)(nil),
+		(*ChatEvent_MessagesBulkDeleted)(nil),
+		(*ChatEvent_ReactionAdded)(nil),
+		(*ChatEvent_ReactionRemoved)(nil),
+		(*ChatEvent_ReactionsCleared)(nil),
+		(*ChatEvent_ThreadCreated)(nil),
+		(*ChatEvent_ThreadUpdated)(nil),
+		(*ChatEvent_MessagePinUpdated)(nil),
+		(*ChatEvent_TypingStarted)(nil),
+		(*ChatEvent_ReadStateUpdated)(nil),
+		(*ChatEvent_InteractionCreated)(nil),
+		(*ChatEvent_DmChannelUpdated)(nil),
+		(*ChatEvent_DmChannelRemoved)(nil),
+		(*ChatEvent_RelationshipUpdated)(nil),
+		(*ChatEvent_RelationshipRemoved)(nil),
+	}
+	file_kitsulan_v1_service_proto_msgTypes[138].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kitsulan_v1_service_proto_rawDesc), len(file_kitsulan_v1_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   50,
+			NumEnums:      10,
+			NumMessages:   177,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   8,
 		},
 		GoTypes:           file_kitsulan_v1_service_proto_goTypes,
 		DependencyIndexes: file_kitsulan_v1_service_proto_depIdxs,
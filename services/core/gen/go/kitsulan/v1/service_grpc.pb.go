@@ -387,17 +387,23 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	GuildService_CreateGuild_FullMethodName   = "/kitsulan.v1.GuildService/CreateGuild"
-	GuildService_GetGuild_FullMethodName      = "/kitsulan.v1.GuildService/GetGuild"
-	GuildService_ListMyGuilds_FullMethodName  = "/kitsulan.v1.GuildService/ListMyGuilds"
-	GuildService_DeleteGuild_FullMethodName   = "/kitsulan.v1.GuildService/DeleteGuild"
-	GuildService_CreateInvite_FullMethodName  = "/kitsulan.v1.GuildService/CreateInvite"
-	GuildService_JoinByInvite_FullMethodName  = "/kitsulan.v1.GuildService/JoinByInvite"
-	GuildService_LeaveGuild_FullMethodName    = "/kitsulan.v1.GuildService/LeaveGuild"
-	GuildService_CreateChannel_FullMethodName = "/kitsulan.v1.GuildService/CreateChannel"
-	GuildService_DeleteChannel_FullMethodName = "/kitsulan.v1.GuildService/DeleteChannel"
-	GuildService_ListChannels_FullMethodName  = "/kitsulan.v1.GuildService/ListChannels"
-	GuildService_ListMembers_FullMethodName   = "/kitsulan.v1.GuildService/ListMembers"
+	GuildService_CreateGuild_FullMethodName         = "/kitsulan.v1.GuildService/CreateGuild"
+	GuildService_GetGuild_FullMethodName            = "/kitsulan.v1.GuildService/GetGuild"
+	GuildService_UpdateGuild_FullMethodName         = "/kitsulan.v1.GuildService/UpdateGuild"
+	GuildService_ListMyGuilds_FullMethodName        = "/kitsulan.v1.GuildService/ListMyGuilds"
+	GuildService_DeleteGuild_FullMethodName         = "/kitsulan.v1.GuildService/DeleteGuild"
+	GuildService_CreateInvite_FullMethodName        = "/kitsulan.v1.GuildService/CreateInvite"
+	GuildService_JoinByInvite_FullMethodName        = "/kitsulan.v1.GuildService/JoinByInvite"
+	GuildService_LeaveGuild_FullMethodName          = "/kitsulan.v1.GuildService/LeaveGuild"
+	GuildService_KickMember_FullMethodName          = "/kitsulan.v1.GuildService/KickMember"
+	GuildService_CreateChannel_FullMethodName       = "/kitsulan.v1.GuildService/CreateChannel"
+	GuildService_DeleteChannel_FullMethodName       = "/kitsulan.v1.GuildService/DeleteChannel"
+	GuildService_ListChannels_FullMethodName        = "/kitsulan.v1.GuildService/ListChannels"
+	GuildService_ListMembers_FullMethodName         = "/kitsulan.v1.GuildService/ListMembers"
+	GuildService_UpdateMyMember_FullMethodName      = "/kitsulan.v1.GuildService/UpdateMyMember"
+	GuildService_UpdateMember_FullMethodName        = "/kitsulan.v1.GuildService/UpdateMember"
+	GuildService_TimeoutMember_FullMethodName       = "/kitsulan.v1.GuildService/TimeoutMember"
+	GuildService_SetMemberVoiceState_FullMethodName = "/kitsulan.v1.GuildService/SetMemberVoiceState"
 )
 
 // GuildServiceClient is the client API for GuildService service.
@@ -406,15 +412,30 @@ const (
 type GuildServiceClient interface {
 	CreateGuild(ctx context.Context, in *CreateGuildRequest, opts ...grpc.CallOption) (*CreateGuildResponse, error)
 	GetGuild(ctx context.Context, in *GetGuildRequest, opts ...grpc.CallOption) (*GetGuildResponse, error)
+	// Частичное обновление настроек гильдии (по update_mask).
+	// При конкурентном изменении возвращает CONFLICT.
+	UpdateGuild(ctx context.Context, in *UpdateGuildRequest, opts ...grpc.CallOption) (*UpdateGuildResponse, error)
 	ListMyGuilds(ctx context.Context, in *ListMyGuildsRequest, opts ...grpc.CallOption) (*ListMyGuildsResponse, error)
 	DeleteGuild(ctx context.Context, in *DeleteGuildRequest, opts ...grpc.CallOption) (*DeleteGuildResponse, error)
 	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*CreateInviteResponse, error)
 	JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinByInviteResponse, error)
 	LeaveGuild(ctx context.Context, in *LeaveGuildRequest, opts ...grpc.CallOption) (*LeaveGuildResponse, error)
+	// Исключить участника из гильдии. Требует KICK_MEMBERS.
+	KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error)
 	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error)
 	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
 	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// Изменить свой профиль в гильдии (ник, аватар). Требует CHANGE_NICKNAME.
+	UpdateMyMember(ctx context.Context, in *UpdateMyMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error)
+	// Модерация профиля другого участника. Требует MANAGE_NICKNAMES.
+	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error)
+	// Тайм-аут: до указанного времени участник не может писать, ставить реакции
+	// и говорить в голосе (Member.can_speak). Снимается сам по истечении.
+	// Требует MODERATE_MEMBERS.
+	TimeoutMember(ctx context.Context, in *TimeoutMemberRequest, opts ...grpc.CallOption) (*TimeoutMemberResponse, error)
+	// Серверные mute/deafen (постоянные, до снятия). Требует MUTE_MEMBERS.
+	SetMemberVoiceState(ctx context.Context, in *SetMemberVoiceStateRequest, opts ...grpc.CallOption) (*SetMemberVoiceStateResponse, error)
 }
 
 type guildServiceClient struct {
@@ -445,6 +466,16 @@ func (c *guildServiceClient) GetGuild(ctx context.Context, in *GetGuildRequest,
 	return out, nil
 }
 
+func (c *guildServiceClient) UpdateGuild(ctx context.Context, in *UpdateGuildRequest, opts ...grpc.CallOption) (*UpdateGuildResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGuildResponse)
+	err := c.cc.Invoke(ctx, GuildService_UpdateGuild_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guildServiceClient) ListMyGuilds(ctx context.Context, in *ListMyGuildsRequest, opts ...grpc.CallOption) (*ListMyGuildsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMyGuildsResponse)
@@ -495,6 +526,16 @@ func (c *guildServiceClient) LeaveGuild(ctx context.Context, in *LeaveGuildReque
 	return out, nil
 }
 
+func (c *guildServiceClient) KickMember(ctx context.Context, in *KickMemberRequest, opts ...grpc.CallOption) (*KickMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KickMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_KickMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *guildServiceClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*CreateChannelResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateChannelResponse)
@@ -535,21 +576,76 @@ func (c *guildServiceClient) ListMembers(ctx context.Context, in *ListMembersReq
 	return out, nil
 }
 
+func (c *guildServiceClient) UpdateMyMember(ctx context.Context, in *UpdateMyMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_UpdateMyMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_UpdateMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) TimeoutMember(ctx context.Context, in *TimeoutMemberRequest, opts ...grpc.CallOption) (*TimeoutMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeoutMemberResponse)
+	err := c.cc.Invoke(ctx, GuildService_TimeoutMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *guildServiceClient) SetMemberVoiceState(ctx context.Context, in *SetMemberVoiceStateRequest, opts ...grpc.CallOption) (*SetMemberVoiceStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberVoiceStateResponse)
+	err := c.cc.Invoke(ctx, GuildService_SetMemberVoiceState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GuildServiceServer is the server API for GuildService service.
 // All implementations must embed UnimplementedGuildServiceServer
 // for forward compatibility.
 type GuildServiceServer interface {
 	CreateGuild(context.Context, *CreateGuildRequest) (*CreateGuildResponse, error)
 	GetGuild(context.Context, *GetGuildRequest) (*GetGuildResponse, error)
+	// Частичное обновление настроек гильдии (по update_mask).
+	// При конкурентном изменении возвращает CONFLICT.
+	UpdateGuild(context.Context, *UpdateGuildRequest) (*UpdateGuildResponse, error)
 	ListMyGuilds(context.Context, *ListMyGuildsRequest) (*ListMyGuildsResponse, error)
 	DeleteGuild(context.Context, *DeleteGuildRequest) (*DeleteGuildResponse, error)
 	CreateInvite(context.Context, *CreateInviteRequest) (*CreateInviteResponse, error)
 	JoinByInvite(context.Context, *JoinByInviteRequest) (*JoinByInviteResponse, error)
 	LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error)
+	// Исключить участника из гильдии. Требует KICK_MEMBERS.
+	KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error)
 	CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error)
 	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
 	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
 	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// Изменить свой профиль в гильдии (ник, аватар). Требует CHANGE_NICKNAME.
+	UpdateMyMember(context.Context, *UpdateMyMemberRequest) (*UpdateMemberResponse, error)
+	// Модерация профиля другого участника. Требует MANAGE_NICKNAMES.
+	UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error)
+	// Тайм-аут: до указанного времени участник не может писать, ставить реакции
+	// и говорить в голосе (Member.can_speak). Снимается сам по истечении.
+	// Требует MODERATE_MEMBERS.
+	TimeoutMember(context.Context, *TimeoutMemberRequest) (*TimeoutMemberResponse, error)
+	// Серверные mute/deafen (постоянные, до снятия). Требует MUTE_MEMBERS.
+	SetMemberVoiceState(context.Context, *SetMemberVoiceStateRequest) (*SetMemberVoiceStateResponse, error)
 	mustEmbedUnimplementedGuildServiceServer()
 }
 
@@ -566,6 +662,9 @@ func (UnimplementedGuildServiceServer) CreateGuild(context.Context, *CreateGuild
 func (UnimplementedGuildServiceServer) GetGuild(context.Context, *GetGuildRequest) (*GetGuildResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetGuild not implemented")
 }
+func (UnimplementedGuildServiceServer) UpdateGuild(context.Context, *UpdateGuildRequest) (*UpdateGuildResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGuild not implemented")
+}
 func (UnimplementedGuildServiceServer) ListMyGuilds(context.Context, *ListMyGuildsRequest) (*ListMyGuildsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMyGuilds not implemented")
 }
@@ -581,6 +680,9 @@ func (UnimplementedGuildServiceServer) JoinByInvite(context.Context, *JoinByInvi
 func (UnimplementedGuildServiceServer) LeaveGuild(context.Context, *LeaveGuildRequest) (*LeaveGuildResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LeaveGuild not implemented")
 }
+func (UnimplementedGuildServiceServer) KickMember(context.Context, *KickMemberRequest) (*KickMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method KickMember not implemented")
+}
 func (UnimplementedGuildServiceServer) CreateChannel(context.Context, *CreateChannelRequest) (*CreateChannelResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateChannel not implemented")
 }
@@ -593,6 +695,18 @@ func (UnimplementedGuildServiceServer) ListChannels(context.Context, *ListChanne
 func (UnimplementedGuildServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMembers not implemented")
 }
+func (UnimplementedGuildServiceServer) UpdateMyMember(context.Context, *UpdateMyMemberRequest) (*UpdateMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMyMember not implemented")
+}
+func (UnimplementedGuildServiceServer) UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMember not implemented")
+}
+func (UnimplementedGuildServiceServer) TimeoutMember(context.Context, *TimeoutMemberRequest) (*TimeoutMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TimeoutMember not implemented")
+}
+func (UnimplementedGuildServiceServer) SetMemberVoiceState(context.Context, *SetMemberVoiceStateRequest) (*SetMemberVoiceStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMemberVoiceState not implemented")
+}
 func (UnimplementedGuildServiceServer) mustEmbedUnimplementedGuildServiceServer() {}
 func (UnimplementedGuildServiceServer) testEmbeddedByValue()                      {}
 
@@ -650,6 +764,24 @@ func _GuildService_GetGuild_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuildService_UpdateGuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).UpdateGuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_UpdateGuild_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).UpdateGuild(ctx, req.(*UpdateGuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GuildService_ListMyGuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMyGuildsRequest)
 	if err := dec(in); err != nil {
@@ -740,6 +872,24 @@ func _GuildService_LeaveGuild_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuildService_KickMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).KickMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_KickMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).KickMember(ctx, req.(*KickMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GuildService_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateChannelRequest)
 	if err := dec(in); err != nil {
@@ -812,6 +962,78 @@ func _GuildService_ListMembers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuildService_UpdateMyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).UpdateMyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_UpdateMyMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).UpdateMyMember(ctx, req.(*UpdateMyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).UpdateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_UpdateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).UpdateMember(ctx, req.(*UpdateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_TimeoutMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeoutMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).TimeoutMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_TimeoutMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).TimeoutMember(ctx, req.(*TimeoutMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GuildService_SetMemberVoiceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberVoiceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuildServiceServer).SetMemberVoiceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuildService_SetMemberVoiceState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuildServiceServer).SetMemberVoiceState(ctx, req.(*SetMemberVoiceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GuildService_ServiceDesc is the grpc.ServiceDesc for GuildService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -827,6 +1049,10 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGuild",
 			Handler:    _GuildService_GetGuild_Handler,
 		},
+		{
+			MethodName: "UpdateGuild",
+			Handler:    _GuildService_UpdateGuild_Handler,
+		},
 		{
 			MethodName: "ListMyGuilds",
 			Handler:    _GuildService_ListMyGuilds_Handler,
@@ -847,6 +1073,10 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGuild",
 			Handler:    _GuildService_LeaveGuild_Handler,
 		},
+		{
+			MethodName: "KickMember",
+			Handler:    _GuildService_KickMember_Handler,
+		},
 		{
 			MethodName: "CreateChannel",
 			Handler:    _GuildService_CreateChannel_Handler,
@@ -863,15 +1093,58 @@ var GuildService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMembers",
 			Handler:    _GuildService_ListMembers_Handler,
 		},
+		{
+			MethodName: "UpdateMyMember",
+			Handler:    _GuildService_UpdateMyMember_Handler,
+		},
+		{
+			MethodName: "UpdateMember",
+			Handler:    _GuildService_UpdateMember_Handler,
+		},
+		{
+			MethodName: "TimeoutMember",
+			Handler:    _GuildService_TimeoutMember_Handler,
+		},
+		{
+			MethodName: "SetMemberVoiceState",
+			Handler:    _GuildService_SetMemberVoiceState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kitsulan/v1/service.proto",
 }
 
 const (
-	ChatService_SendMessage_FullMethodName      = "/kitsulan.v1.ChatService/SendMessage"
-	ChatService_GetHistory_FullMethodName       = "/kitsulan.v1.ChatService/GetHistory"
-	ChatService_SubscribeChannel_FullMethodName = "/kitsulan.v1.ChatService/SubscribeChannel"
+	ChatService_SendMessage_FullMethodName            = "/kitsulan.v1.ChatService/SendMessage"
+	ChatService_GetHistory_FullMethodName             = "/kitsulan.v1.ChatService/GetHistory"
+	ChatService_SyncChannel_FullMethodName            = "/kitsulan.v1.ChatService/SyncChannel"
+	ChatService_SubscribeChannel_FullMethodName       = "/kitsulan.v1.ChatService/SubscribeChannel"
+	ChatService_EditMessage_FullMethodName            = "/kitsulan.v1.ChatService/EditMessage"
+	ChatService_ListMessageEdits_FullMethodName       = "/kitsulan.v1.ChatService/ListMessageEdits"
+	ChatService_DeleteMessage_FullMethodName          = "/kitsulan.v1.ChatService/DeleteMessage"
+	ChatService_BulkDeleteMessages_FullMethodName     = "/kitsulan.v1.ChatService/BulkDeleteMessages"
+	ChatService_AddReaction_FullMethodName            = "/kitsulan.v1.ChatService/AddReaction"
+	ChatService_RemoveReaction_FullMethodName         = "/kitsulan.v1.ChatService/RemoveReaction"
+	ChatService_RemoveAllReactions_FullMethodName     = "/kitsulan.v1.ChatService/RemoveAllReactions"
+	ChatService_ListReactors_FullMethodName           = "/kitsulan.v1.ChatService/ListReactors"
+	ChatService_PinMessage_FullMethodName             = "/kitsulan.v1.ChatService/PinMessage"
+	ChatService_UnpinMessage_FullMethodName           = "/kitsulan.v1.ChatService/UnpinMessage"
+	ChatService_ListPinnedMessages_FullMethodName     = "/kitsulan.v1.ChatService/ListPinnedMessages"
+	ChatService_SendTyping_FullMethodName             = "/kitsulan.v1.ChatService/SendTyping"
+	ChatService_Ack_FullMethodName                    = "/kitsulan.v1.ChatService/Ack"
+	ChatService_GetUnreadSummary_FullMethodName       = "/kitsulan.v1.ChatService/GetUnreadSummary"
+	ChatService_SubscribeUserEvents_FullMethodName    = "/kitsulan.v1.ChatService/SubscribeUserEvents"
+	ChatService_ListRecentMentions_FullMethodName     = "/kitsulan.v1.ChatService/ListRecentMentions"
+	ChatService_SearchMessages_FullMethodName         = "/kitsulan.v1.ChatService/SearchMessages"
+	ChatService_InteractWithComponent_FullMethodName  = "/kitsulan.v1.ChatService/InteractWithComponent"
+	ChatService_RespondToInteraction_FullMethodName   = "/kitsulan.v1.ChatService/RespondToInteraction"
+	ChatService_SendEphemeralMessage_FullMethodName   = "/kitsulan.v1.ChatService/SendEphemeralMessage"
+	ChatService_ScheduleMessage_FullMethodName        = "/kitsulan.v1.ChatService/ScheduleMessage"
+	ChatService_ListScheduledMessages_FullMethodName  = "/kitsulan.v1.ChatService/ListScheduledMessages"
+	ChatService_CancelScheduledMessage_FullMethodName = "/kitsulan.v1.ChatService/CancelScheduledMessage"
+	ChatService_FollowChannel_FullMethodName          = "/kitsulan.v1.ChatService/FollowChannel"
+	ChatService_UnfollowChannel_FullMethodName        = "/kitsulan.v1.ChatService/UnfollowChannel"
+	ChatService_ListChannelFollows_FullMethodName     = "/kitsulan.v1.ChatService/ListChannelFollows"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -882,8 +1155,78 @@ type ChatServiceClient interface {
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	// Получить историю сообщений (пагинация курсором)
 	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	// Изменения канала после sync_seq: новые, отредактированные и удалённые
+	// сообщения. Для досинхронизации после переподключения.
+	SyncChannel(ctx context.Context, in *SyncChannelRequest, opts ...grpc.CallOption) (*SyncChannelResponse, error)
 	// Подписаться на real-time события канала (server-streaming)
 	SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error)
+	// Изменить своё сообщение. Предыдущий текст сохраняется в истории правок.
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error)
+	// История правок сообщения. Требует MANAGE_MESSAGES.
+	ListMessageEdits(ctx context.Context, in *ListMessageEditsRequest, opts ...grpc.CallOption) (*ListMessageEditsResponse, error)
+	// Удалить сообщение. Автор — своё, модератор с MANAGE_MESSAGES — любое.
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error)
+	// Массовое удаление (рейды, спам). Требует MANAGE_MESSAGES. Не больше 1000
+	// сообщений: если под фильтр без last_n попадает больше — ошибка, а не обрезка.
+	BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error)
+	// Реакции. Добавление требует ADD_REACTIONS.
+	AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error)
+	// Снять реакцию. Чужую — только с MANAGE_MESSAGES.
+	RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error)
+	// Снять все реакции (или все с одним emoji). Требует MANAGE_MESSAGES.
+	RemoveAllReactions(ctx context.Context, in *RemoveAllReactionsRequest, opts ...grpc.CallOption) (*RemoveAllReactionsResponse, error)
+	// Кто поставил реакцию (пагинация по user_id).
+	ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error)
+	// Закрепы. Требуют MANAGE_MESSAGES, не больше 50 на канал.
+	PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error)
+	UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error)
+	// Закреплённые сообщения канала, последние закреплённые сверху
+	ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error)
+	// Сообщить, что пользователь печатает. Не сохраняется, ограничено по частоте:
+	// клиенту достаточно вызывать раз в несколько секунд, пока идёт набор.
+	// Требует SEND_MESSAGES.
+	SendTyping(ctx context.Context, in *SendTypingRequest, opts ...grpc.CallOption) (*SendTypingResponse, error)
+	// Отметить канал прочитанным до seq (0 — до последнего сообщения).
+	// Позиция только двигается вперёд и синхронизируется между устройствами.
+	Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error)
+	// Непрочитанное вызывающего по каналам и гильдиям
+	GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryRequest, opts ...grpc.CallOption) (*GetUnreadSummaryResponse, error)
+	// Личные события пользователя (read state и т.п.), общие для всех его устройств
+	SubscribeUserEvents(ctx context.Context, in *SubscribeUserEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error)
+	// Сообщения, в которых упомянули вызывающего (лично, ролью или @everyone/@here),
+	// по всем гильдиям, новые сверху
+	ListRecentMentions(ctx context.Context, in *ListRecentMentionsRequest, opts ...grpc.CallOption) (*ListRecentMentionsResponse, error)
+	// Полнотекстовый поиск по гильдии или каналу. Только видимые вызывающему
+	// каналы (с их ветками), удалённые сообщения не ищутся.
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	// Нажать кнопку или выбрать значения в меню сообщения. Взаимодействие уходит
+	// автору сообщения (боту) в SubscribeUserEvents; ответ ждём до 3 секунд.
+	InteractWithComponent(ctx context.Context, in *InteractWithComponentRequest, opts ...grpc.CallOption) (*InteractWithComponentResponse, error)
+	// Ответ бота на interaction_created. Только автор сообщения с токеном взаимодействия.
+	RespondToInteraction(ctx context.Context, in *RespondToInteractionRequest, opts ...grpc.CallOption) (*RespondToInteractionResponse, error)
+	// Сообщение, которое увидит только user_id (в своих подписках на канал).
+	// Не сохраняется в истории. Требует MANAGE_MESSAGES. Если user_id сейчас
+	// не подписан на канал — FAILED_PRECONDITION (RECIPIENT_NOT_CONNECTED);
+	// если не видит канал — INVALID_ARGUMENT.
+	SendEphemeralMessage(ctx context.Context, in *SendEphemeralMessageRequest, opts ...grpc.CallOption) (*SendEphemeralMessageResponse, error)
+	// Запланировать сообщение: в send_at сервер отправит его от имени автора как
+	// обычный SendMessage, права проверяются заново в момент отправки.
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error)
+	// Неотправленные запланированные сообщения канала (ожидающие и неудавшиеся).
+	// Свои; с MANAGE_MESSAGES — всех авторов.
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+	// Отменить ожидающее сообщение или убрать неудавшееся.
+	// Автор — своё, модератор с MANAGE_MESSAGES — любое.
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
+	// Подписать канал на канал объявлений (в том числе другой гильдии): новые
+	// объявления копируются в него с подписью источника, правки и удаления
+	// оригинала доходят до копий. Компоненты остаются только у оригинала.
+	// Требует MANAGE_CHANNELS в гильдии получателя.
+	FollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*FollowChannelResponse, error)
+	// Отписать канал. MANAGE_CHANNELS в гильдии получателя или источника.
+	UnfollowChannel(ctx context.Context, in *UnfollowChannelRequest, opts ...grpc.CallOption) (*UnfollowChannelResponse, error)
+	// На какие каналы подписан канал и кто подписан на него. Требует MANAGE_CHANNELS.
+	ListChannelFollows(ctx context.Context, in *ListChannelFollowsRequest, opts ...grpc.CallOption) (*ListChannelFollowsResponse, error)
 }
 
 type chatServiceClient struct {
@@ -914,6 +1257,16 @@ func (c *chatServiceClient) GetHistory(ctx context.Context, in *GetHistoryReques
 	return out, nil
 }
 
+func (c *chatServiceClient) SyncChannel(ctx context.Context, in *SyncChannelRequest, opts ...grpc.CallOption) (*SyncChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncChannelResponse)
+	err := c.cc.Invoke(ctx, ChatService_SyncChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_SubscribeChannel_FullMethodName, cOpts...)
@@ -933,126 +1286,2064 @@ func (c *chatServiceClient) SubscribeChannel(ctx context.Context, in *SubscribeC
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ChatService_SubscribeChannelClient = grpc.ServerStreamingClient[ChatEvent]
 
-// ChatServiceServer is the server API for ChatService service.
-// All implementations must embed UnimplementedChatServiceServer
-// for forward compatibility.
-type ChatServiceServer interface {
-	// Отправить сообщение в канал
-	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
-	// Получить историю сообщений (пагинация курсором)
-	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
-	// Подписаться на real-time события канала (server-streaming)
-	SubscribeChannel(*SubscribeChannelRequest, grpc.ServerStreamingServer[ChatEvent]) error
-	mustEmbedUnimplementedChatServiceServer()
+func (c *chatServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*EditMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_EditMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedChatServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedChatServiceServer struct{}
-
-func (UnimplementedChatServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SendMessage not implemented")
-}
-func (UnimplementedChatServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetHistory not implemented")
-}
-func (UnimplementedChatServiceServer) SubscribeChannel(*SubscribeChannelRequest, grpc.ServerStreamingServer[ChatEvent]) error {
-	return status.Error(codes.Unimplemented, "method SubscribeChannel not implemented")
+func (c *chatServiceClient) ListMessageEdits(ctx context.Context, in *ListMessageEditsRequest, opts ...grpc.CallOption) (*ListMessageEditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageEditsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListMessageEdits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
-func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ChatServiceServer will
-// result in compilation errors.
-type UnsafeChatServiceServer interface {
-	mustEmbedUnimplementedChatServiceServer()
+func (c *chatServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_DeleteMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
-	// If the following call panics, it indicates UnimplementedChatServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *chatServiceClient) BulkDeleteMessages(ctx context.Context, in *BulkDeleteMessagesRequest, opts ...grpc.CallOption) (*BulkDeleteMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_BulkDeleteMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ChatService_ServiceDesc, srv)
+	return out, nil
 }
 
-func _ChatService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendMessageRequest)
-	if err := dec(in); err != nil {
+func (c *chatServiceClient) AddReaction(ctx context.Context, in *AddReactionRequest, opts ...grpc.CallOption) (*AddReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddReactionResponse)
+	err := c.cc.Invoke(ctx, ChatService_AddReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ChatServiceServer).SendMessage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ChatService_SendMessage_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ChatService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoryRequest)
-	if err := dec(in); err != nil {
+func (c *chatServiceClient) RemoveReaction(ctx context.Context, in *RemoveReactionRequest, opts ...grpc.CallOption) (*RemoveReactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveReactionResponse)
+	err := c.cc.Invoke(ctx, ChatService_RemoveReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ChatServiceServer).GetHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: ChatService_GetHistory_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ChatService_SubscribeChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeChannelRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *chatServiceClient) RemoveAllReactions(ctx context.Context, in *RemoveAllReactionsRequest, opts ...grpc.CallOption) (*RemoveAllReactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveAllReactionsResponse)
+	err := c.cc.Invoke(ctx, ChatService_RemoveAllReactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ChatServiceServer).SubscribeChannel(m, &grpc.GenericServerStream[SubscribeChannelRequest, ChatEvent]{ServerStream: stream})
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ChatService_SubscribeChannelServer = grpc.ServerStreamingServer[ChatEvent]
+func (c *chatServiceClient) ListReactors(ctx context.Context, in *ListReactorsRequest, opts ...grpc.CallOption) (*ListReactorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReactorsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListReactors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) PinMessage(ctx context.Context, in *PinMessageRequest, opts ...grpc.CallOption) (*PinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_PinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnpinMessage(ctx context.Context, in *UnpinMessageRequest, opts ...grpc.CallOption) (*UnpinMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpinMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_UnpinMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListPinnedMessages(ctx context.Context, in *ListPinnedMessagesRequest, opts ...grpc.CallOption) (*ListPinnedMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPinnedMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListPinnedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SendTyping(ctx context.Context, in *SendTypingRequest, opts ...grpc.CallOption) (*SendTypingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendTypingResponse)
+	err := c.cc.Invoke(ctx, ChatService_SendTyping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) Ack(ctx context.Context, in *AckRequest, opts ...grpc.CallOption) (*AckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckResponse)
+	err := c.cc.Invoke(ctx, ChatService_Ack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryRequest, opts ...grpc.CallOption) (*GetUnreadSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadSummaryResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetUnreadSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SubscribeUserEvents(ctx context.Context, in *SubscribeUserEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChatEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[1], ChatService_SubscribeUserEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeUserEventsRequest, ChatEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_SubscribeUserEventsClient = grpc.ServerStreamingClient[ChatEvent]
+
+func (c *chatServiceClient) ListRecentMentions(ctx context.Context, in *ListRecentMentionsRequest, opts ...grpc.CallOption) (*ListRecentMentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecentMentionsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListRecentMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) InteractWithComponent(ctx context.Context, in *InteractWithComponentRequest, opts ...grpc.CallOption) (*InteractWithComponentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InteractWithComponentResponse)
+	err := c.cc.Invoke(ctx, ChatService_InteractWithComponent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RespondToInteraction(ctx context.Context, in *RespondToInteractionRequest, opts ...grpc.CallOption) (*RespondToInteractionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToInteractionResponse)
+	err := c.cc.Invoke(ctx, ChatService_RespondToInteraction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SendEphemeralMessage(ctx context.Context, in *SendEphemeralMessageRequest, opts ...grpc.CallOption) (*SendEphemeralMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEphemeralMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_SendEphemeralMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_ScheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) FollowChannel(ctx context.Context, in *FollowChannelRequest, opts ...grpc.CallOption) (*FollowChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowChannelResponse)
+	err := c.cc.Invoke(ctx, ChatService_FollowChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnfollowChannel(ctx context.Context, in *UnfollowChannelRequest, opts ...grpc.CallOption) (*UnfollowChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowChannelResponse)
+	err := c.cc.Invoke(ctx, ChatService_UnfollowChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListChannelFollows(ctx context.Context, in *ListChannelFollowsRequest, opts ...grpc.CallOption) (*ListChannelFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelFollowsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListChannelFollows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServiceServer is the server API for ChatService service.
+// All implementations must embed UnimplementedChatServiceServer
+// for forward compatibility.
+type ChatServiceServer interface {
+	// Отправить сообщение в канал
+	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
+	// Получить историю сообщений (пагинация курсором)
+	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	// Изменения канала после sync_seq: новые, отредактированные и удалённые
+	// сообщения. Для досинхронизации после переподключения.
+	SyncChannel(context.Context, *SyncChannelRequest) (*SyncChannelResponse, error)
+	// Подписаться на real-time события канала (server-streaming)
+	SubscribeChannel(*SubscribeChannelRequest, grpc.ServerStreamingServer[ChatEvent]) error
+	// Изменить своё сообщение. Предыдущий текст сохраняется в истории правок.
+	EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error)
+	// История правок сообщения. Требует MANAGE_MESSAGES.
+	ListMessageEdits(context.Context, *ListMessageEditsRequest) (*ListMessageEditsResponse, error)
+	// Удалить сообщение. Автор — своё, модератор с MANAGE_MESSAGES — любое.
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error)
+	// Массовое удаление (рейды, спам). Требует MANAGE_MESSAGES. Не больше 1000
+	// сообщений: если под фильтр без last_n попадает больше — ошибка, а не обрезка.
+	BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error)
+	// Реакции. Добавление требует ADD_REACTIONS.
+	AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error)
+	// Снять реакцию. Чужую — только с MANAGE_MESSAGES.
+	RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error)
+	// Снять все реакции (или все с одним emoji). Требует MANAGE_MESSAGES.
+	RemoveAllReactions(context.Context, *RemoveAllReactionsRequest) (*RemoveAllReactionsResponse, error)
+	// Кто поставил реакцию (пагинация по user_id).
+	ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error)
+	// Закрепы. Требуют MANAGE_MESSAGES, не больше 50 на канал.
+	PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error)
+	UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error)
+	// Закреплённые сообщения канала, последние закреплённые сверху
+	ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error)
+	// Сообщить, что пользователь печатает. Не сохраняется, ограничено по частоте:
+	// клиенту достаточно вызывать раз в несколько секунд, пока идёт набор.
+	// Требует SEND_MESSAGES.
+	SendTyping(context.Context, *SendTypingRequest) (*SendTypingResponse, error)
+	// Отметить канал прочитанным до seq (0 — до последнего сообщения).
+	// Позиция только двигается вперёд и синхронизируется между устройствами.
+	Ack(context.Context, *AckRequest) (*AckResponse, error)
+	// Непрочитанное вызывающего по каналам и гильдиям
+	GetUnreadSummary(context.Context, *GetUnreadSummaryRequest) (*GetUnreadSummaryResponse, error)
+	// Личные события пользователя (read state и т.п.), общие для всех его устройств
+	SubscribeUserEvents(*SubscribeUserEventsRequest, grpc.ServerStreamingServer[ChatEvent]) error
+	// Сообщения, в которых упомянули вызывающего (лично, ролью или @everyone/@here),
+	// по всем гильдиям, новые сверху
+	ListRecentMentions(context.Context, *ListRecentMentionsRequest) (*ListRecentMentionsResponse, error)
+	// Полнотекстовый поиск по гильдии или каналу. Только видимые вызывающему
+	// каналы (с их ветками), удалённые сообщения не ищутся.
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	// Нажать кнопку или выбрать значения в меню сообщения. Взаимодействие уходит
+	// автору сообщения (боту) в SubscribeUserEvents; ответ ждём до 3 секунд.
+	InteractWithComponent(context.Context, *InteractWithComponentRequest) (*InteractWithComponentResponse, error)
+	// Ответ бота на interaction_created. Только автор сообщения с токеном взаимодействия.
+	RespondToInteraction(context.Context, *RespondToInteractionRequest) (*RespondToInteractionResponse, error)
+	// Сообщение, которое увидит только user_id (в своих подписках на канал).
+	// Не сохраняется в истории. Требует MANAGE_MESSAGES. Если user_id сейчас
+	// не подписан на канал — FAILED_PRECONDITION (RECIPIENT_NOT_CONNECTED);
+	// если не видит канал — INVALID_ARGUMENT.
+	SendEphemeralMessage(context.Context, *SendEphemeralMessageRequest) (*SendEphemeralMessageResponse, error)
+	// Запланировать сообщение: в send_at сервер отправит его от имени автора как
+	// обычный SendMessage, права проверяются заново в момент отправки.
+	ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error)
+	// Неотправленные запланированные сообщения канала (ожидающие и неудавшиеся).
+	// Свои; с MANAGE_MESSAGES — всех авторов.
+	ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error)
+	// Отменить ожидающее сообщение или убрать неудавшееся.
+	// Автор — своё, модератор с MANAGE_MESSAGES — любое.
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error)
+	// Подписать канал на канал объявлений (в том числе другой гильдии): новые
+	// объявления копируются в него с подписью источника, правки и удаления
+	// оригинала доходят до копий. Компоненты остаются только у оригинала.
+	// Требует MANAGE_CHANNELS в гильдии получателя.
+	FollowChannel(context.Context, *FollowChannelRequest) (*FollowChannelResponse, error)
+	// Отписать канал. MANAGE_CHANNELS в гильдии получателя или источника.
+	UnfollowChannel(context.Context, *UnfollowChannelRequest) (*UnfollowChannelResponse, error)
+	// На какие каналы подписан канал и кто подписан на него. Требует MANAGE_CHANNELS.
+	ListChannelFollows(context.Context, *ListChannelFollowsRequest) (*ListChannelFollowsResponse, error)
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+// UnimplementedChatServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChatServiceServer struct{}
+
+func (UnimplementedChatServiceServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (UnimplementedChatServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (UnimplementedChatServiceServer) SyncChannel(context.Context, *SyncChannelRequest) (*SyncChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncChannel not implemented")
+}
+func (UnimplementedChatServiceServer) SubscribeChannel(*SubscribeChannelRequest, grpc.ServerStreamingServer[ChatEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeChannel not implemented")
+}
+func (UnimplementedChatServiceServer) EditMessage(context.Context, *EditMessageRequest) (*EditMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedChatServiceServer) ListMessageEdits(context.Context, *ListMessageEditsRequest) (*ListMessageEditsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMessageEdits not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedChatServiceServer) BulkDeleteMessages(context.Context, *BulkDeleteMessagesRequest) (*BulkDeleteMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BulkDeleteMessages not implemented")
+}
+func (UnimplementedChatServiceServer) AddReaction(context.Context, *AddReactionRequest) (*AddReactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveReaction(context.Context, *RemoveReactionRequest) (*RemoveReactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveAllReactions(context.Context, *RemoveAllReactionsRequest) (*RemoveAllReactionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveAllReactions not implemented")
+}
+func (UnimplementedChatServiceServer) ListReactors(context.Context, *ListReactorsRequest) (*ListReactorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedChatServiceServer) PinMessage(context.Context, *PinMessageRequest) (*PinMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PinMessage not implemented")
+}
+func (UnimplementedChatServiceServer) UnpinMessage(context.Context, *UnpinMessageRequest) (*UnpinMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpinMessage not implemented")
+}
+func (UnimplementedChatServiceServer) ListPinnedMessages(context.Context, *ListPinnedMessagesRequest) (*ListPinnedMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPinnedMessages not implemented")
+}
+func (UnimplementedChatServiceServer) SendTyping(context.Context, *SendTypingRequest) (*SendTypingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendTyping not implemented")
+}
+func (UnimplementedChatServiceServer) Ack(context.Context, *AckRequest) (*AckResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Ack not implemented")
+}
+func (UnimplementedChatServiceServer) GetUnreadSummary(context.Context, *GetUnreadSummaryRequest) (*GetUnreadSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUnreadSummary not implemented")
+}
+func (UnimplementedChatServiceServer) SubscribeUserEvents(*SubscribeUserEventsRequest, grpc.ServerStreamingServer[ChatEvent]) error {
+	return status.Error(codes.Unimplemented, "method SubscribeUserEvents not implemented")
+}
+func (UnimplementedChatServiceServer) ListRecentMentions(context.Context, *ListRecentMentionsRequest) (*ListRecentMentionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecentMentions not implemented")
+}
+func (UnimplementedChatServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedChatServiceServer) InteractWithComponent(context.Context, *InteractWithComponentRequest) (*InteractWithComponentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InteractWithComponent not implemented")
+}
+func (UnimplementedChatServiceServer) RespondToInteraction(context.Context, *RespondToInteractionRequest) (*RespondToInteractionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RespondToInteraction not implemented")
+}
+func (UnimplementedChatServiceServer) SendEphemeralMessage(context.Context, *SendEphemeralMessageRequest) (*SendEphemeralMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendEphemeralMessage not implemented")
+}
+func (UnimplementedChatServiceServer) ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (UnimplementedChatServiceServer) ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (UnimplementedChatServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedChatServiceServer) FollowChannel(context.Context, *FollowChannelRequest) (*FollowChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FollowChannel not implemented")
+}
+func (UnimplementedChatServiceServer) UnfollowChannel(context.Context, *UnfollowChannelRequest) (*UnfollowChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfollowChannel not implemented")
+}
+func (UnimplementedChatServiceServer) ListChannelFollows(context.Context, *ListChannelFollowsRequest) (*ListChannelFollowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChannelFollows not implemented")
+}
+func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
+func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChatServiceServer will
+// result in compilation errors.
+type UnsafeChatServiceServer interface {
+	mustEmbedUnimplementedChatServiceServer()
+}
+
+func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
+	// If the following call panics, it indicates UnimplementedChatServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChatService_ServiceDesc, srv)
+}
+
+func _ChatService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendMessage(ctx, req.(*SendMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetHistory(ctx, req.(*GetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SyncChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SyncChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SyncChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SyncChannel(ctx, req.(*SyncChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SubscribeChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).SubscribeChannel(m, &grpc.GenericServerStream[SubscribeChannelRequest, ChatEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_SubscribeChannelServer = grpc.ServerStreamingServer[ChatEvent]
+
+func _ChatService_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListMessageEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageEditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListMessageEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListMessageEdits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListMessageEdits(ctx, req.(*ListMessageEditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_BulkDeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).BulkDeleteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_BulkDeleteMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).BulkDeleteMessages(ctx, req.(*BulkDeleteMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AddReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AddReaction(ctx, req.(*AddReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveReaction(ctx, req.(*RemoveReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveAllReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAllReactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveAllReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveAllReactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveAllReactions(ctx, req.(*RemoveAllReactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListReactors(ctx, req.(*ListReactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_PinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).PinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_PinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).PinMessage(ctx, req.(*PinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UnpinMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnpinMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnpinMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnpinMessage(ctx, req.(*UnpinMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListPinnedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinnedMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListPinnedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListPinnedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListPinnedMessages(ctx, req.(*ListPinnedMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SendTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendTyping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendTyping(ctx, req.(*SendTypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_Ack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).Ack(ctx, req.(*AckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetUnreadSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetUnreadSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetUnreadSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetUnreadSummary(ctx, req.(*GetUnreadSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SubscribeUserEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeUserEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChatServiceServer).SubscribeUserEvents(m, &grpc.GenericServerStream[SubscribeUserEventsRequest, ChatEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChatService_SubscribeUserEventsServer = grpc.ServerStreamingServer[ChatEvent]
+
+func _ChatService_ListRecentMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListRecentMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListRecentMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListRecentMentions(ctx, req.(*ListRecentMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_InteractWithComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractWithComponentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).InteractWithComponent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_InteractWithComponent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).InteractWithComponent(ctx, req.(*InteractWithComponentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RespondToInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToInteractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RespondToInteraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RespondToInteraction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RespondToInteraction(ctx, req.(*RespondToInteractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SendEphemeralMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEphemeralMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendEphemeralMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendEphemeralMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendEphemeralMessage(ctx, req.(*SendEphemeralMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ScheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ScheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ScheduleMessage(ctx, req.(*ScheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_FollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).FollowChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_FollowChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).FollowChannel(ctx, req.(*FollowChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UnfollowChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnfollowChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnfollowChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnfollowChannel(ctx, req.(*UnfollowChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListChannelFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListChannelFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListChannelFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListChannelFollows(ctx, req.(*ListChannelFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ChatService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "kitsulan.v1.ChatService",
-	HandlerType: (*ChatServiceServer)(nil),
+var ChatService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kitsulan.v1.ChatService",
+	HandlerType: (*ChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendMessage",
+			Handler:    _ChatService_SendMessage_Handler,
+		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _ChatService_GetHistory_Handler,
+		},
+		{
+			MethodName: "SyncChannel",
+			Handler:    _ChatService_SyncChannel_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _ChatService_EditMessage_Handler,
+		},
+		{
+			MethodName: "ListMessageEdits",
+			Handler:    _ChatService_ListMessageEdits_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _ChatService_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "BulkDeleteMessages",
+			Handler:    _ChatService_BulkDeleteMessages_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _ChatService_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _ChatService_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "RemoveAllReactions",
+			Handler:    _ChatService_RemoveAllReactions_Handler,
+		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _ChatService_ListReactors_Handler,
+		},
+		{
+			MethodName: "PinMessage",
+			Handler:    _ChatService_PinMessage_Handler,
+		},
+		{
+			MethodName: "UnpinMessage",
+			Handler:    _ChatService_UnpinMessage_Handler,
+		},
+		{
+			MethodName: "ListPinnedMessages",
+			Handler:    _ChatService_ListPinnedMessages_Handler,
+		},
+		{
+			MethodName: "SendTyping",
+			Handler:    _ChatService_SendTyping_Handler,
+		},
+		{
+			MethodName: "Ack",
+			Handler:    _ChatService_Ack_Handler,
+		},
+		{
+			MethodName: "GetUnreadSummary",
+			Handler:    _ChatService_GetUnreadSummary_Handler,
+		},
+		{
+			MethodName: "ListRecentMentions",
+			Handler:    _ChatService_ListRecentMentions_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _ChatService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "InteractWithComponent",
+			Handler:    _ChatService_InteractWithComponent_Handler,
+		},
+		{
+			MethodName: "RespondToInteraction",
+			Handler:    _ChatService_RespondToInteraction_Handler,
+		},
+		{
+			MethodName: "SendEphemeralMessage",
+			Handler:    _ChatService_SendEphemeralMessage_Handler,
+		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _ChatService_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _ChatService_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _ChatService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "FollowChannel",
+			Handler:    _ChatService_FollowChannel_Handler,
+		},
+		{
+			MethodName: "UnfollowChannel",
+			Handler:    _ChatService_UnfollowChannel_Handler,
+		},
+		{
+			MethodName: "ListChannelFollows",
+			Handler:    _ChatService_ListChannelFollows_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeChannel",
+			Handler:       _ChatService_SubscribeChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeUserEvents",
+			Handler:       _ChatService_SubscribeUserEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kitsulan/v1/service.proto",
+}
+
+const (
+	ThreadService_StartThread_FullMethodName       = "/kitsulan.v1.ThreadService/StartThread"
+	ThreadService_JoinThread_FullMethodName        = "/kitsulan.v1.ThreadService/JoinThread"
+	ThreadService_LeaveThread_FullMethodName       = "/kitsulan.v1.ThreadService/LeaveThread"
+	ThreadService_UpdateThread_FullMethodName      = "/kitsulan.v1.ThreadService/UpdateThread"
+	ThreadService_ListActiveThreads_FullMethodName = "/kitsulan.v1.ThreadService/ListActiveThreads"
+)
+
+// ThreadServiceClient is the client API for ThreadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Ветки (threads) — дочерние каналы текстового канала со своим Seq и историей.
+// Сообщения в ветке отправляются и читаются через ChatService по ID ветки.
+type ThreadServiceClient interface {
+	// Начать ветку от сообщения или без него (только в канале типа thread_parent)
+	StartThread(ctx context.Context, in *StartThreadRequest, opts ...grpc.CallOption) (*StartThreadResponse, error)
+	// Вступить в ветку: только участники подписываются на её события
+	JoinThread(ctx context.Context, in *JoinThreadRequest, opts ...grpc.CallOption) (*JoinThreadResponse, error)
+	LeaveThread(ctx context.Context, in *LeaveThreadRequest, opts ...grpc.CallOption) (*LeaveThreadResponse, error)
+	// Переименовать/архивировать/закрыть. Автор ветки может переименовать и
+	// архивировать свою, закрывать и трогать закрытые — только MANAGE_THREADS.
+	UpdateThread(ctx context.Context, in *UpdateThreadRequest, opts ...grpc.CallOption) (*UpdateThreadResponse, error)
+	// Неархивированные ветки канала, новые сверху
+	ListActiveThreads(ctx context.Context, in *ListActiveThreadsRequest, opts ...grpc.CallOption) (*ListActiveThreadsResponse, error)
+}
+
+type threadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThreadServiceClient(cc grpc.ClientConnInterface) ThreadServiceClient {
+	return &threadServiceClient{cc}
+}
+
+func (c *threadServiceClient) StartThread(ctx context.Context, in *StartThreadRequest, opts ...grpc.CallOption) (*StartThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartThreadResponse)
+	err := c.cc.Invoke(ctx, ThreadService_StartThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) JoinThread(ctx context.Context, in *JoinThreadRequest, opts ...grpc.CallOption) (*JoinThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinThreadResponse)
+	err := c.cc.Invoke(ctx, ThreadService_JoinThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) LeaveThread(ctx context.Context, in *LeaveThreadRequest, opts ...grpc.CallOption) (*LeaveThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveThreadResponse)
+	err := c.cc.Invoke(ctx, ThreadService_LeaveThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) UpdateThread(ctx context.Context, in *UpdateThreadRequest, opts ...grpc.CallOption) (*UpdateThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateThreadResponse)
+	err := c.cc.Invoke(ctx, ThreadService_UpdateThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) ListActiveThreads(ctx context.Context, in *ListActiveThreadsRequest, opts ...grpc.CallOption) (*ListActiveThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActiveThreadsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_ListActiveThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThreadServiceServer is the server API for ThreadService service.
+// All implementations must embed UnimplementedThreadServiceServer
+// for forward compatibility.
+//
+// Ветки (threads) — дочерние каналы текстового канала со своим Seq и историей.
+// Сообщения в ветке отправляются и читаются через ChatService по ID ветки.
+type ThreadServiceServer interface {
+	// Начать ветку от сообщения или без него (только в канале типа thread_parent)
+	StartThread(context.Context, *StartThreadRequest) (*StartThreadResponse, error)
+	// Вступить в ветку: только участники подписываются на её события
+	JoinThread(context.Context, *JoinThreadRequest) (*JoinThreadResponse, error)
+	LeaveThread(context.Context, *LeaveThreadRequest) (*LeaveThreadResponse, error)
+	// Переименовать/архивировать/закрыть. Автор ветки может переименовать и
+	// архивировать свою, закрывать и трогать закрытые — только MANAGE_THREADS.
+	UpdateThread(context.Context, *UpdateThreadRequest) (*UpdateThreadResponse, error)
+	// Неархивированные ветки канала, новые сверху
+	ListActiveThreads(context.Context, *ListActiveThreadsRequest) (*ListActiveThreadsResponse, error)
+	mustEmbedUnimplementedThreadServiceServer()
+}
+
+// UnimplementedThreadServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedThreadServiceServer struct{}
+
+func (UnimplementedThreadServiceServer) StartThread(context.Context, *StartThreadRequest) (*StartThreadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartThread not implemented")
+}
+func (UnimplementedThreadServiceServer) JoinThread(context.Context, *JoinThreadRequest) (*JoinThreadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinThread not implemented")
+}
+func (UnimplementedThreadServiceServer) LeaveThread(context.Context, *LeaveThreadRequest) (*LeaveThreadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveThread not implemented")
+}
+func (UnimplementedThreadServiceServer) UpdateThread(context.Context, *UpdateThreadRequest) (*UpdateThreadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateThread not implemented")
+}
+func (UnimplementedThreadServiceServer) ListActiveThreads(context.Context, *ListActiveThreadsRequest) (*ListActiveThreadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListActiveThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) mustEmbedUnimplementedThreadServiceServer() {}
+func (UnimplementedThreadServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeThreadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ThreadServiceServer will
+// result in compilation errors.
+type UnsafeThreadServiceServer interface {
+	mustEmbedUnimplementedThreadServiceServer()
+}
+
+func RegisterThreadServiceServer(s grpc.ServiceRegistrar, srv ThreadServiceServer) {
+	// If the following call panics, it indicates UnimplementedThreadServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ThreadService_ServiceDesc, srv)
+}
+
+func _ThreadService_StartThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).StartThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_StartThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).StartThread(ctx, req.(*StartThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_JoinThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).JoinThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_JoinThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).JoinThread(ctx, req.(*JoinThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_LeaveThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).LeaveThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_LeaveThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).LeaveThread(ctx, req.(*LeaveThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_UpdateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).UpdateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_UpdateThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).UpdateThread(ctx, req.(*UpdateThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_ListActiveThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActiveThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).ListActiveThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_ListActiveThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).ListActiveThreads(ctx, req.(*ListActiveThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ThreadService_ServiceDesc is the grpc.ServiceDesc for ThreadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ThreadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kitsulan.v1.ThreadService",
+	HandlerType: (*ThreadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartThread",
+			Handler:    _ThreadService_StartThread_Handler,
+		},
+		{
+			MethodName: "JoinThread",
+			Handler:    _ThreadService_JoinThread_Handler,
+		},
+		{
+			MethodName: "LeaveThread",
+			Handler:    _ThreadService_LeaveThread_Handler,
+		},
+		{
+			MethodName: "UpdateThread",
+			Handler:    _ThreadService_UpdateThread_Handler,
+		},
+		{
+			MethodName: "ListActiveThreads",
+			Handler:    _ThreadService_ListActiveThreads_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kitsulan/v1/service.proto",
+}
+
+const (
+	DMService_OpenDM_FullMethodName            = "/kitsulan.v1.DMService/OpenDM"
+	DMService_ListMyDMs_FullMethodName         = "/kitsulan.v1.DMService/ListMyDMs"
+	DMService_AddDMRecipient_FullMethodName    = "/kitsulan.v1.DMService/AddDMRecipient"
+	DMService_RemoveDMRecipient_FullMethodName = "/kitsulan.v1.DMService/RemoveDMRecipient"
+	DMService_RenameGroupDM_FullMethodName     = "/kitsulan.v1.DMService/RenameGroupDM"
+)
+
+// DMServiceClient is the client API for DMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Личные каналы (dm, group_dm) — каналы без гильдии. Сообщения, история,
+// реакции и подписка работают через ChatService с ID личного канала;
+// новые сообщения дополнительно приходят участникам в SubscribeUserEvents.
+type DMServiceClient interface {
+	// Открыть личный канал. С одним собеседником — dm (повторный вызов вернёт
+	// тот же канал), с несколькими — новый групповой, до 10 участников.
+	OpenDM(ctx context.Context, in *OpenDMRequest, opts ...grpc.CallOption) (*OpenDMResponse, error)
+	// Личные каналы вызывающего, недавно активные сверху
+	ListMyDMs(ctx context.Context, in *ListMyDMsRequest, opts ...grpc.CallOption) (*ListMyDMsResponse, error)
+	// Добавить участника в групповой канал (может любой участник)
+	AddDMRecipient(ctx context.Context, in *AddDMRecipientRequest, opts ...grpc.CallOption) (*AddDMRecipientResponse, error)
+	// Исключить участника (только владелец) или выйти самому
+	RemoveDMRecipient(ctx context.Context, in *RemoveDMRecipientRequest, opts ...grpc.CallOption) (*RemoveDMRecipientResponse, error)
+	// Переименовать групповой канал (может любой участник)
+	RenameGroupDM(ctx context.Context, in *RenameGroupDMRequest, opts ...grpc.CallOption) (*RenameGroupDMResponse, error)
+}
+
+type dMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDMServiceClient(cc grpc.ClientConnInterface) DMServiceClient {
+	return &dMServiceClient{cc}
+}
+
+func (c *dMServiceClient) OpenDM(ctx context.Context, in *OpenDMRequest, opts ...grpc.CallOption) (*OpenDMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenDMResponse)
+	err := c.cc.Invoke(ctx, DMService_OpenDM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMServiceClient) ListMyDMs(ctx context.Context, in *ListMyDMsRequest, opts ...grpc.CallOption) (*ListMyDMsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyDMsResponse)
+	err := c.cc.Invoke(ctx, DMService_ListMyDMs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMServiceClient) AddDMRecipient(ctx context.Context, in *AddDMRecipientRequest, opts ...grpc.CallOption) (*AddDMRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddDMRecipientResponse)
+	err := c.cc.Invoke(ctx, DMService_AddDMRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMServiceClient) RemoveDMRecipient(ctx context.Context, in *RemoveDMRecipientRequest, opts ...grpc.CallOption) (*RemoveDMRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveDMRecipientResponse)
+	err := c.cc.Invoke(ctx, DMService_RemoveDMRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dMServiceClient) RenameGroupDM(ctx context.Context, in *RenameGroupDMRequest, opts ...grpc.CallOption) (*RenameGroupDMResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameGroupDMResponse)
+	err := c.cc.Invoke(ctx, DMService_RenameGroupDM_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DMServiceServer is the server API for DMService service.
+// All implementations must embed UnimplementedDMServiceServer
+// for forward compatibility.
+//
+// Личные каналы (dm, group_dm) — каналы без гильдии. Сообщения, история,
+// реакции и подписка работают через ChatService с ID личного канала;
+// новые сообщения дополнительно приходят участникам в SubscribeUserEvents.
+type DMServiceServer interface {
+	// Открыть личный канал. С одним собеседником — dm (повторный вызов вернёт
+	// тот же канал), с несколькими — новый групповой, до 10 участников.
+	OpenDM(context.Context, *OpenDMRequest) (*OpenDMResponse, error)
+	// Личные каналы вызывающего, недавно активные сверху
+	ListMyDMs(context.Context, *ListMyDMsRequest) (*ListMyDMsResponse, error)
+	// Добавить участника в групповой канал (может любой участник)
+	AddDMRecipient(context.Context, *AddDMRecipientRequest) (*AddDMRecipientResponse, error)
+	// Исключить участника (только владелец) или выйти самому
+	RemoveDMRecipient(context.Context, *RemoveDMRecipientRequest) (*RemoveDMRecipientResponse, error)
+	// Переименовать групповой канал (может любой участник)
+	RenameGroupDM(context.Context, *RenameGroupDMRequest) (*RenameGroupDMResponse, error)
+	mustEmbedUnimplementedDMServiceServer()
+}
+
+// UnimplementedDMServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDMServiceServer struct{}
+
+func (UnimplementedDMServiceServer) OpenDM(context.Context, *OpenDMRequest) (*OpenDMResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenDM not implemented")
+}
+func (UnimplementedDMServiceServer) ListMyDMs(context.Context, *ListMyDMsRequest) (*ListMyDMsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyDMs not implemented")
+}
+func (UnimplementedDMServiceServer) AddDMRecipient(context.Context, *AddDMRecipientRequest) (*AddDMRecipientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddDMRecipient not implemented")
+}
+func (UnimplementedDMServiceServer) RemoveDMRecipient(context.Context, *RemoveDMRecipientRequest) (*RemoveDMRecipientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveDMRecipient not implemented")
+}
+func (UnimplementedDMServiceServer) RenameGroupDM(context.Context, *RenameGroupDMRequest) (*RenameGroupDMResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameGroupDM not implemented")
+}
+func (UnimplementedDMServiceServer) mustEmbedUnimplementedDMServiceServer() {}
+func (UnimplementedDMServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafeDMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DMServiceServer will
+// result in compilation errors.
+type UnsafeDMServiceServer interface {
+	mustEmbedUnimplementedDMServiceServer()
+}
+
+func RegisterDMServiceServer(s grpc.ServiceRegistrar, srv DMServiceServer) {
+	// If the following call panics, it indicates UnimplementedDMServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DMService_ServiceDesc, srv)
+}
+
+func _DMService_OpenDM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMServiceServer).OpenDM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMService_OpenDM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMServiceServer).OpenDM(ctx, req.(*OpenDMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMService_ListMyDMs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyDMsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMServiceServer).ListMyDMs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMService_ListMyDMs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMServiceServer).ListMyDMs(ctx, req.(*ListMyDMsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMService_AddDMRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDMRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMServiceServer).AddDMRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMService_AddDMRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMServiceServer).AddDMRecipient(ctx, req.(*AddDMRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMService_RemoveDMRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDMRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMServiceServer).RemoveDMRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMService_RemoveDMRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMServiceServer).RemoveDMRecipient(ctx, req.(*RemoveDMRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DMService_RenameGroupDM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameGroupDMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DMServiceServer).RenameGroupDM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DMService_RenameGroupDM_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DMServiceServer).RenameGroupDM(ctx, req.(*RenameGroupDMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DMService_ServiceDesc is the grpc.ServiceDesc for DMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kitsulan.v1.DMService",
+	HandlerType: (*DMServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "SendMessage",
-			Handler:    _ChatService_SendMessage_Handler,
+			MethodName: "OpenDM",
+			Handler:    _DMService_OpenDM_Handler,
 		},
 		{
-			MethodName: "GetHistory",
-			Handler:    _ChatService_GetHistory_Handler,
+			MethodName: "ListMyDMs",
+			Handler:    _DMService_ListMyDMs_Handler,
+		},
+		{
+			MethodName: "AddDMRecipient",
+			Handler:    _DMService_AddDMRecipient_Handler,
+		},
+		{
+			MethodName: "RemoveDMRecipient",
+			Handler:    _DMService_RemoveDMRecipient_Handler,
+		},
+		{
+			MethodName: "RenameGroupDM",
+			Handler:    _DMService_RenameGroupDM_Handler,
 		},
 	},
-	Streams: []grpc.StreamDesc{
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kitsulan/v1/service.proto",
+}
+
+const (
+	RelationshipService_ListRelationships_FullMethodName    = "/kitsulan.v1.RelationshipService/ListRelationships"
+	RelationshipService_SendFriendRequest_FullMethodName    = "/kitsulan.v1.RelationshipService/SendFriendRequest"
+	RelationshipService_AcceptFriendRequest_FullMethodName  = "/kitsulan.v1.RelationshipService/AcceptFriendRequest"
+	RelationshipService_DeclineFriendRequest_FullMethodName = "/kitsulan.v1.RelationshipService/DeclineFriendRequest"
+	RelationshipService_CancelFriendRequest_FullMethodName  = "/kitsulan.v1.RelationshipService/CancelFriendRequest"
+	RelationshipService_RemoveFriend_FullMethodName         = "/kitsulan.v1.RelationshipService/RemoveFriend"
+	RelationshipService_BlockUser_FullMethodName            = "/kitsulan.v1.RelationshipService/BlockUser"
+	RelationshipService_UnblockUser_FullMethodName          = "/kitsulan.v1.RelationshipService/UnblockUser"
+)
+
+// RelationshipServiceClient is the client API for RelationshipService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Друзья, заявки в друзья и блокировки. Изменения приходят обеим сторонам в
+// SubscribeUserEvents (relationship_updated / relationship_removed).
+type RelationshipServiceClient interface {
+	// Друзья (с is_online), заявки и заблокированные
+	ListRelationships(ctx context.Context, in *ListRelationshipsRequest, opts ...grpc.CallOption) (*ListRelationshipsResponse, error)
+	// Отправить заявку. Если встречная заявка уже есть — принимает её.
+	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
+	AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*AcceptFriendRequestResponse, error)
+	DeclineFriendRequest(ctx context.Context, in *DeclineFriendRequestRequest, opts ...grpc.CallOption) (*DeclineFriendRequestResponse, error)
+	CancelFriendRequest(ctx context.Context, in *CancelFriendRequestRequest, opts ...grpc.CallOption) (*CancelFriendRequestResponse, error)
+	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error)
+	// Заблокировать: снимает дружбу и заявки, запрещает заблокированному
+	// писать в dm и отправлять заявки. В group_dm и гильдиях он по-прежнему
+	// пишет: его сообщения помечаются author_blocked в ответах на запросы, а
+	// в real-time событиях клиент скрывает их по своему списку блокировок.
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+}
+
+type relationshipServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationshipServiceClient(cc grpc.ClientConnInterface) RelationshipServiceClient {
+	return &relationshipServiceClient{cc}
+}
+
+func (c *relationshipServiceClient) ListRelationships(ctx context.Context, in *ListRelationshipsRequest, opts ...grpc.CallOption) (*ListRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRelationshipsResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_ListRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendFriendRequestResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*AcceptFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptFriendRequestResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_AcceptFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) DeclineFriendRequest(ctx context.Context, in *DeclineFriendRequestRequest, opts ...grpc.CallOption) (*DeclineFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineFriendRequestResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_DeclineFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) CancelFriendRequest(ctx context.Context, in *CancelFriendRequestRequest, opts ...grpc.CallOption) (*CancelFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFriendRequestResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_CancelFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFriendResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_RemoveFriend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationshipServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, RelationshipService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationshipServiceServer is the server API for RelationshipService service.
+// All implementations must embed UnimplementedRelationshipServiceServer
+// for forward compatibility.
+//
+// Друзья, заявки в друзья и блокировки. Изменения приходят обеим сторонам в
+// SubscribeUserEvents (relationship_updated / relationship_removed).
+type RelationshipServiceServer interface {
+	// Друзья (с is_online), заявки и заблокированные
+	ListRelationships(context.Context, *ListRelationshipsRequest) (*ListRelationshipsResponse, error)
+	// Отправить заявку. Если встречная заявка уже есть — принимает её.
+	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
+	AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*AcceptFriendRequestResponse, error)
+	DeclineFriendRequest(context.Context, *DeclineFriendRequestRequest) (*DeclineFriendRequestResponse, error)
+	CancelFriendRequest(context.Context, *CancelFriendRequestRequest) (*CancelFriendRequestResponse, error)
+	RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error)
+	// Заблокировать: снимает дружбу и заявки, запрещает заблокированному
+	// писать в dm и отправлять заявки. В group_dm и гильдиях он по-прежнему
+	// пишет: его сообщения помечаются author_blocked в ответах на запросы, а
+	// в real-time событиях клиент скрывает их по своему списку блокировок.
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	mustEmbedUnimplementedRelationshipServiceServer()
+}
+
+// UnimplementedRelationshipServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRelationshipServiceServer struct{}
+
+func (UnimplementedRelationshipServiceServer) ListRelationships(context.Context, *ListRelationshipsRequest) (*ListRelationshipsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRelationships not implemented")
+}
+func (UnimplementedRelationshipServiceServer) SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedRelationshipServiceServer) AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*AcceptFriendRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedRelationshipServiceServer) DeclineFriendRequest(context.Context, *DeclineFriendRequestRequest) (*DeclineFriendRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineFriendRequest not implemented")
+}
+func (UnimplementedRelationshipServiceServer) CancelFriendRequest(context.Context, *CancelFriendRequestRequest) (*CancelFriendRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
+func (UnimplementedRelationshipServiceServer) RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedRelationshipServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedRelationshipServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedRelationshipServiceServer) mustEmbedUnimplementedRelationshipServiceServer() {}
+func (UnimplementedRelationshipServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeRelationshipServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationshipServiceServer will
+// result in compilation errors.
+type UnsafeRelationshipServiceServer interface {
+	mustEmbedUnimplementedRelationshipServiceServer()
+}
+
+func RegisterRelationshipServiceServer(s grpc.ServiceRegistrar, srv RelationshipServiceServer) {
+	// If the following call panics, it indicates UnimplementedRelationshipServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RelationshipService_ServiceDesc, srv)
+}
+
+func _RelationshipService_ListRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).ListRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_ListRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).ListRelationships(ctx, req.(*ListRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_SendFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).SendFriendRequest(ctx, req.(*SendFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_AcceptFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).AcceptFriendRequest(ctx, req.(*AcceptFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_DeclineFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).DeclineFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_DeclineFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).DeclineFriendRequest(ctx, req.(*DeclineFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_CancelFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).CancelFriendRequest(ctx, req.(*CancelFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_RemoveFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).RemoveFriend(ctx, req.(*RemoveFriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationshipService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationshipServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationshipService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationshipServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationshipService_ServiceDesc is the grpc.ServiceDesc for RelationshipService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationshipService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kitsulan.v1.RelationshipService",
+	HandlerType: (*RelationshipServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			StreamName:    "SubscribeChannel",
-			Handler:       _ChatService_SubscribeChannel_Handler,
-			ServerStreams: true,
+			MethodName: "ListRelationships",
+			Handler:    _RelationshipService_ListRelationships_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _RelationshipService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _RelationshipService_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "DeclineFriendRequest",
+			Handler:    _RelationshipService_DeclineFriendRequest_Handler,
+		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _RelationshipService_CancelFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _RelationshipService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _RelationshipService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _RelationshipService_UnblockUser_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "kitsulan/v1/service.proto",
 }
 
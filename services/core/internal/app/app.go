@@ -14,10 +14,12 @@ import (
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/infra/cache"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
 	grpctransport "github.com/KitsuLAN/KitsuLAN/services/core/internal/transport/grpc"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/unfurl"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -33,6 +35,7 @@ type App struct {
 	log           *slog.Logger
 	db            *gorm.DB
 	cacheProvider *cache.Provider
+	chat          *service.ChatService // Для фонового планировщика сообщений
 
 	// Серверы
 	grpcServer   *grpc.Server
@@ -82,6 +85,7 @@ func New(cfg *config.Config, log *slog.Logger) (*App, error) {
 		log:           log,
 		db:            db,
 		cacheProvider: cacheProvider,
+		chat:          services.chat,
 		grpcServer:    grpcSrv,
 		grpcListener:  lis,
 		healthServer:  healthSrv,
@@ -144,6 +148,13 @@ func (a *App) Run(ctx context.Context) error {
 		return a.healthServer.Shutdown(shutdownCtx)
 	})
 
+	// --- 4. Scheduled Messages ---
+	g.Go(func() error {
+		a.log.Info("message scheduler started", "interval", a.cfg.SchedulerInterval)
+		a.chat.RunScheduler(logger.WithContext(ctx, a.log), a.cfg.SchedulerInterval)
+		return nil
+	})
+
 	// Ждем завершения всех горутин
 	err := g.Wait()
 
@@ -168,11 +179,14 @@ func (a *App) closeResources() {
 // --- Helpers ---
 
 type serviceDeps struct {
-	realm *service.RealmService
-	auth  *service.AuthService
-	user  *service.UserService
-	guild *service.GuildService
-	chat  *service.ChatService
+	realm  *service.RealmService
+	auth   *service.AuthService
+	user   *service.UserService
+	guild  *service.GuildService
+	chat   *service.ChatService
+	thread *service.ThreadService
+	dm     *service.DMService
+	rels   *service.RelationshipService
 }
 
 func initServices(db *gorm.DB, cfg *config.Config, cp *cache.Provider) *serviceDeps {
@@ -180,13 +194,29 @@ func initServices(db *gorm.DB, cfg *config.Config, cp *cache.Provider) *serviceD
 	tm := database.NewTransactionManager(db)
 	chatHub := hub.New()
 	usersService := service.NewUserService(repos.Users, cp)
+	systemMessenger := service.NewSystemMessenger(repos.Messages, chatHub)
+
+	var unfurler *service.Unfurler
+	if cfg.UnfurlEnabled {
+		fetcher := unfurl.NewHTTPFetcher(unfurl.Options{
+			Timeout:              cfg.UnfurlTimeout,
+			MaxBytes:             cfg.UnfurlMaxBytes,
+			AllowDomains:         cfg.UnfurlAllowDomains,
+			DenyDomains:          cfg.UnfurlDenyDomains,
+			AllowPrivateNetworks: cfg.UnfurlAllowPrivateNetworks,
+		})
+		unfurler = service.NewUnfurler(fetcher, repos.Messages, chatHub, cfg.UnfurlMaxLinks)
+	}
 
 	return &serviceDeps{
-		realm: service.NewRealmService(repos.Realms, cfg),
-		auth:  service.NewAuthService(repos.Users, cfg),
-		user:  usersService,
-		guild: service.NewGuildService(repos.Guilds, repos.Channels, tm, chatHub),
-		chat:  service.NewChatService(repos.Messages, repos.Channels, repos.Guilds, usersService, chatHub),
+		realm:  service.NewRealmService(repos.Realms, cfg),
+		auth:   service.NewAuthService(repos.Users, cfg),
+		user:   usersService,
+		guild:  service.NewGuildService(repos.Guilds, repos.Channels, tm, chatHub, systemMessenger),
+		chat:   service.NewChatService(repos.Messages, repos.Channels, repos.Guilds, repos.AuditLogs, repos.ReadStates, repos.Relations, repos.Scheduled, usersService, systemMessenger, unfurler, tm, chatHub),
+		thread: service.NewThreadService(repos.Channels, repos.Messages, repos.Guilds, tm, chatHub),
+		dm:     service.NewDMService(repos.Channels, repos.Guilds, repos.Users, repos.Relations, tm, chatHub),
+		rels:   service.NewRelationshipService(repos.Relations, repos.Users, tm, chatHub),
 	}
 }
 
@@ -213,6 +243,9 @@ func initGRPCServer(cfg *config.Config, s *serviceDeps) (*grpc.Server, error) {
 	pb.RegisterUserServiceServer(grpcServer, grpctransport.NewUserServer(s.user))
 	pb.RegisterGuildServiceServer(grpcServer, grpctransport.NewGuildServer(s.guild))
 	pb.RegisterChatServiceServer(grpcServer, grpctransport.NewChatServer(s.chat))
+	pb.RegisterThreadServiceServer(grpcServer, grpctransport.NewThreadServer(s.thread))
+	pb.RegisterDMServiceServer(grpcServer, grpctransport.NewDMServer(s.dm))
+	pb.RegisterRelationshipServiceServer(grpcServer, grpctransport.NewRelationshipServer(s.rels))
 
 	// Health Check gRPC
 	healthSrv := health.NewServer()
@@ -7,7 +7,8 @@ type UserCacheDTO struct {
 	Username  string `msgpack:"2"`
 	AvatarURL string `msgpack:"3"`
 	// Bio string - не кэшируем, тяжелое поле, редко нужно в списках
-	IsOnline bool `msgpack:"4"` // Можно хранить тут, или отдельно в Redis Bitmaps
+	IsOnline bool   `msgpack:"4"` // Можно хранить тут, или отдельно в Redis Bitmaps
+	DMPolicy string `msgpack:"5"` // Отдаётся только владельцу профиля
 }
 
 // GuildMemberCacheDTO
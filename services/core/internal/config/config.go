@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -77,6 +78,19 @@ type Config struct {
 	CacheL1TTL         time.Duration
 	CacheL1Metrics     bool
 
+	// --- Unfurl (превью ссылок) ---
+	UnfurlEnabled      bool // Выключить для изолированных LAN-реалмов без выхода наружу
+	UnfurlTimeout      time.Duration
+	UnfurlMaxBytes     int64
+	UnfurlMaxLinks     int // Сколько ссылок одного сообщения разворачиваем
+	UnfurlAllowDomains []string
+	UnfurlDenyDomains  []string
+	// Разрешить превью адресов из приватных сетей (intranet-сервисы реалма)
+	UnfurlAllowPrivateNetworks bool
+
+	// --- Scheduled messages ---
+	SchedulerInterval time.Duration // Как часто проверять наступившие сообщения
+
 	// --- Observability ---
 	ListenAddr    string // ""
 	PublicApiPort string // "8090"
@@ -141,6 +155,16 @@ func Load() (*Config, error) {
 		CacheL1TTL:         getDurationEnv("CACHE_L1_TTL", 5*time.Minute),
 		CacheL1Metrics:     getBoolEnv("CACHE_L1_METRICS", false),
 
+		UnfurlEnabled:              getBoolEnv("UNFURL_ENABLED", true),
+		UnfurlTimeout:              getDurationEnv("UNFURL_TIMEOUT", 5*time.Second),
+		UnfurlMaxBytes:             getInt64Env("UNFURL_MAX_BYTES", 1024*1024), // 1MB
+		UnfurlMaxLinks:             getIntEnv("UNFURL_MAX_LINKS", 5),
+		UnfurlAllowDomains:         getListEnv("UNFURL_ALLOW_DOMAINS"),
+		UnfurlDenyDomains:          getListEnv("UNFURL_DENY_DOMAINS"),
+		UnfurlAllowPrivateNetworks: getBoolEnv("UNFURL_ALLOW_PRIVATE_NETWORKS", false),
+
+		SchedulerInterval: getDurationEnv("SCHEDULER_INTERVAL", 5*time.Second),
+
 		ListenAddr:    getAddrEnv("LISTEN_ADDR", "0.0.0.0"),
 		PublicApiPort: getEnv("PUBLIC_API_PORT", "8090"),
 		HealthPort:    getEnv("HEALTH_PORT", "8091"),
@@ -196,6 +220,22 @@ func (c *Config) validate() error {
 		}
 	}
 
+	if c.UnfurlEnabled {
+		if c.UnfurlTimeout <= 0 {
+			return fmt.Errorf("UNFURL_TIMEOUT must be > 0")
+		}
+		if c.UnfurlMaxBytes <= 0 {
+			return fmt.Errorf("UNFURL_MAX_BYTES must be > 0")
+		}
+		if c.UnfurlMaxLinks <= 0 {
+			return fmt.Errorf("UNFURL_MAX_LINKS must be > 0")
+		}
+	}
+
+	if c.SchedulerInterval <= 0 {
+		return fmt.Errorf("SCHEDULER_INTERVAL must be > 0")
+	}
+
 	return nil
 }
 
@@ -287,3 +327,14 @@ func getFloat64Env(key string, fallback float64) float64 {
 	}
 	return f
 }
+
+// getListEnv читает список через запятую, пустые элементы отбрасываются.
+func getListEnv(key string) []string {
+	var out []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -59,6 +59,14 @@ func Connect(cfg *config.Config, log *slog.Logger) (*gorm.DB, error) {
 		return nil, fmt.Errorf("auto-migration failed: %w", err)
 	}
 
+	if err := SetupSearch(db); err != nil {
+		if cfg.DBDriver != "sqlite" {
+			return nil, err
+		}
+		// SQLite без FTS5 (сборка без тега sqlite_fts5) — поиск через LIKE
+		log.Warn("full-text search unavailable, falling back to LIKE", "error", err)
+	}
+
 	log.Info("database ready")
 	return db, nil
 }
@@ -74,24 +82,115 @@ func connectSQLite(cfg *config.Config, gormCfg *gorm.Config) (*gorm.DB, error) {
 // migrate запускает автомиграцию для всех доменных моделей.
 // Добавляй сюда новые модели по мере их появления.
 func migrate(db *gorm.DB) error {
-	return db.AutoMigrate(
+	if err := db.AutoMigrate(&schemaMigration{}); err != nil {
+		return fmt.Errorf("migrate schema_migrations: %w", err)
+	}
+
+	// AutoMigrate создаёт ограничение, только если его нет по имени, и не
+	// замечает новых значений type. Поэтому устаревшую проверку типа канала
+	// сносим разовым шагом, и AutoMigrate собирает её заново из модели.
+	// Новое значение type — новый шаг с новым именем.
+	if err := runOnce(db, "0002_channel_type_check_group_dm", dropChannelTypeChecks); err != nil {
+		return err
+	}
+
+	// Раньше member_roles создавалась из тега many2many без guild_id, и её
+	// внешний ключ вёл user_id на guild_members.guild_id — осмысленных строк
+	// в такой таблице нет, пересоздаём её по MemberRole.
+	if db.Migrator().HasColumn(&models.MemberRole{}, "guild_member_user_id") {
+		if err := db.Migrator().DropTable(&models.MemberRole{}); err != nil {
+			return fmt.Errorf("drop legacy member_roles: %w", err)
+		}
+	}
+	if err := db.SetupJoinTable(&models.GuildMember{}, "Roles", &models.MemberRole{}); err != nil {
+		return fmt.Errorf("setup member_roles: %w", err)
+	}
+
+	err := db.AutoMigrate(
 		// 1. Identity & Federation
 		&models.RealmConfig{},
 		&models.User{},
 		&models.UserDevice{},
+		&models.Relationship{},
 
 		// 2. Guilds, Channels, Roles
 		&models.Guild{},
 		&models.Role{},
 		&models.GuildMember{},
 		&models.Channel{},
+		&models.ThreadMember{},
+		&models.ChannelRecipient{},
+		&models.ChannelFollower{},
 		&models.ChannelPermissionOverwrite{},
 		&models.GuildInvite{},
 		&models.AuditLog{},
 
 		// 3. Messages & Media
 		&models.Message{},
+		&models.MessageEdit{},
+		&models.MessageMention{},
+		&models.MessageNonce{},
+		&models.ScheduledMessage{},
+		&models.ReadState{},
 		&models.MessageAttachment{},
 		&models.MessageReaction{},
 	)
+	if err != nil {
+		return err
+	}
+	return runOnce(db, "0001_member_default_permissions", backfillMemberPermissions)
+}
+
+// schemaMigration — отметка о выполненном разовом шаге миграции.
+type schemaMigration struct {
+	Name      string    `gorm:"primaryKey;size:128"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string { return "schema_migrations" }
+
+// runOnce выполняет шаг name, если его ещё нет в schema_migrations. Шаг и
+// отметка о нём пишутся в одной транзакции: упавший шаг повторится при
+// следующем старте. Имена шагов не меняются после выпуска.
+func runOnce(db *gorm.DB, name string, step func(tx *gorm.DB) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var applied int64
+		if err := tx.Model(&schemaMigration{}).Where("name = ?", name).Count(&applied).Error; err != nil {
+			return fmt.Errorf("check migration %s: %w", name, err)
+		}
+		if applied > 0 {
+			return nil
+		}
+		if err := step(tx); err != nil {
+			return err
+		}
+		return tx.Create(&schemaMigration{Name: name, AppliedAt: time.Now()}).Error
+	})
+}
+
+// dropChannelTypeChecks удаляет проверки типа канала, собранные без group_dm;
+// _v2 и _v3 — прежние имена ограничения.
+func dropChannelTypeChecks(tx *gorm.DB) error {
+	for _, name := range []string{"chk_channels_type", "chk_channels_type_v2", "chk_channels_type_v3"} {
+		if tx.Migrator().HasConstraint(&models.Channel{}, name) {
+			if err := tx.Migrator().DropConstraint(&models.Channel{}, name); err != nil {
+				return fmt.Errorf("drop channel type check %s: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// backfillMemberPermissions выдаёт DefaultGuildPermissions участникам, вступившим
+// до появления прав по умолчанию: у них остался 0 из default колонки, и без
+// прав они не могут ни писать, ни менять ник. Выполняется один раз: позже 0
+// может быть выставлен осознанно.
+func backfillMemberPermissions(tx *gorm.DB) error {
+	err := tx.Model(&models.GuildMember{}).
+		Where("effective_permissions = ?", 0).
+		Update("effective_permissions", models.DefaultGuildPermissions).Error
+	if err != nil {
+		return fmt.Errorf("backfill member permissions: %w", err)
+	}
+	return nil
 }
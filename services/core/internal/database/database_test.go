@@ -0,0 +1,141 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+func newMigrateTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormlogger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("failed to open test db: %v", err)
+	}
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+	return db
+}
+
+func TestMigrate_BackfillsMemberPermissions(t *testing.T) {
+	db := newMigrateTestDB(t)
+	if err := migrate(db); err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+	// База «до» шага: отметки нет, участник вступил без прав по умолчанию
+	if err := db.Where("name = ?", "0001_member_default_permissions").Delete(&schemaMigration{}).Error; err != nil {
+		t.Fatalf("failed to reset migration marker: %v", err)
+	}
+
+	guildID := uuid.New()
+	legacy := &models.GuildMember{GuildID: guildID, UserID: uuid.New()}
+	admin := &models.GuildMember{GuildID: guildID, UserID: uuid.New(), EffectivePermissions: models.AllGuildPermissions}
+	for _, m := range []*models.GuildMember{legacy, admin} {
+		if err := db.Omit("User", "Guild", "Roles").Create(m).Error; err != nil {
+			t.Fatalf("failed to create member: %v", err)
+		}
+	}
+
+	if err := migrate(db); err != nil {
+		t.Fatalf("second migrate failed: %v", err)
+	}
+
+	permissions := func() map[uuid.UUID]models.GuildPermission {
+		var got []models.GuildMember
+		if err := db.Where("guild_id = ?", guildID).Find(&got).Error; err != nil {
+			t.Fatalf("failed to load members: %v", err)
+		}
+		out := make(map[uuid.UUID]models.GuildPermission, len(got))
+		for _, m := range got {
+			out[m.UserID] = m.EffectivePermissions
+		}
+		return out
+	}
+	got := permissions()
+	if got[legacy.UserID] != models.DefaultGuildPermissions || got[admin.UserID] != models.AllGuildPermissions {
+		t.Errorf("unexpected permissions after backfill: %v", got)
+	}
+
+	t.Run("does not run again", func(t *testing.T) {
+		// Права, снятые до нуля после шага, остаются снятыми
+		if err := db.Model(&models.GuildMember{}).Where("user_id = ?", legacy.UserID).
+			Update("effective_permissions", 0).Error; err != nil {
+			t.Fatalf("failed to strip permissions: %v", err)
+		}
+		if err := migrate(db); err != nil {
+			t.Fatalf("third migrate failed: %v", err)
+		}
+		if got := permissions()[legacy.UserID]; got != 0 {
+			t.Errorf("expected permissions to stay 0, got %d", got)
+		}
+	})
+}
+
+func TestMigrate_RebuildsLegacyMemberRoles(t *testing.T) {
+	db := newMigrateTestDB(t)
+	// Таблица в том виде, в каком её создавал старый тег many2many
+	legacy := "CREATE TABLE member_roles (user_id uuid, guild_member_user_id uuid, role_id uuid, " +
+		"PRIMARY KEY (user_id, guild_member_user_id, role_id))"
+	if err := db.Exec(legacy).Error; err != nil {
+		t.Fatalf("failed to create legacy table: %v", err)
+	}
+
+	if err := migrate(db); err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+
+	if db.Migrator().HasColumn(&models.MemberRole{}, "guild_member_user_id") {
+		t.Error("expected legacy column to be gone")
+	}
+	for _, column := range []string{"realm_id", "guild_id", "user_id", "role_id"} {
+		if !db.Migrator().HasColumn(&models.MemberRole{}, column) {
+			t.Errorf("expected member_roles.%s", column)
+		}
+	}
+}
+
+func TestMigrate_RebuildsChannelTypeCheck(t *testing.T) {
+	db := newMigrateTestDB(t)
+	// Таблица с проверкой, собранной до появления group_dm
+	legacy := "CREATE TABLE channels (id uuid PRIMARY KEY, name text, type text NOT NULL, " +
+		"CONSTRAINT chk_channels_type CHECK (type IN ('text','voice','announcement','thread_parent','thread','dm')))"
+	if err := db.Exec(legacy).Error; err != nil {
+		t.Fatalf("failed to create legacy table: %v", err)
+	}
+	for range 2 {
+		if err := migrate(db); err != nil {
+			t.Fatalf("migrate failed: %v", err)
+		}
+	}
+
+	var steps int64
+	if err := db.Model(&schemaMigration{}).Where("name = ?", "0002_channel_type_check_group_dm").Count(&steps).Error; err != nil {
+		t.Fatalf("failed to load migration markers: %v", err)
+	}
+	if steps != 1 {
+		t.Errorf("expected the step to be recorded once, got %d", steps)
+	}
+
+	if !db.Migrator().HasConstraint(&models.Channel{}, "chk_channels_type") {
+		t.Error("expected chk_channels_type after migrate")
+	}
+	for _, legacy := range []string{"chk_channels_type_v2", "chk_channels_type_v3"} {
+		if db.Migrator().HasConstraint(&models.Channel{}, legacy) {
+			t.Errorf("expected %s to be gone", legacy)
+		}
+	}
+	if err := db.Create(&models.Channel{Name: "group", Type: models.ChannelTypeGroupDM}).Error; err != nil {
+		t.Errorf("expected group_dm to pass the check: %v", err)
+	}
+	if err := db.Create(&models.Channel{Name: "bogus", Type: "bogus"}).Error; err == nil {
+		t.Error("expected an unknown type to fail the check")
+	}
+}
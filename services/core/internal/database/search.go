@@ -0,0 +1,70 @@
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// MessagesFTSTable — FTS5-индекс сообщений в SQLite. Если таблицы нет
+// (драйвер собран без FTS5), поиск работает через LIKE.
+const MessagesFTSTable = "messages_fts"
+
+// SetupSearch создаёт полнотекстовый индекс сообщений, которого AutoMigrate не умеет:
+//   - PostgreSQL: генерируемая колонка search_vector (tsvector) + GIN-индекс;
+//   - SQLite: внешняя FTS5-таблица поверх messages, синхронизируемая триггерами.
+//
+// Идемпотентна: вызывается при каждом старте после migrate.
+func SetupSearch(db *gorm.DB) error {
+	switch db.Dialector.Name() {
+	case "postgres":
+		return setupPostgresSearch(db)
+	case "sqlite":
+		return setupSQLiteSearch(db)
+	}
+	return nil
+}
+
+func setupPostgresSearch(db *gorm.DB) error {
+	stmts := []string{
+		// 'simple' — без стемминга: сообщения на разных языках вперемешку
+		`ALTER TABLE messages ADD COLUMN IF NOT EXISTS search_vector tsvector
+			GENERATED ALWAYS AS (to_tsvector('simple', coalesce(content, ''))) STORED`,
+		`CREATE INDEX IF NOT EXISTS idx_message_search ON messages USING GIN (search_vector)`,
+	}
+	for _, stmt := range stmts {
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("setup postgres search: %w", err)
+		}
+	}
+	return nil
+}
+
+func setupSQLiteSearch(db *gorm.DB) error {
+	if db.Migrator().HasTable(MessagesFTSTable) {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		stmts := []string{
+			`CREATE VIRTUAL TABLE messages_fts USING fts5(content, content='messages', content_rowid='rowid')`,
+			`CREATE TRIGGER messages_fts_ai AFTER INSERT ON messages BEGIN
+				INSERT INTO messages_fts(rowid, content) VALUES (new.rowid, new.content);
+			END`,
+			`CREATE TRIGGER messages_fts_ad AFTER DELETE ON messages BEGIN
+				INSERT INTO messages_fts(messages_fts, rowid, content) VALUES ('delete', old.rowid, old.content);
+			END`,
+			`CREATE TRIGGER messages_fts_au AFTER UPDATE OF content ON messages BEGIN
+				INSERT INTO messages_fts(messages_fts, rowid, content) VALUES ('delete', old.rowid, old.content);
+				INSERT INTO messages_fts(rowid, content) VALUES (new.rowid, new.content);
+			END`,
+			// Индексируем уже существующие сообщения
+			`INSERT INTO messages_fts(messages_fts) VALUES ('rebuild')`,
+		}
+		for _, stmt := range stmts {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return fmt.Errorf("setup sqlite search: %w", err)
+			}
+		}
+		return nil
+	})
+}
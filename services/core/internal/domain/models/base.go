@@ -24,7 +24,7 @@ type BaseEntity struct {
 
 	// --- Traceability ---
 	// Мы знаем не только когда но и кто.
-	CreatedAt time.Time  `gorm:"not null;default:current_timestamp;index:idx_created_at" json:"created_at"`
+	CreatedAt time.Time  `gorm:"not null;default:current_timestamp;index" json:"created_at"`
 	CreatedBy *uuid.UUID `gorm:"type:uuid" json:"created_by,omitempty"`
 
 	UpdatedAt time.Time  `gorm:"not null;default:current_timestamp" json:"updated_at"`
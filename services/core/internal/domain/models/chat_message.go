@@ -1,7 +1,6 @@
 package models
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,42 +29,241 @@ const (
 	MessageContentTypeSystem   MessageContentType = "system"
 )
 
+// SystemMessageType — вид системного сообщения. Текст локализует клиент.
+type SystemMessageType string
+
+const (
+	SystemMessageMemberJoin  SystemMessageType = "member_join"
+	SystemMessageMemberLeave SystemMessageType = "member_leave"
+	SystemMessageMemberKick  SystemMessageType = "member_kick"
+	SystemMessageMessagePin  SystemMessageType = "message_pin"
+)
+
+// CrosspostSource — подпись копии объявления. Названия запоминаются при
+// публикации: читателю не нужен доступ к гильдии-источнику.
+type CrosspostSource struct {
+	GuildID     string `json:"guild_id"`
+	GuildName   string `json:"guild_name"`
+	ChannelID   string `json:"channel_id"`
+	ChannelName string `json:"channel_name"`
+}
+
+// SystemPayload — структурированные данные системного сообщения.
+type SystemPayload struct {
+	Type     SystemMessageType `json:"type"`
+	ActorID  string            `json:"actor_id,omitempty"`
+	TargetID string            `json:"target_id,omitempty"`
+	Params   map[string]string `json:"params,omitempty"`
+}
+
 type Message struct {
 	BaseEntity    // ID, RealmID, Version, Audit
 	SoftDeletable // DeletedAt (DeletedBy и DeletionReason важны для модерации)
 
-	ChannelID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_channel_seq,priority:1;index:idx_messages_history,priority:1"`
+	ChannelID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_channel_seq,priority:1;index:idx_messages_history,priority:1;index:idx_messages_changes,priority:1"`
 	AuthorID  uuid.UUID `gorm:"type:uuid;not null;index"`
 
 	Content     string             `gorm:"type:text"`
 	ContentType MessageContentType `gorm:"type:text;not null;default:'text'"`
 
 	// --- Rich Content (JSONB) ---
-	Embeds     json.RawMessage `gorm:"type:jsonb" json:"embeds,omitempty"`
-	Components json.RawMessage `gorm:"type:jsonb" json:"components,omitempty"`
+	Embeds     []Embed     `gorm:"type:jsonb;serializer:json" json:"embeds,omitempty"`     // Превью ссылок, заполняет unfurler
+	Components []ActionRow `gorm:"type:jsonb;serializer:json" json:"components,omitempty"` // Кнопки и меню для ботов
+
+	// System заполнен только у системных сообщений (Flags содержит MessageFlagSystem)
+	System *SystemPayload `gorm:"type:jsonb;serializer:json" json:"system,omitempty"`
 
-	// Полнотекстовый поиск (PostgreSQL specific)
-	// gorm:"type:tsvector;index:idx_message_search,type:gin"
-	SearchVector string `gorm:"-" json:"-"`
+	// Mentions — проверенные упоминания; nil, если их нет
+	Mentions *MessageMentions `gorm:"type:jsonb;serializer:json" json:"mentions,omitempty"`
+
+	// Полнотекстовый поиск: колонка search_vector (PostgreSQL) или таблица
+	// messages_fts (SQLite) создаются в database.SetupSearch, в модели их нет.
 
 	// --- Threading & Replies ---
 	ReplyToID *uuid.UUID `gorm:"type:uuid"`
 	ThreadID  *uuid.UUID `gorm:"type:uuid;index"`
 
+	// --- Crosspost ---
+	// CrosspostOfID — оригинал в канале объявлений; задан только у копий
+	CrosspostOfID *uuid.UUID       `gorm:"type:uuid;index"`
+	Crosspost     *CrosspostSource `gorm:"type:jsonb;serializer:json" json:"crosspost,omitempty"`
+
 	// --- State ---
 	Flags       MessageFlag `gorm:"type:bigint;not null;default:0"`
 	EditVersion int         `gorm:"not null;default:0"` // Для инвалидации кэша/sync
 	IsPinned    bool        `gorm:"not null;default:false"`
+	PinnedAt    *time.Time  // Для сортировки списка закрепов
 
 	// --- Ordering ---
 	Seq      int64 `gorm:"not null;uniqueIndex:idx_channel_seq,priority:2"` // Sequence внутри канала
 	EditedAt *time.Time
 
+	// ChangeSeq — номер последнего изменения (создание, правка, удаление, закреп…)
+	// из Channel.NextChangeSeq. Реакции его не двигают.
+	ChangeSeq int64 `gorm:"not null;default:0;index:idx_messages_changes,priority:2"`
+
 	// Ассоциации
 	Author      User                `gorm:"foreignKey:AuthorID"`
 	Channel     Channel             `gorm:"foreignKey:ChannelID"`
 	Attachments []MessageAttachment `gorm:"foreignKey:MessageID;constraint:OnDelete:CASCADE"`
 	Reactions   []MessageReaction   `gorm:"foreignKey:MessageID;constraint:OnDelete:CASCADE"`
+
+	// AuthorMember — профиль автора в гильдии канала (ник, аватар).
+	// Заполняется сервисом при выдаче, в БД не хранится.
+	AuthorMember *GuildMember `gorm:"-"`
+
+	// ReferencedMessage — сообщение из ReplyToID для превью. Заполняется сервисом;
+	// у удалённого оригинала DeletedAt.Valid == true.
+	ReferencedMessage *Message `gorm:"-"`
+
+	// ReactionSummaries — реакции, сгруппированные по эмодзи, с точки зрения
+	// запрашивающего пользователя. Заполняется сервисом, в БД не хранится.
+	ReactionSummaries []ReactionSummary `gorm:"-"`
+
+	// Nonce — ключ идемпотентности из SendMessage. Возвращается отправителю
+	// в ответе и в message_created, в БД не хранится (см. MessageNonce).
+	Nonce string `gorm:"-"`
+
+	// AuthorBlocked — автор заблокирован запрашивающим пользователем, клиент
+	// скрывает такие сообщения. Заполняется сервисом только в ответах на
+	// запросы: событие в хабе общее для всех подписчиков, поэтому в real-time
+	// клиент сверяется со своим списком блокировок сам. В БД не хранится.
+	AuthorBlocked bool `gorm:"-"`
+}
+
+type EmbedType string
+
+const (
+	EmbedTypeLink  EmbedType = "link"
+	EmbedTypeImage EmbedType = "image"
+	EmbedTypeVideo EmbedType = "video"
+	EmbedTypeRich  EmbedType = "rich"
+)
+
+// Embed — превью ссылки из сообщения (OpenGraph/oEmbed).
+type Embed struct {
+	URL         string    `json:"url"` // Ссылка из текста сообщения
+	Type        EmbedType `json:"type"`
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description,omitempty"`
+	SiteName    string    `json:"site_name,omitempty"`
+	AuthorName  string    `json:"author_name,omitempty"`
+	ImageURL    string    `json:"image_url,omitempty"`
+	ImageWidth  int       `json:"image_width,omitempty"`
+	ImageHeight int       `json:"image_height,omitempty"`
+}
+
+type ComponentType string
+
+const (
+	ComponentTypeButton ComponentType = "button"
+	ComponentTypeSelect ComponentType = "select"
+)
+
+type ButtonStyle string
+
+const (
+	ButtonStylePrimary   ButtonStyle = "primary"
+	ButtonStyleSecondary ButtonStyle = "secondary"
+	ButtonStyleSuccess   ButtonStyle = "success"
+	ButtonStyleDanger    ButtonStyle = "danger"
+	ButtonStyleLink      ButtonStyle = "link" // Открывает URL, взаимодействия не создаёт
+)
+
+// ActionRow — строка интерактивных компонентов: до 5 кнопок или одно меню.
+type ActionRow struct {
+	Components []Component `json:"components"`
+}
+
+// Component — кнопка или меню выбора. Нажатие приходит автору сообщения
+// как взаимодействие с CustomID.
+type Component struct {
+	Type     ComponentType `json:"type"`
+	CustomID string        `json:"custom_id,omitempty"` // Уникален в сообщении; у ссылок пуст
+	Disabled bool          `json:"disabled,omitempty"`
+
+	// Кнопка
+	Label string      `json:"label,omitempty"`
+	Style ButtonStyle `json:"style,omitempty"`
+	URL   string      `json:"url,omitempty"` // Только для ButtonStyleLink
+	Emoji string      `json:"emoji,omitempty"`
+
+	// Меню выбора
+	Placeholder string         `json:"placeholder,omitempty"`
+	MinValues   int            `json:"min_values,omitempty"`
+	MaxValues   int            `json:"max_values,omitempty"`
+	Options     []SelectOption `json:"options,omitempty"`
+}
+
+type SelectOption struct {
+	Label       string `json:"label"`
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+	Default     bool   `json:"default,omitempty"`
+}
+
+// FindComponent ищет интерактивный компонент по CustomID. Ссылки не ищутся.
+func (m *Message) FindComponent(customID string) *Component {
+	for i := range m.Components {
+		for j := range m.Components[i].Components {
+			c := &m.Components[i].Components[j]
+			if c.CustomID != "" && c.CustomID == customID {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// MessageMentions — упоминания, прошедшие проверку при отправке:
+// только участники гильдии, упоминаемые роли и разрешённые @everyone/@here.
+type MessageMentions struct {
+	UserIDs  []uuid.UUID `json:"user_ids,omitempty"`
+	RoleIDs  []uuid.UUID `json:"role_ids,omitempty"`
+	Everyone bool        `json:"everyone,omitempty"`
+	Here     bool        `json:"here,omitempty"`
+}
+
+// MessageMention — запись во «входящих» упоминаниях пользователя.
+// Пишется на каждого адресата (в т.ч. через роль и @everyone), кроме автора.
+type MessageMention struct {
+	RealmID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	MessageID uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false;index:idx_mentions_inbox,priority:1;index:idx_mentions_unread,priority:1"`
+	GuildID   uuid.UUID `gorm:"type:uuid;not null"`
+	ChannelID uuid.UUID `gorm:"type:uuid;not null;index:idx_mentions_unread,priority:2"`
+	Seq       int64     `gorm:"not null;index:idx_mentions_unread,priority:3"` // Seq сообщения — для счётчика непрочитанных
+	CreatedAt time.Time `gorm:"not null;index:idx_mentions_inbox,priority:2"`
+}
+
+// MessageNonce — ключ идемпотентности отправки. Повтор SendMessage с тем же
+// nonce от того же автора в том же канале возвращает уже созданное сообщение.
+// Записи старше окна дедупликации перезаписываются и вычищаются.
+type MessageNonce struct {
+	AuthorID  uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false;index:idx_nonces_expiry,priority:1"`
+	ChannelID uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	Nonce     string    `gorm:"primaryKey;size:64"`
+	MessageID uuid.UUID `gorm:"type:uuid;not null"`
+	CreatedAt time.Time `gorm:"not null;index:idx_nonces_expiry,priority:2"`
+}
+
+// ReactionSummary — агрегат реакций одного эмодзи на сообщении.
+type ReactionSummary struct {
+	MessageID uuid.UUID
+	Emoji     string
+	Count     int
+	Me        bool // Реакцию поставил тот, кто запрашивает
+}
+
+// MessageEdit — предыдущая редакция сообщения. Пишется при каждом EditMessage,
+// чтобы модераторы видели, что было до правки.
+type MessageEdit struct {
+	BaseEntity
+
+	MessageID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_message_edits_lookup,priority:1"`
+	EditorID    uuid.UUID `gorm:"type:uuid;not null"`
+	Content     string    `gorm:"type:text"`
+	EditVersion int       `gorm:"not null;uniqueIndex:idx_message_edits_lookup,priority:2"` // EditVersion сообщения до правки
 }
 
 type MessageAttachment struct {
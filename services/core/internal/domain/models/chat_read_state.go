@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReadState — позиция чтения пользователя в канале. Непрочитанные — живые
+// несистемные сообщения с Seq больше LastReadSeq.
+type ReadState struct {
+	RealmID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID      uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	ChannelID   uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false;index"`
+	LastReadSeq int64     `gorm:"not null;default:0"`
+	UpdatedAt   time.Time `gorm:"not null"`
+}
+
+// ChannelUnread — сводка непрочитанного по одному каналу.
+type ChannelUnread struct {
+	ChannelID    uuid.UUID
+	GuildID      uuid.UUID  // uuid.Nil у личных каналов
+	ParentID     *uuid.UUID // Канал ветки: видимость ветки определяет он
+	LastSeq      int64      // Seq последнего сообщения в канале
+	LastReadSeq  int64
+	UnreadCount  int64
+	MentionCount int64
+}
+
+// ViewChannelID — канал, по правам которого решается, видно ли непрочитанное.
+func (u *ChannelUnread) ViewChannelID() uuid.UUID {
+	if u.ParentID != nil {
+		return *u.ParentID
+	}
+	return u.ChannelID
+}
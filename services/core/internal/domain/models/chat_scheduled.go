@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduledMessageStatus string
+
+const (
+	ScheduledMessagePending ScheduledMessageStatus = "pending"
+	ScheduledMessageSent    ScheduledMessageStatus = "sent"
+	ScheduledMessageFailed  ScheduledMessageStatus = "failed" // Отправка отклонена (нет прав, канал удалён и т.п.)
+)
+
+// ScheduledMessage — сообщение, которое планировщик отправит от имени автора
+// через обычный ChatService.SendMessage. Экземпляры core разбирают очередь
+// через аренду LockedUntil; повтор после падения посреди отправки гасится
+// nonce сообщения (см. MessageNonce).
+type ScheduledMessage struct {
+	BaseEntity
+
+	ChannelID   uuid.UUID          `gorm:"type:uuid;not null;index"`
+	AuthorID    uuid.UUID          `gorm:"type:uuid;not null;index"`
+	Content     string             `gorm:"type:text;not null"`
+	ContentType MessageContentType `gorm:"type:text;not null;default:'text'"`
+	SendAt      time.Time          `gorm:"not null"`
+
+	Status        ScheduledMessageStatus `gorm:"type:text;not null;default:'pending';index:idx_scheduled_due,priority:1;check:chk_scheduled_status,status IN ('pending','sent','failed')"`
+	NextAttemptAt time.Time              `gorm:"not null;index:idx_scheduled_due,priority:2"` // SendAt, после сбоя — время повтора
+	Attempts      int                    `gorm:"not null;default:0"`
+	LockedUntil   *time.Time             // Аренда экземпляра, взявшего сообщение в отправку
+	MessageID     *uuid.UUID             `gorm:"type:uuid"` // Отправленное сообщение
+	LastError     string                 `gorm:"type:text"`
+}
+
+// Nonce — ключ идемпотентности отправки, общий для всех попыток.
+func (m *ScheduledMessage) Nonce() string {
+	return "scheduled:" + m.ID.String()
+}
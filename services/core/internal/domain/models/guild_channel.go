@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -11,15 +13,18 @@ const (
 	ChannelTypeVoice        ChannelType = "voice"
 	ChannelTypeAnnouncement ChannelType = "announcement"
 	ChannelTypeThreadParent ChannelType = "thread_parent"
+	ChannelTypeThread       ChannelType = "thread"   // Ветка внутри текстового канала
+	ChannelTypeDM           ChannelType = "dm"       // Личный канал двух пользователей, без гильдии
+	ChannelTypeGroupDM      ChannelType = "group_dm" // Личный канал на несколько человек, без гильдии
 )
 
 type Channel struct {
 	BaseEntity    // ID, RealmID
 	SoftDeletable // DeletedAt
 
-	GuildID     uuid.UUID   `gorm:"type:uuid;not null;index"`
+	GuildID     *uuid.UUID  `gorm:"type:uuid;index;check:chk_channels_guild,(guild_id IS NULL) = (type IN ('dm','group_dm'))"` // NULL у личных каналов
 	Name        string      `gorm:"not null;size:100"`
-	Type        ChannelType `gorm:"type:text;not null;check:type IN ('text','voice','announcement','thread_parent')"`
+	Type        ChannelType `gorm:"type:text;not null;check:chk_channels_type,type IN ('text','voice','announcement','thread_parent','thread','dm','group_dm')"`
 	Position    int         `gorm:"not null;default:0"`
 	Topic       string      `gorm:"type:text"`
 	SlowmodeSec int         `gorm:"not null;default:0"`
@@ -28,6 +33,83 @@ type Channel struct {
 	CategoryID       *uuid.UUID `gorm:"type:uuid"`             // NULL если канал в корне гильдии
 	PermissionSynced bool       `gorm:"not null;default:true"` // Синхронизировано ли с категорией
 	NextSeq          int64      `gorm:"not null;default:1"`    // Монотонный счетчик для доставки сообщений
+	NextChangeSeq    int64      `gorm:"not null;default:1"`    // Счётчик изменений сообщений (Message.ChangeSeq) для SyncChannel
+
+	// --- Thread (только для Type == thread) ---
+	ParentID         *uuid.UUID `gorm:"type:uuid;index"` // Канал, в котором создана ветка
+	ThreadOwnerID    *uuid.UUID `gorm:"type:uuid"`
+	StarterMessageID *uuid.UUID `gorm:"type:uuid"` // NULL у веток, созданных без сообщения
+	IsArchived       bool       `gorm:"not null;default:false"`
+	IsLocked         bool       `gorm:"not null;default:false"` // Писать могут только MANAGE_THREADS
+	ArchivedAt       *time.Time
+
+	// --- Личные каналы (dm, group_dm) ---
+	// DMKey — «меньший ID:больший ID» участников dm; не даёт открыть второй такой же канал
+	DMKey      *string            `gorm:"size:73;uniqueIndex"`
+	OwnerID    *uuid.UUID         `gorm:"type:uuid"` // Создатель group_dm: переименовывает и исключает
+	Recipients []ChannelRecipient `gorm:"foreignKey:ChannelID;constraint:OnDelete:CASCADE"`
+}
+
+// IsDM — личный канал (dm или group_dm): доступ по списку участников, а не по гильдии.
+func (c *Channel) IsDM() bool {
+	return c.Type == ChannelTypeDM || c.Type == ChannelTypeGroupDM
+}
+
+// GuildIDString возвращает ID гильдии строкой; у личных каналов — пустую строку.
+func (c *Channel) GuildIDString() string {
+	if c.GuildID == nil {
+		return ""
+	}
+	return c.GuildID.String()
+}
+
+// IsTextBased — можно ли писать сообщения в канал.
+func (c *Channel) IsTextBased() bool {
+	switch c.Type {
+	case ChannelTypeText, ChannelTypeAnnouncement, ChannelTypeThread, ChannelTypeDM, ChannelTypeGroupDM:
+		return true
+	}
+	return false
+}
+
+// CanHaveThreads — можно ли создавать ветки в канале.
+func (c *Channel) CanHaveThreads() bool {
+	switch c.Type {
+	case ChannelTypeText, ChannelTypeAnnouncement, ChannelTypeThreadParent:
+		return true
+	}
+	return false
+}
+
+// ThreadMember — участник ветки. Только участники подписываются на её события.
+type ThreadMember struct {
+	RealmID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	ThreadID uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	UserID   uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false;index"`
+	JoinedAt time.Time `gorm:"not null;default:current_timestamp"`
+}
+
+// ChannelRecipient — участник личного канала.
+type ChannelRecipient struct {
+	RealmID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	ChannelID uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false;index"`
+	JoinedAt  time.Time `gorm:"not null;default:current_timestamp"`
+
+	User User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+// ChannelFollower — подписка канала гильдии на канал объявлений (в том числе
+// другой гильдии): каждое объявление копируется в TargetChannelID.
+type ChannelFollower struct {
+	RealmID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	SourceChannelID uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	TargetChannelID uuid.UUID `gorm:"type:uuid;primaryKey;autoIncrement:false;index"`
+	CreatedBy       uuid.UUID `gorm:"type:uuid;not null"`
+	CreatedAt       time.Time `gorm:"not null;default:current_timestamp"`
+
+	SourceChannel Channel `gorm:"foreignKey:SourceChannelID;constraint:OnDelete:CASCADE"`
+	TargetChannel Channel `gorm:"foreignKey:TargetChannelID;constraint:OnDelete:CASCADE"`
 }
 
 type PermissionTargetType string
@@ -64,12 +64,34 @@ type GuildMember struct {
 	IsDeafened bool `gorm:"not null;default:false"`
 	IsOnline   bool `gorm:"-" json:"is_online"`
 
+	// TimeoutUntil — до этого момента участник не может писать, реагировать и говорить.
+	// Истёкший тайм-аут не сбрасывается в БД, а просто перестаёт действовать.
+	TimeoutUntil *time.Time
+
 	// Ассоциации
 	User  User   `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 	Guild Guild  `gorm:"foreignKey:GuildID;constraint:OnDelete:CASCADE"`
-	Roles []Role `gorm:"many2many:member_roles;joinForeignKey:UserID;JoinReferences:RoleID;constraint:OnDelete:CASCADE"`
+	Roles []Role `gorm:"many2many:member_roles;foreignKey:GuildID,UserID;joinForeignKey:GuildID,UserID;joinReferences:RoleID;constraint:OnDelete:CASCADE"`
+}
+
+// IsTimedOut сообщает, действует ли тайм-аут участника на момент now.
+func (m *GuildMember) IsTimedOut(now time.Time) bool {
+	return m.TimeoutUntil != nil && now.Before(*m.TimeoutUntil)
 }
 
+// CanSpeak сообщает, может ли участник говорить в голосовых каналах.
+// Голосовых токенов ядро не выдаёт: результат уходит клиентам и голосовому
+// серверу в Member.can_speak.
+func (m *GuildMember) CanSpeak(now time.Time) bool {
+	return !m.IsMuted && !m.IsTimedOut(now)
+}
+
+// Действия в журнале аудита
+const (
+	AuditActionMessageDelete     = "message_delete"
+	AuditActionMessageBulkDelete = "message_bulk_delete"
+)
+
 type AuditLog struct {
 	ID        uuid.UUID       `gorm:"type:uuid;primaryKey"`
 	GuildID   uuid.UUID       `gorm:"type:uuid;not null;index"`
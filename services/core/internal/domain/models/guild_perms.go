@@ -1,5 +1,11 @@
 package models
 
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
 const AllGuildPermissions GuildPermission = ^GuildPermission(0)
 
 type GuildPermission int64
@@ -59,6 +65,28 @@ func CalculatePermissions(base GuildPermission, overwrites []ChannelPermissionOv
 	return perms
 }
 
+// ChannelPermissions считает права участника в канале: сначала
+// переопределения его ролей, затем персональное — оно сильнее.
+// Переопределения других каналов и чужих ролей игнорируются.
+func ChannelPermissions(base GuildPermission, channelID, userID uuid.UUID, roleIDs []uuid.UUID, overwrites []ChannelPermissionOverwrite) GuildPermission {
+	if base.Has(PermAdministrator) {
+		return AllGuildPermissions
+	}
+
+	applicable := make([]ChannelPermissionOverwrite, 0, len(overwrites))
+	for _, ow := range overwrites {
+		if ow.ChannelID == channelID && ow.TargetType == TargetTypeRole && slices.Contains(roleIDs, ow.TargetID) {
+			applicable = append(applicable, ow)
+		}
+	}
+	for _, ow := range overwrites {
+		if ow.ChannelID == channelID && ow.TargetType == TargetTypeUser && ow.TargetID == userID {
+			applicable = append(applicable, ow)
+		}
+	}
+	return CalculatePermissions(base, applicable)
+}
+
 // ApplyOverwrite применяет разрешающие и запрещающие маски.
 // Сначала снимаются запреты, затем накладываются разрешения.
 func ApplyOverwrite(
@@ -93,4 +121,23 @@ const (
 	PermAdministrator // обходит остальные проверки
 	PermCreateInvites
 	PermManageThreads
+	PermChangeNickname  // свой ник и аватар в гильдии
+	PermManageNicknames // ники и аватары других участников
+	PermModerateMembers // тайм-ауты участников
+	PermMentionEveryone // @everyone, @here и неупоминаемые роли
+	PermPublishMessages // сообщения в каналах объявлений (они уходят подписчикам)
 )
+
+// DefaultGuildPermissions — права, которые получает новый участник гильдии.
+const DefaultGuildPermissions = PermViewChannels |
+	PermSendMessages |
+	PermAttachFiles |
+	PermAddReactions |
+	PermConnectVoice |
+	PermSpeakVoice |
+	PermCreateInvites |
+	PermChangeNickname
+
+// DMPermissions — права в личном канале: модерации там нет, каждый
+// управляет только своими сообщениями.
+const DMPermissions = PermViewChannels | PermSendMessages | PermAttachFiles | PermAddReactions
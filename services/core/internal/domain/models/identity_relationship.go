@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RelationshipType string
+
+const (
+	RelationshipFriend   RelationshipType = "friend"
+	RelationshipIncoming RelationshipType = "incoming" // Заявка в друзья от TargetID
+	RelationshipOutgoing RelationshipType = "outgoing" // Заявка в друзья к TargetID
+	RelationshipBlocked  RelationshipType = "blocked"  // UserID заблокировал TargetID
+)
+
+// Relationship — отношение UserID к TargetID, каждая сторона видит свою запись.
+// Дружба и заявки хранятся зеркальной парой (friend/friend, outgoing/incoming),
+// блокировка — только записью заблокировавшего: заблокированный о ней не узнаёт.
+type Relationship struct {
+	RealmID   uuid.UUID        `gorm:"type:uuid;not null;index"`
+	UserID    uuid.UUID        `gorm:"type:uuid;primaryKey;autoIncrement:false"`
+	TargetID  uuid.UUID        `gorm:"type:uuid;primaryKey;autoIncrement:false;index"`
+	Type      RelationshipType `gorm:"type:text;not null;check:chk_relationships_type,type IN ('friend','incoming','outgoing','blocked')"`
+	CreatedAt time.Time        `gorm:"not null"` // С какого момента действует текущий Type
+
+	Target User `gorm:"foreignKey:TargetID;constraint:OnDelete:CASCADE"`
+
+	IsOnline bool `gorm:"-"` // Заполняется сервисом только для друзей
+}
@@ -15,6 +15,15 @@ const (
 	AccountStatusDeactivated AccountStatus = "deactivated"
 )
 
+// DMPolicy — кто может начать с пользователем личную переписку.
+type DMPolicy string
+
+const (
+	DMPolicyEveryone     DMPolicy = "everyone"
+	DMPolicyGuildMembers DMPolicy = "guild_members" // Друзья и те, с кем есть общая гильдия
+	DMPolicyNobody       DMPolicy = "nobody"
+)
+
 // User - основная модель пользователя
 type User struct {
 	BaseEntity    // Включает ID, RealmID, Version, Audit
@@ -47,5 +56,8 @@ type User struct {
 	// Флаги участника платформы (битмаска, отдельная от GuildPerms)
 	PlatformFlags int64 `gorm:"not null;default:0" json:"platform_flags"`
 
+	// Проверяется при открытии нового личного канала и добавлении в групповой
+	DMPolicy DMPolicy `gorm:"type:text;not null;default:'everyone'" json:"dm_policy"`
+
 	AccountStatus AccountStatus `gorm:"type:text;not null;default:'active'" json:"account_status"`
 }
@@ -8,12 +8,18 @@ import (
 	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
 )
 
+// subscriber — одно активное подключение к каналу.
+type subscriber struct {
+	userID string
+	ch     chan *pb.ChatEvent
+}
+
 // Hub управляет подписками на каналы.
 // Безопасен для конкурентного использования.
 type Hub struct {
 	mu sync.RWMutex
-	// channelID → subID → ch
-	subscribers map[string]map[uint64]chan *pb.ChatEvent
+	// channelID → subID → подписчик
+	subscribers map[string]map[uint64]*subscriber
 
 	// userID -> count (сколько активных соединений у юзера)
 	// Если count > 0, юзер онлайн.
@@ -24,7 +30,7 @@ type Hub struct {
 
 func New() *Hub {
 	return &Hub{
-		subscribers: make(map[string]map[uint64]chan *pb.ChatEvent),
+		subscribers: make(map[string]map[uint64]*subscriber),
 		presence:    make(map[string]int),
 	}
 }
@@ -41,9 +47,9 @@ func (h *Hub) Subscribe(channelID string, userID string) (<-chan *pb.ChatEvent,
 	ch := make(chan *pb.ChatEvent, 32) // буфер на случай медленного клиента
 
 	if h.subscribers[channelID] == nil {
-		h.subscribers[channelID] = make(map[uint64]chan *pb.ChatEvent)
+		h.subscribers[channelID] = make(map[uint64]*subscriber)
 	}
-	h.subscribers[channelID][id] = ch
+	h.subscribers[channelID][id] = &subscriber{userID: userID, ch: ch}
 
 	if userID != "" {
 		h.presence[userID]++
@@ -83,16 +89,70 @@ func (h *Hub) IsOnline(userID string) bool {
 	return h.presence[userID] > 0
 }
 
+// HasSubscribers проверяет, слушает ли кто-нибудь канал (или UserTopic).
+func (h *Hub) HasSubscribers(channelID string) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscribers[channelID]) > 0
+}
+
 // Publish рассылает событие всем подписчикам канала.
 // Медленные клиенты (полный буфер) пропускают событие — не блокируем.
 func (h *Hub) Publish(channelID string, event *pb.ChatEvent) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	for _, ch := range h.subscribers[channelID] {
-		select {
-		case ch <- event:
-		default: // клиент не успевает — пропускаем
+	for _, sub := range h.subscribers[channelID] {
+		sub.send(event)
+	}
+}
+
+// PublishExcept рассылает событие всем подписчикам канала, кроме соединений
+// пользователя exceptUserID (например, его собственный typing).
+func (h *Hub) PublishExcept(channelID, exceptUserID string, event *pb.ChatEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, sub := range h.subscribers[channelID] {
+		if sub.userID != exceptUserID {
+			sub.send(event)
+		}
+	}
+}
+
+// PublishTo доставляет событие только соединениям одного пользователя,
+// подписанным на канал (ephemeral-сообщения). Возвращает false, если событие
+// не попало ни в одно соединение.
+func (h *Hub) PublishTo(channelID, userID string, event *pb.ChatEvent) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	delivered := false
+	for _, sub := range h.subscribers[channelID] {
+		if sub.userID == userID && sub.send(event) {
+			delivered = true
 		}
 	}
+	return delivered
+}
+
+// UserTopic — топик личных событий пользователя (общий для всех его устройств).
+// Не пересекается с ID каналов: те всегда UUID.
+func UserTopic(userID string) string {
+	return "user:" + userID
+}
+
+// PublishUser рассылает событие всем подключениям пользователя к UserTopic.
+func (h *Hub) PublishUser(userID string, event *pb.ChatEvent) {
+	h.Publish(UserTopic(userID), event)
+}
+
+// send не блокирует: медленный клиент (полный буфер) пропускает событие.
+func (s *subscriber) send(event *pb.ChatEvent) bool {
+	select {
+	case s.ch <- event:
+		return true
+	default: // клиент не успевает — пропускаем
+		return false
+	}
 }
@@ -0,0 +1,74 @@
+package hub
+
+import (
+	"testing"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+)
+
+func TestHub_PublishExcept(t *testing.T) {
+	h := New()
+	alice, unsubAlice := h.Subscribe("general", "alice")
+	defer unsubAlice()
+	bob, unsubBob := h.Subscribe("general", "bob")
+	defer unsubBob()
+
+	h.PublishExcept("general", "alice", &pb.ChatEvent{})
+
+	select {
+	case <-bob:
+	default:
+		t.Error("bob should receive the event")
+	}
+	select {
+	case <-alice:
+		t.Error("alice should not receive her own event")
+	default:
+	}
+}
+
+func TestHub_PublishTo(t *testing.T) {
+	h := New()
+	alice, unsubAlice := h.Subscribe("general", "alice")
+	defer unsubAlice()
+	aliceOtherDevice, unsubAliceOther := h.Subscribe("general", "alice")
+	defer unsubAliceOther()
+	bob, unsubBob := h.Subscribe("general", "bob")
+	defer unsubBob()
+
+	if !h.PublishTo("general", "alice", &pb.ChatEvent{}) {
+		t.Error("expected the event to be delivered")
+	}
+	if h.PublishTo("general", "carol", &pb.ChatEvent{}) {
+		t.Error("carol is not subscribed, nothing should be delivered")
+	}
+
+	for _, events := range []<-chan *pb.ChatEvent{alice, aliceOtherDevice} {
+		select {
+		case <-events:
+		default:
+			t.Error("every alice connection should receive the event")
+		}
+	}
+	select {
+	case <-bob:
+		t.Error("bob should not receive alice's ephemeral event")
+	default:
+	}
+}
+
+func TestHub_HasSubscribers(t *testing.T) {
+	h := New()
+	topic := UserTopic("bot")
+	if h.HasSubscribers(topic) {
+		t.Fatal("nobody is subscribed yet")
+	}
+	_, unsubscribe := h.Subscribe(topic, "bot")
+	if !h.HasSubscribers(topic) {
+		t.Error("bot should be subscribed")
+	}
+	unsubscribe()
+	if h.HasSubscribers(topic) {
+		t.Error("subscription should be gone after unsubscribe")
+	}
+}
@@ -0,0 +1,77 @@
+// Package ratelimit реализует in-memory ограничение частоты запросов по ключу
+// (token bucket). Подходит для лёгких эфемерных действий вроде typing-событий,
+// где не нужна точность между несколькими узлами.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval — как часто вычищать полностью восстановленные бакеты.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter разрешает burst действий подряд и дальше по одному на каждый every.
+// Безопасен для конкурентного использования.
+type Limiter struct {
+	mu        sync.Mutex
+	every     time.Duration
+	burst     float64
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time // подменяется в тестах
+}
+
+func New(every time.Duration, burst int) *Limiter {
+	return &Limiter{
+		every:   every,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow списывает токен для key. Если токенов нет — возвращает false
+// и время, через которое появится следующий.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(l.burst, b.tokens+float64(now.Sub(b.last))/float64(l.every))
+	b.last = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) * float64(l.every))
+		return false, wait
+	}
+	b.tokens--
+	return true, 0
+}
+
+// sweep удаляет бакеты, которые успели полностью восстановиться:
+// они ничем не отличаются от новых, а память на них не нужна.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	full := time.Duration(l.burst * float64(l.every))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
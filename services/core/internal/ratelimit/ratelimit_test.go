@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+// newTestLimiter создаёт лимитер с управляемыми часами.
+func newTestLimiter(every time.Duration, burst int) (*Limiter, *time.Time) {
+	l := New(every, burst)
+	now := time.Unix(1_700_000_000, 0)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Run("allows burst then limits", func(t *testing.T) {
+		l, _ := newTestLimiter(time.Second, 3)
+		for i := range 3 {
+			if ok, _ := l.Allow("user"); !ok {
+				t.Fatalf("call %d should be allowed", i+1)
+			}
+		}
+		ok, wait := l.Allow("user")
+		if ok {
+			t.Fatal("call over burst should be limited")
+		}
+		if wait != time.Second {
+			t.Errorf("expected retry after 1s, got %v", wait)
+		}
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		l, now := newTestLimiter(time.Second, 1)
+		l.Allow("user")
+		if ok, _ := l.Allow("user"); ok {
+			t.Fatal("second call should be limited")
+		}
+		*now = now.Add(time.Second)
+		if ok, _ := l.Allow("user"); !ok {
+			t.Error("call after refill should be allowed")
+		}
+	})
+
+	t.Run("keys are independent", func(t *testing.T) {
+		l, _ := newTestLimiter(time.Second, 1)
+		l.Allow("alice")
+		if ok, _ := l.Allow("bob"); !ok {
+			t.Error("other key should not be limited")
+		}
+	})
+
+	t.Run("sweeps refilled buckets", func(t *testing.T) {
+		l, now := newTestLimiter(time.Second, 2)
+		l.Allow("alice")
+		*now = now.Add(sweepInterval)
+		l.Allow("bob")
+		if _, ok := l.buckets["alice"]; ok {
+			t.Error("refilled bucket should be swept")
+		}
+	})
+}
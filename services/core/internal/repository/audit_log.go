@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type auditLogGORMRepo struct{ BaseRepo[models.AuditLog] }
+
+func NewAuditLogRepository(db *gorm.DB) AuditLogRepository {
+	return &auditLogGORMRepo{BaseRepo: NewBaseRepo[models.AuditLog](db, nil)}
+}
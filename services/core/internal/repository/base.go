@@ -54,6 +54,27 @@ func (r *BaseRepo[T]) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// UpdateVersioned обновляет поля сущности с проверкой Optimistic Locking
+// (BaseEntity.Version). Версия в БД инкрементится при успешной записи.
+// Если запись изменили после чтения — возвращает errors.ErrVersionConflict.
+func (r *BaseRepo[T]) UpdateVersioned(ctx context.Context, id string, version uint, fields map[string]any) error {
+	fields["version"] = gorm.Expr("version + 1")
+
+	var entity T
+	res := r.DB(ctx).Model(&entity).Where("id = ? AND version = ?", id, version).Updates(fields)
+	if res.Error != nil {
+		return r.MapError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		// Либо записи нет, либо версия уже другая — различаем для клиента
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return err
+		}
+		return errors.ErrVersionConflict
+	}
+	return nil
+}
+
 // MapError переводит ошибки драйвера БД в доменные ошибки.
 // TODO: Вынести в core/pkg/errors
 func (r *BaseRepo[T]) MapError(err error) error {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
 	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -17,8 +18,63 @@ func NewChannelRepository(db *gorm.DB) ChannelRepository {
 func (r *channelGORMRepo) ListByGuild(ctx context.Context, guildID string) ([]models.Channel, error) {
 	var channels []models.Channel
 	err := r.DB(ctx).
-		Where("guild_id = ?", guildID).
+		Where("guild_id = ? AND type <> ?", guildID, models.ChannelTypeThread).
 		Order("position ASC, created_at ASC").
 		Find(&channels).Error
 	return channels, r.MapError(err)
 }
+
+func (r *channelGORMRepo) ListOverwrites(ctx context.Context, channelIDs []uuid.UUID) ([]models.ChannelPermissionOverwrite, error) {
+	var overwrites []models.ChannelPermissionOverwrite
+	if len(channelIDs) == 0 {
+		return overwrites, nil
+	}
+	err := r.DB(ctx).Where("channel_id IN ?", channelIDs).Find(&overwrites).Error
+	return overwrites, r.MapError(err)
+}
+
+func (r *channelGORMRepo) Update(ctx context.Context, id string, fields map[string]any) error {
+	res := r.DB(ctx).Model(&models.Channel{}).Where("id = ?", id).Updates(fields)
+	if res.Error != nil {
+		return r.MapError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errors.ErrChannelNotFound
+	}
+	return nil
+}
+
+func (r *channelGORMRepo) ListActiveThreads(ctx context.Context, parentID string) ([]models.Channel, error) {
+	var threads []models.Channel
+	err := r.DB(ctx).
+		Where("parent_id = ? AND type = ? AND is_archived = ?", parentID, models.ChannelTypeThread, false).
+		Order("created_at DESC").
+		Find(&threads).Error
+	return threads, r.MapError(err)
+}
+
+func (r *channelGORMRepo) DeleteThreads(ctx context.Context, parentID string) (int64, error) {
+	res := r.DB(ctx).
+		Where("parent_id = ? AND type = ?", parentID, models.ChannelTypeThread).
+		Delete(&models.Channel{})
+	return res.RowsAffected, r.MapError(res.Error)
+}
+
+func (r *channelGORMRepo) AddThreadMember(ctx context.Context, member *models.ThreadMember) error {
+	return r.MapError(r.DB(ctx).Create(member).Error)
+}
+
+func (r *channelGORMRepo) RemoveThreadMember(ctx context.Context, threadID, userID string) error {
+	err := r.DB(ctx).
+		Where("thread_id = ? AND user_id = ?", threadID, userID).
+		Delete(&models.ThreadMember{}).Error
+	return r.MapError(err)
+}
+
+func (r *channelGORMRepo) IsThreadMember(ctx context.Context, threadID, userID string) (bool, error) {
+	var count int64
+	err := r.DB(ctx).Model(&models.ThreadMember{}).
+		Where("thread_id = ? AND user_id = ?", threadID, userID).
+		Count(&count).Error
+	return count > 0, r.MapError(err)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *channelGORMRepo) CreateDM(ctx context.Context, ch *models.Channel) error {
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(ch).Error; err != nil {
+			return err
+		}
+		for i := range ch.Recipients {
+			ch.Recipients[i].ChannelID = ch.ID
+		}
+		return tx.Omit("User").Create(&ch.Recipients).Error
+	})
+	return r.MapError(err)
+}
+
+func (r *channelGORMRepo) FindDMByKey(ctx context.Context, key string) (*models.Channel, error) {
+	var ch models.Channel
+	err := r.DB(ctx).Where("dm_key = ?", key).First(&ch).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+	return &ch, nil
+}
+
+func (r *channelGORMRepo) FindDM(ctx context.Context, id string) (*models.Channel, error) {
+	var ch models.Channel
+	err := r.DB(ctx).
+		Preload("Recipients", func(db *gorm.DB) *gorm.DB { return db.Order("joined_at ASC, user_id ASC") }).
+		Preload("Recipients.User").
+		Where("id = ? AND type IN ?", id, []models.ChannelType{models.ChannelTypeDM, models.ChannelTypeGroupDM}).
+		First(&ch).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+	return &ch, nil
+}
+
+func (r *channelGORMRepo) ListDMs(ctx context.Context, userID string) ([]models.Channel, error) {
+	var channels []models.Channel
+	err := r.DB(ctx).
+		Preload("Recipients", func(db *gorm.DB) *gorm.DB { return db.Order("joined_at ASC, user_id ASC") }).
+		Preload("Recipients.User").
+		Where("id IN (?)", r.DB(ctx).Model(&models.ChannelRecipient{}).Select("channel_id").Where("user_id = ?", userID)).
+		// Последнее сообщение или, если их нет, создание канала
+		Order("COALESCE((SELECT MAX(m.created_at) FROM messages m WHERE m.channel_id = channels.id), channels.created_at) DESC").
+		Find(&channels).Error
+	return channels, r.MapError(err)
+}
+
+func (r *channelGORMRepo) AddRecipient(ctx context.Context, recipient *models.ChannelRecipient) error {
+	return r.MapError(r.DB(ctx).Omit("User").Create(recipient).Error)
+}
+
+func (r *channelGORMRepo) RemoveRecipient(ctx context.Context, channelID, userID string) error {
+	err := r.DB(ctx).
+		Where("channel_id = ? AND user_id = ?", channelID, userID).
+		Delete(&models.ChannelRecipient{}).Error
+	return r.MapError(err)
+}
+
+func (r *channelGORMRepo) IsRecipient(ctx context.Context, channelID, userID string) (bool, error) {
+	var count int64
+	err := r.DB(ctx).Model(&models.ChannelRecipient{}).
+		Where("channel_id = ? AND user_id = ?", channelID, userID).
+		Count(&count).Error
+	return count > 0, r.MapError(err)
+}
+
+func (r *channelGORMRepo) ListRecipientIDs(ctx context.Context, channelID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.DB(ctx).Model(&models.ChannelRecipient{}).
+		Where("channel_id = ?", channelID).
+		Order("joined_at ASC, user_id ASC").
+		Pluck("user_id", &ids).Error
+	return ids, r.MapError(err)
+}
@@ -0,0 +1,95 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestChannelRepository_DMs(t *testing.T) {
+	db := newMessageTestDB(t)
+	if err := db.AutoMigrate(&models.ChannelRecipient{}); err != nil {
+		t.Fatalf("failed to migrate recipients: %v", err)
+	}
+	repo := repository.NewChannelRepository(db)
+	ctx := context.Background()
+
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	newDM := func(key string, users ...uuid.UUID) *models.Channel {
+		ch := &models.Channel{Type: models.ChannelTypeDM, DMKey: &key}
+		for _, u := range users {
+			ch.Recipients = append(ch.Recipients, models.ChannelRecipient{UserID: u})
+		}
+		return ch
+	}
+
+	dm := newDM(alice.String()+":"+bob.String(), alice, bob)
+	if err := repo.CreateDM(ctx, dm); err != nil {
+		t.Fatalf("failed to create dm: %v", err)
+	}
+
+	t.Run("dm key is unique", func(t *testing.T) {
+		err := repo.CreateDM(ctx, newDM(*dm.DMKey, alice, bob))
+		if !domainerr.Is(err, domainerr.ErrConflict) {
+			t.Fatalf("expected conflict, got %v", err)
+		}
+		found, err := repo.FindDMByKey(ctx, *dm.DMKey)
+		if err != nil || found.ID != dm.ID {
+			t.Fatalf("expected existing dm, got %+v, %v", found, err)
+		}
+	})
+
+	group := &models.Channel{Type: models.ChannelTypeGroupDM, OwnerID: &alice, Name: "squad"}
+	group.Recipients = []models.ChannelRecipient{{UserID: alice}, {UserID: carol}}
+	if err := repo.CreateDM(ctx, group); err != nil {
+		t.Fatalf("failed to create group dm: %v", err)
+	}
+
+	t.Run("recipients", func(t *testing.T) {
+		if err := repo.AddRecipient(ctx, &models.ChannelRecipient{ChannelID: group.ID, UserID: bob}); err != nil {
+			t.Fatalf("failed to add recipient: %v", err)
+		}
+		if err := repo.AddRecipient(ctx, &models.ChannelRecipient{ChannelID: group.ID, UserID: bob}); !domainerr.Is(err, domainerr.ErrConflict) {
+			t.Errorf("expected conflict on duplicate recipient, got %v", err)
+		}
+		if err := repo.RemoveRecipient(ctx, group.ID.String(), carol.String()); err != nil {
+			t.Fatalf("failed to remove recipient: %v", err)
+		}
+		if ok, _ := repo.IsRecipient(ctx, group.ID.String(), carol.String()); ok {
+			t.Error("carol should no longer be a recipient")
+		}
+		ids, err := repo.ListRecipientIDs(ctx, group.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(ids) != 2 {
+			t.Errorf("expected 2 recipients, got %v", ids)
+		}
+	})
+
+	t.Run("ListDMs orders by last activity", func(t *testing.T) {
+		msgs := repository.NewMessageRepository(db)
+		if err := msgs.Create(ctx, &models.Message{ChannelID: dm.ID, AuthorID: bob, Content: "gg"}); err != nil {
+			t.Fatalf("failed to create message: %v", err)
+		}
+		channels, err := repo.ListDMs(ctx, bob.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(channels) != 2 || channels[0].ID != dm.ID || channels[1].ID != group.ID {
+			t.Fatalf("expected [dm, group], got %+v", channels)
+		}
+		if len(channels[0].Recipients) != 2 {
+			t.Errorf("expected preloaded recipients, got %+v", channels[0].Recipients)
+		}
+
+		carolDMs, _ := repo.ListDMs(ctx, carol.String())
+		if len(carolDMs) != 0 {
+			t.Errorf("removed recipient should not see the group, got %+v", carolDMs)
+		}
+	})
+}
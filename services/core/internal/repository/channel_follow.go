@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+func (r *channelGORMRepo) AddFollower(ctx context.Context, follower *models.ChannelFollower) error {
+	return r.MapError(r.DB(ctx).Omit("SourceChannel", "TargetChannel").Create(follower).Error)
+}
+
+func (r *channelGORMRepo) RemoveFollower(ctx context.Context, sourceID, targetID string) (bool, error) {
+	res := r.DB(ctx).
+		Where("source_channel_id = ? AND target_channel_id = ?", sourceID, targetID).
+		Delete(&models.ChannelFollower{})
+	return res.RowsAffected > 0, r.MapError(res.Error)
+}
+
+func (r *channelGORMRepo) ListFollowers(ctx context.Context, sourceID string) ([]models.ChannelFollower, error) {
+	var followers []models.ChannelFollower
+	// Каналы удаляются мягко, каскад не срабатывает — отсекаем удалённые получатели
+	err := r.DB(ctx).
+		Preload("TargetChannel").
+		Joins("JOIN channels t ON t.id = channel_followers.target_channel_id AND t.deleted_at IS NULL").
+		Where("channel_followers.source_channel_id = ?", sourceID).
+		Order("channel_followers.created_at ASC").
+		Find(&followers).Error
+	return followers, r.MapError(err)
+}
+
+func (r *channelGORMRepo) ListFollowing(ctx context.Context, targetID string) ([]models.ChannelFollower, error) {
+	var following []models.ChannelFollower
+	err := r.DB(ctx).
+		Preload("SourceChannel").
+		Joins("JOIN channels s ON s.id = channel_followers.source_channel_id AND s.deleted_at IS NULL").
+		Where("channel_followers.target_channel_id = ?", targetID).
+		Order("channel_followers.created_at ASC").
+		Find(&following).Error
+	return following, r.MapError(err)
+}
@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestChannelRepository_Followers(t *testing.T) {
+	db := newMessageTestDB(t)
+	if err := db.AutoMigrate(&models.ChannelFollower{}); err != nil {
+		t.Fatalf("failed to migrate followers: %v", err)
+	}
+	repo := repository.NewChannelRepository(db)
+	ctx := context.Background()
+
+	newsGuild, gameGuild := uuid.New(), uuid.New()
+	news := &models.Channel{GuildID: &newsGuild, Name: "event-news", Type: models.ChannelTypeAnnouncement}
+	cs := &models.Channel{GuildID: &gameGuild, Name: "cs-news", Type: models.ChannelTypeText}
+	dota := &models.Channel{GuildID: &gameGuild, Name: "dota-news", Type: models.ChannelTypeText}
+	for _, ch := range []*models.Channel{news, cs, dota} {
+		if err := repo.Create(ctx, ch); err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+	}
+	author := uuid.New()
+	for _, target := range []*models.Channel{cs, dota} {
+		err := repo.AddFollower(ctx, &models.ChannelFollower{SourceChannelID: news.ID, TargetChannelID: target.ID, CreatedBy: author})
+		if err != nil {
+			t.Fatalf("failed to follow: %v", err)
+		}
+	}
+
+	t.Run("duplicate follow conflicts", func(t *testing.T) {
+		err := repo.AddFollower(ctx, &models.ChannelFollower{SourceChannelID: news.ID, TargetChannelID: cs.ID, CreatedBy: author})
+		if !domainerr.Is(err, domainerr.ErrConflict) {
+			t.Fatalf("expected conflict, got %v", err)
+		}
+	})
+
+	t.Run("lists both directions", func(t *testing.T) {
+		followers, err := repo.ListFollowers(ctx, news.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(followers) != 2 || followers[0].TargetChannel.Name != "cs-news" {
+			t.Fatalf("expected two followers with channels, got %+v", followers)
+		}
+		following, err := repo.ListFollowing(ctx, dota.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(following) != 1 || following[0].SourceChannel.ID != news.ID {
+			t.Fatalf("expected news source, got %+v", following)
+		}
+	})
+
+	t.Run("deleted targets are skipped", func(t *testing.T) {
+		if err := repo.Delete(ctx, dota.ID.String()); err != nil {
+			t.Fatalf("failed to delete channel: %v", err)
+		}
+		followers, _ := repo.ListFollowers(ctx, news.ID.String())
+		if len(followers) != 1 || followers[0].TargetChannelID != cs.ID {
+			t.Errorf("expected only cs follower, got %+v", followers)
+		}
+	})
+
+	t.Run("unfollow", func(t *testing.T) {
+		removed, err := repo.RemoveFollower(ctx, news.ID.String(), cs.ID.String())
+		if err != nil || !removed {
+			t.Fatalf("expected removal, got %v, %v", removed, err)
+		}
+		removed, _ = repo.RemoveFollower(ctx, news.ID.String(), cs.ID.String())
+		if removed {
+			t.Error("second unfollow should report nothing removed")
+		}
+	})
+
+	t.Run("ListCrossposts", func(t *testing.T) {
+		msgs := repository.NewMessageRepository(db)
+		original := &models.Message{ChannelID: news.ID, AuthorID: author, Content: "finals at 18:00"}
+		if err := msgs.Create(ctx, original); err != nil {
+			t.Fatalf("failed to create original: %v", err)
+		}
+		copyMsg := &models.Message{ChannelID: cs.ID, AuthorID: author, Content: original.Content, CrosspostOfID: &original.ID}
+		if err := msgs.Create(ctx, copyMsg); err != nil {
+			t.Fatalf("failed to create crosspost: %v", err)
+		}
+		copies, err := msgs.ListCrossposts(ctx, []uuid.UUID{original.ID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(copies) != 1 || copies[0].ID != copyMsg.ID {
+			t.Fatalf("expected one crosspost, got %+v", copies)
+		}
+		if err := msgs.SoftDelete(ctx, copyMsg.ID.String(), author, nil); err != nil {
+			t.Fatalf("failed to delete crosspost: %v", err)
+		}
+		if copies, _ := msgs.ListCrossposts(ctx, []uuid.UUID{original.ID}); len(copies) != 0 {
+			t.Errorf("deleted crosspost should be skipped, got %+v", copies)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestChannelRepository_Threads(t *testing.T) {
+	db := newGuildTestDB(t)
+	if err := db.AutoMigrate(&models.ThreadMember{}); err != nil {
+		t.Fatalf("failed to migrate thread members: %v", err)
+	}
+	repo := repository.NewChannelRepository(db)
+	ctx := context.Background()
+
+	guildID := uuid.New()
+	parent := &models.Channel{GuildID: &guildID, Name: "tournament", Type: models.ChannelTypeText}
+	if err := repo.Create(ctx, parent); err != nil {
+		t.Fatalf("failed to create parent: %v", err)
+	}
+	active := &models.Channel{GuildID: &guildID, Name: "bracket A", Type: models.ChannelTypeThread, ParentID: &parent.ID}
+	archived := &models.Channel{GuildID: &guildID, Name: "bracket B", Type: models.ChannelTypeThread, ParentID: &parent.ID, IsArchived: true}
+	for _, ch := range []*models.Channel{active, archived} {
+		if err := repo.Create(ctx, ch); err != nil {
+			t.Fatalf("failed to create thread: %v", err)
+		}
+	}
+
+	t.Run("ListByGuild skips threads", func(t *testing.T) {
+		channels, err := repo.ListByGuild(ctx, guildID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(channels) != 1 || channels[0].ID != parent.ID {
+			t.Errorf("expected only parent channel, got %+v", channels)
+		}
+	})
+
+	t.Run("ListActiveThreads skips archived", func(t *testing.T) {
+		threads, err := repo.ListActiveThreads(ctx, parent.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(threads) != 1 || threads[0].ID != active.ID {
+			t.Errorf("expected only active thread, got %+v", threads)
+		}
+	})
+
+	t.Run("thread membership", func(t *testing.T) {
+		userID := uuid.New()
+		member := &models.ThreadMember{ThreadID: active.ID, UserID: userID}
+		if err := repo.AddThreadMember(ctx, member); err != nil {
+			t.Fatalf("failed to join: %v", err)
+		}
+		if err := repo.AddThreadMember(ctx, &models.ThreadMember{ThreadID: active.ID, UserID: userID}); !domainerr.Is(err, domainerr.ErrConflict) {
+			t.Errorf("expected ErrConflict on second join, got: %v", err)
+		}
+		if ok, _ := repo.IsThreadMember(ctx, active.ID.String(), userID.String()); !ok {
+			t.Error("user should be a thread member")
+		}
+		if err := repo.RemoveThreadMember(ctx, active.ID.String(), userID.String()); err != nil {
+			t.Fatalf("failed to leave: %v", err)
+		}
+		if ok, _ := repo.IsThreadMember(ctx, active.ID.String(), userID.String()); ok {
+			t.Error("user should have left the thread")
+		}
+	})
+
+	t.Run("DeleteThreads removes active and archived threads", func(t *testing.T) {
+		other := &models.Channel{GuildID: &guildID, Name: "other", Type: models.ChannelTypeText}
+		if err := repo.Create(ctx, other); err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+		foreign := &models.Channel{GuildID: &guildID, Name: "side", Type: models.ChannelTypeThread, ParentID: &other.ID}
+		if err := repo.Create(ctx, foreign); err != nil {
+			t.Fatalf("failed to create thread: %v", err)
+		}
+
+		n, err := repo.DeleteThreads(ctx, parent.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 2 {
+			t.Errorf("expected 2 deleted threads, got %d", n)
+		}
+		for _, th := range []*models.Channel{active, archived} {
+			if _, err := repo.FindByID(ctx, th.ID.String()); !domainerr.Is(err, domainerr.ErrChannelNotFound) {
+				t.Errorf("thread %s should be deleted, got %v", th.Name, err)
+			}
+		}
+		if _, err := repo.FindByID(ctx, foreign.ID.String()); err != nil {
+			t.Errorf("threads of other channels must stay, got %v", err)
+		}
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
 	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -59,6 +60,27 @@ func (r *guildGORMRepo) IsMember(ctx context.Context, guildID, userID string) (b
 	return count > 0, r.MapError(err)
 }
 
+func (r *guildGORMRepo) SharesGuild(ctx context.Context, userID, otherID string) (bool, error) {
+	var count int64
+	err := r.DB(ctx).Table("guild_members AS a").
+		Joins("JOIN guild_members b ON b.guild_id = a.guild_id").
+		Where("a.user_id = ? AND b.user_id = ?", userID, otherID).
+		Limit(1).
+		Count(&count).Error
+	return count > 0, r.MapError(err)
+}
+
+func (r *guildGORMRepo) FindMember(ctx context.Context, guildID, userID string) (*models.GuildMember, error) {
+	var m models.GuildMember
+	err := r.DB(ctx).
+		Where("guild_id = ? AND user_id = ?", guildID, userID).
+		First(&m).Error
+	if err != nil {
+		return nil, r.MapError(mapNotFound(err, errors.ErrMemberNotFound))
+	}
+	return &m, nil
+}
+
 func (r *guildGORMRepo) ListMembers(ctx context.Context, guildID string) ([]models.GuildMember, error) {
 	var members []models.GuildMember
 	err := r.DB(ctx).
@@ -68,6 +90,74 @@ func (r *guildGORMRepo) ListMembers(ctx context.Context, guildID string) ([]mode
 	return members, r.MapError(err)
 }
 
+func (r *guildGORMRepo) ListMembersByUserIDs(ctx context.Context, guildID string, userIDs []string) ([]models.GuildMember, error) {
+	var members []models.GuildMember
+	if len(userIDs) == 0 {
+		return members, nil
+	}
+	err := r.DB(ctx).
+		Preload("User").
+		Where("guild_id = ? AND user_id IN ?", guildID, userIDs).
+		Find(&members).Error
+	return members, r.MapError(err)
+}
+
+func (r *guildGORMRepo) ListMemberIDs(ctx context.Context, guildID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.DB(ctx).Model(&models.GuildMember{}).
+		Where("guild_id = ?", guildID).
+		Pluck("user_id", &ids).Error
+	return ids, r.MapError(err)
+}
+
+func (r *guildGORMRepo) ListMemberIDsByRoles(ctx context.Context, guildID string, roleIDs []uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if len(roleIDs) == 0 {
+		return ids, nil
+	}
+	err := r.DB(ctx).Model(&models.MemberRole{}).
+		Distinct("user_id").
+		Where("guild_id = ? AND role_id IN ?", guildID, roleIDs).
+		Pluck("user_id", &ids).Error
+	return ids, r.MapError(err)
+}
+
+func (r *guildGORMRepo) ListMemberRoleIDs(ctx context.Context, guildID, userID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.DB(ctx).Model(&models.MemberRole{}).
+		Where("guild_id = ? AND user_id = ?", guildID, userID).
+		Pluck("role_id", &ids).Error
+	return ids, r.MapError(err)
+}
+
+func (r *guildGORMRepo) ListRolesByIDs(ctx context.Context, guildID string, roleIDs []uuid.UUID) ([]models.Role, error) {
+	var roles []models.Role
+	if len(roleIDs) == 0 {
+		return roles, nil
+	}
+	err := r.DB(ctx).
+		Where("guild_id = ? AND id IN ?", guildID, roleIDs).
+		Find(&roles).Error
+	return roles, r.MapError(err)
+}
+
+func (r *guildGORMRepo) UpdateMember(ctx context.Context, guildID, userID string, fields map[string]any) error {
+	// Ключ участника менять нельзя
+	delete(fields, "guild_id")
+	delete(fields, "user_id")
+
+	res := r.DB(ctx).Model(&models.GuildMember{}).
+		Where("guild_id = ? AND user_id = ?", guildID, userID).
+		Updates(fields)
+	if res.Error != nil {
+		return r.MapError(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return errors.ErrMemberNotFound
+	}
+	return nil
+}
+
 func (r *guildGORMRepo) CreateInvite(ctx context.Context, inv *models.GuildInvite) error {
 	if inv.Code == "" {
 		code, err := generateInviteCode()
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// newGuildTestDB добавляет к тестовой БД таблицы гильдий.
+func newGuildTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&models.Guild{}, &models.GuildMember{}, &models.Channel{}, &models.ChannelRecipient{}); err != nil {
+		t.Fatalf("failed to migrate guild tables: %v", err)
+	}
+	return db
+}
+
+func TestGuildRepository_UpdateVersioned(t *testing.T) {
+	repo := repository.NewGuildRepository(newGuildTestDB(t))
+	ctx := context.Background()
+
+	guild := &models.Guild{Name: "LAN Party", OwnerID: uuid.New()}
+	if err := repo.Create(ctx, guild); err != nil {
+		t.Fatalf("failed to create guild: %v", err)
+	}
+
+	t.Run("updates fields and bumps version", func(t *testing.T) {
+		err := repo.UpdateVersioned(ctx, guild.ID.String(), 1, map[string]any{"name": "LAN Party 2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		updated, _ := repo.FindByID(ctx, guild.ID.String())
+		if updated.Name != "LAN Party 2" {
+			t.Errorf("name not updated, got: %q", updated.Name)
+		}
+		if updated.Version != 2 {
+			t.Errorf("expected version 2, got %d", updated.Version)
+		}
+	})
+
+	t.Run("returns ErrVersionConflict for stale version", func(t *testing.T) {
+		err := repo.UpdateVersioned(ctx, guild.ID.String(), 1, map[string]any{"name": "Stale"})
+		if !domainerr.Is(err, domainerr.ErrVersionConflict) {
+			t.Errorf("expected ErrVersionConflict, got: %v", err)
+		}
+	})
+
+	t.Run("returns ErrGuildNotFound for unknown id", func(t *testing.T) {
+		err := repo.UpdateVersioned(ctx, uuid.NewString(), 1, map[string]any{"name": "Ghost"})
+		if !domainerr.Is(err, domainerr.ErrGuildNotFound) {
+			t.Errorf("expected ErrGuildNotFound, got: %v", err)
+		}
+	})
+}
@@ -14,8 +14,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
 )
 
 // UserRepository — контракт доступа к данным пользователей.
@@ -57,6 +59,9 @@ type UserRepository interface {
 type GuildRepository interface {
 	Create(ctx context.Context, guild *models.Guild) error
 	FindByID(ctx context.Context, id string) (*models.Guild, error)
+	// UpdateVersioned обновляет поля гильдии, если её версия всё ещё равна version.
+	// Иначе возвращает errors.ErrVersionConflict.
+	UpdateVersioned(ctx context.Context, id string, version uint, fields map[string]any) error
 	ListByMember(ctx context.Context, userID string) ([]models.Guild, error)
 	Delete(ctx context.Context, id string) error
 	MemberCount(ctx context.Context, guildID string) (int64, error)
@@ -65,7 +70,24 @@ type GuildRepository interface {
 	AddMember(ctx context.Context, m *models.GuildMember) error
 	RemoveMember(ctx context.Context, guildID, userID string) error
 	IsMember(ctx context.Context, guildID, userID string) (bool, error)
+	// SharesGuild проверяет, есть ли у двух пользователей общая гильдия.
+	SharesGuild(ctx context.Context, userID, otherID string) (bool, error)
+	// FindMember возвращает участника гильдии. Ошибка errors.ErrMemberNotFound если не найден.
+	FindMember(ctx context.Context, guildID, userID string) (*models.GuildMember, error)
 	ListMembers(ctx context.Context, guildID string) ([]models.GuildMember, error)
+	// ListMembersByUserIDs возвращает участников гильдии из списка userIDs (с профилями User).
+	// Отсутствующие в гильдии пользователи просто не попадают в результат.
+	ListMembersByUserIDs(ctx context.Context, guildID string, userIDs []string) ([]models.GuildMember, error)
+	// ListMemberIDs возвращает ID всех участников гильдии.
+	ListMemberIDs(ctx context.Context, guildID string) ([]uuid.UUID, error)
+	// ListMemberIDsByRoles возвращает ID участников, у которых есть хотя бы одна из ролей.
+	ListMemberIDsByRoles(ctx context.Context, guildID string, roleIDs []uuid.UUID) ([]uuid.UUID, error)
+	// ListMemberRoleIDs возвращает ID ролей участника.
+	ListMemberRoleIDs(ctx context.Context, guildID, userID string) ([]uuid.UUID, error)
+	// ListRolesByIDs возвращает роли гильдии из списка; чужие и несуществующие пропускаются.
+	ListRolesByIDs(ctx context.Context, guildID string, roleIDs []uuid.UUID) ([]models.Role, error)
+	// UpdateMember обновляет поля участника (nickname, avatar_url и т.д.).
+	UpdateMember(ctx context.Context, guildID, userID string, fields map[string]any) error
 
 	// Инвайты
 	CreateInvite(ctx context.Context, inv *models.GuildInvite) error
@@ -77,15 +99,236 @@ type GuildRepository interface {
 type ChannelRepository interface {
 	Create(ctx context.Context, ch *models.Channel) error
 	FindByID(ctx context.Context, id string) (*models.Channel, error)
+	// ListByGuild возвращает каналы гильдии без веток.
 	ListByGuild(ctx context.Context, guildID string) ([]models.Channel, error)
 	Delete(ctx context.Context, id string) error
+	Update(ctx context.Context, id string, fields map[string]any) error
+	// ListOverwrites возвращает переопределения прав для набора каналов.
+	ListOverwrites(ctx context.Context, channelIDs []uuid.UUID) ([]models.ChannelPermissionOverwrite, error)
+
+	// ListActiveThreads возвращает неархивированные ветки канала, новые сверху.
+	ListActiveThreads(ctx context.Context, parentID string) ([]models.Channel, error)
+	// DeleteThreads удаляет (мягко) все ветки канала, в том числе архивные.
+	DeleteThreads(ctx context.Context, parentID string) (int64, error)
+	// AddThreadMember добавляет участника ветки. Повторное вступление — errors.ErrConflict.
+	AddThreadMember(ctx context.Context, member *models.ThreadMember) error
+	RemoveThreadMember(ctx context.Context, threadID, userID string) error
+	IsThreadMember(ctx context.Context, threadID, userID string) (bool, error)
+
+	// CreateDM создаёт личный канал вместе с ch.Recipients. Если dm с тем же
+	// DMKey уже есть — errors.ErrConflict.
+	CreateDM(ctx context.Context, ch *models.Channel) error
+	// FindDMByKey находит dm по Channel.DMKey.
+	FindDMByKey(ctx context.Context, key string) (*models.Channel, error)
+	// FindDM загружает личный канал с участниками (и их профилями).
+	FindDM(ctx context.Context, id string) (*models.Channel, error)
+	// ListDMs возвращает личные каналы пользователя с участниками,
+	// последние по активности сверху.
+	ListDMs(ctx context.Context, userID string) ([]models.Channel, error)
+	// AddRecipient добавляет участника личного канала. Уже участник — errors.ErrConflict.
+	AddRecipient(ctx context.Context, recipient *models.ChannelRecipient) error
+	RemoveRecipient(ctx context.Context, channelID, userID string) error
+	IsRecipient(ctx context.Context, channelID, userID string) (bool, error)
+	// ListRecipientIDs возвращает ID участников личного канала в порядке вступления.
+	ListRecipientIDs(ctx context.Context, channelID string) ([]uuid.UUID, error)
+
+	// AddFollower подписывает канал на канал объявлений. Уже подписан — errors.ErrConflict.
+	AddFollower(ctx context.Context, follower *models.ChannelFollower) error
+	// RemoveFollower снимает подписку. false — её не было.
+	RemoveFollower(ctx context.Context, sourceID, targetID string) (bool, error)
+	// ListFollowers возвращает подписки на канал объявлений (с каналами-получателями),
+	// кроме подписок удалённых каналов.
+	ListFollowers(ctx context.Context, sourceID string) ([]models.ChannelFollower, error)
+	// ListFollowing возвращает каналы объявлений, на которые подписан targetID
+	// (с самими каналами-источниками), кроме удалённых.
+	ListFollowing(ctx context.Context, targetID string) ([]models.ChannelFollower, error)
 }
 
 // MessageRepository хранит историю сообщений.
 type MessageRepository interface {
 	Create(ctx context.Context, msg *models.Message) error
-	// GetHistory возвращает limit сообщений из канала, старше beforeID.
-	// Если beforeID пусто — возвращает самые последние.
-	GetHistory(ctx context.Context, channelID string, limit int, beforeID string) ([]models.Message, error)
+	// GetHistory возвращает до Limit живых сообщений канала по курсору Seq,
+	// старые сверху. Без курсоров — самые последние.
+	GetHistory(ctx context.Context, query HistoryQuery) ([]models.Message, error)
+	// ListChanges возвращает сообщения канала (и удалённые тоже) с ChangeSeq
+	// больше afterChangeSeq, по возрастанию ChangeSeq.
+	ListChanges(ctx context.Context, channelID string, afterChangeSeq int64, limit int) ([]models.Message, error)
+	FindByID(ctx context.Context, id string) (*models.Message, error)
+	// FindByNonce возвращает сообщение, отправленное автором в канал с этим nonce
+	// не раньше since. Нет такого — errors.ErrMessageNotFound.
+	FindByNonce(ctx context.Context, authorID, channelID, nonce string, since time.Time) (*models.Message, error)
+	// ClaimNonce закрепляет nonce за сообщением. Если он уже занят записью новее
+	// since — errors.ErrConflict. Заодно удаляет устаревшие nonce автора.
+	ClaimNonce(ctx context.Context, nonce *models.MessageNonce, since time.Time) error
+	// ListCrossposts возвращает живые копии объявлений в каналах-подписчиках.
+	ListCrossposts(ctx context.Context, messageIDs []uuid.UUID) ([]models.Message, error)
+	// FindByIDsWithDeleted загружает сообщения вместе с удалёнными (для превью ответов).
+	FindByIDsWithDeleted(ctx context.Context, ids []uuid.UUID) ([]models.Message, error)
 	Delete(ctx context.Context, id string) error
+
+	// UpdateContent заменяет текст, если EditVersion в БД совпадает с editVersion.
+	// Бампает EditVersion и ставит флаг Edited. Иначе — errors.ErrVersionConflict.
+	UpdateContent(ctx context.Context, id string, editVersion int, content string, editedAt time.Time) error
+	CreateEdit(ctx context.Context, edit *models.MessageEdit) error
+	// UpdateComponents заменяет текст и компоненты по ответу бота на взаимодействие.
+	// Бампает EditVersion, но правкой не считается (без флага Edited и истории).
+	// Если EditVersion в БД не совпадает с editVersion — errors.ErrVersionConflict.
+	UpdateComponents(ctx context.Context, id string, editVersion int, content string, components []models.ActionRow) error
+	// SetEmbeds сохраняет превью ссылок, если сообщение не удалено и не правилось
+	// после editVersion. false — превью устарели и не записаны.
+	SetEmbeds(ctx context.Context, id string, editVersion int, embeds []models.Embed) (bool, error)
+	// ListEdits возвращает историю правок сообщения, от старых к новым.
+	ListEdits(ctx context.Context, messageID string) ([]models.MessageEdit, error)
+
+	// SoftDelete помечает сообщение удалённым, запоминая кто и почему удалил.
+	SoftDelete(ctx context.Context, id string, deletedBy uuid.UUID, reason *string) error
+	// AttachThread связывает сообщение с начатой от него веткой.
+	// Если ветка уже есть — errors.ErrThreadExists.
+	AttachThread(ctx context.Context, messageID string, threadID uuid.UUID) error
+
+	// SetPinned закрепляет/открепляет сообщение. false — состояние уже было таким.
+	// Закрепление, если в канале уже maxPins закрепов (0 — без лимита), вернёт
+	// ошибку CodeMaxPinsReached; проверка и запись атомарны.
+	SetPinned(ctx context.Context, id string, pinned bool, maxPins int) (bool, error)
+	CountPinned(ctx context.Context, channelID string) (int64, error)
+	// ListPinned возвращает закрепы канала, последние закреплённые первыми.
+	ListPinned(ctx context.Context, channelID string) ([]models.Message, error)
+	// FindIDs возвращает ID живых сообщений канала по фильтру, от новых к старым.
+	FindIDs(ctx context.Context, filter MessageFilter) ([]uuid.UUID, error)
+	// SoftDeleteMany удаляет пачку сообщений одним запросом. Возвращает число удалённых.
+	SoftDeleteMany(ctx context.Context, ids []uuid.UUID, deletedBy uuid.UUID, reason *string) (int64, error)
+
+	// AddReaction ставит реакцию. Повторная — errors.ErrConflict.
+	AddReaction(ctx context.Context, reaction *models.MessageReaction) error
+	// RemoveReaction снимает реакцию пользователя. false — реакции не было.
+	RemoveReaction(ctx context.Context, messageID, userID, emoji string) (bool, error)
+	// RemoveReactions снимает все реакции с сообщения (или только emoji, если задан).
+	RemoveReactions(ctx context.Context, messageID, emoji string) (int64, error)
+	// ReactionEmojis возвращает различные эмодзи, которыми отреагировали на сообщение.
+	ReactionEmojis(ctx context.Context, messageID string) ([]string, error)
+	// ReactionSummaries агрегирует реакции на пачку сообщений для viewerID.
+	ReactionSummaries(ctx context.Context, messageIDs []uuid.UUID, viewerID string) ([]models.ReactionSummary, error)
+	// ListReactors возвращает пользователей с реакцией emoji, по возрастанию ID.
+	ListReactors(ctx context.Context, messageID, emoji string, limit int, afterUserID string) ([]models.User, error)
+
+	// CreateMentions сохраняет адресатов упоминаний сообщения.
+	CreateMentions(ctx context.Context, mentions []models.MessageMention) error
+	// ReplaceMentions после правки заменяет упоминания сообщения: колонку
+	// mentions и адресатов во входящих. Изменение сообщения отмечает
+	// UpdateContent той же транзакции.
+	ReplaceMentions(ctx context.Context, messageID string, mentions *models.MessageMentions, rows []models.MessageMention) error
+	// ListMentions возвращает сообщения, упоминающие userID, новые сверху.
+	// Только из гильдий, где пользователь всё ещё состоит.
+	ListMentions(ctx context.Context, filter MentionFilter) ([]models.Message, error)
+
+	// Search ищет сообщения по тексту и фильтрам, новые сверху.
+	// Возвращает до Limit+1 сообщений: лишнее означает, что есть ещё.
+	Search(ctx context.Context, search MessageSearch) ([]models.Message, error)
+}
+
+// MessageSearch — параметры поиска. ChannelIDs обязателен: это каналы, видимые
+// пользователю; ветки этих каналов ищутся автоматически.
+type MessageSearch struct {
+	Query          string
+	ChannelIDs     []uuid.UUID
+	AuthorID       string
+	After          *time.Time
+	Before         *time.Time
+	HasAttachment  bool
+	HasLink        bool
+	Pinned         bool
+	MentionsUserID string // Только сообщения, упоминающие этого пользователя
+	Limit          int
+	Offset         int
+}
+
+// HistoryQuery — страница истории канала. Задаётся не больше одного курсора.
+type HistoryQuery struct {
+	ChannelID string
+	BeforeSeq int64 // Сообщения с Seq меньше
+	AfterSeq  int64 // Сообщения с Seq больше, от старых к новым
+	FromSeq   int64 // Сообщения с Seq не меньше, от старых к новым
+	Limit     int
+}
+
+// Forward сообщает, что выборка идёт от курсора к новым сообщениям.
+func (q HistoryQuery) Forward() bool {
+	return q.AfterSeq > 0 || q.FromSeq > 0
+}
+
+// MentionFilter — выборка входящих упоминаний пользователя.
+type MentionFilter struct {
+	UserID  string
+	GuildID string     // Пусто — все гильдии
+	Before  *time.Time // Курсор: упоминания старше
+	Limit   int
+	// HiddenChannelIDs — каналы, скрытые от пользователя переопределениями
+	// прав; их ветки тоже пропускаются
+	HiddenChannelIDs []uuid.UUID
+}
+
+// MessageFilter — условия выборки сообщений канала. Пустые поля не учитываются.
+type MessageFilter struct {
+	ChannelID string
+	IDs       []string
+	AuthorID  string
+	After     *time.Time
+	Before    *time.Time
+	Limit     int
+}
+
+// ReadStateRepository хранит позиции чтения пользователей.
+type ReadStateRepository interface {
+	// Ack двигает позицию чтения вперёд (назад — игнорируется).
+	Ack(ctx context.Context, state *models.ReadState) error
+	Find(ctx context.Context, userID, channelID string) (*models.ReadState, error)
+	// ListUnread возвращает каналы гильдий пользователя (и ветки, где он участник),
+	// в которых есть непрочитанные сообщения, со счётчиком непрочитанных упоминаний.
+	// Считаются только живые несистемные сообщения. Переопределения прав не
+	// учитываются — скрытые каналы отсеивает сервис.
+	ListUnread(ctx context.Context, userID string) ([]models.ChannelUnread, error)
+}
+
+// ScheduledMessageRepository — очередь запланированных сообщений.
+type ScheduledMessageRepository interface {
+	Create(ctx context.Context, sm *models.ScheduledMessage) error
+	FindByID(ctx context.Context, id string) (*models.ScheduledMessage, error)
+	// ListUnsent возвращает ожидающие и неудавшиеся сообщения канала по SendAt.
+	// authorID == "" — всех авторов.
+	ListUnsent(ctx context.Context, channelID, authorID string) ([]models.ScheduledMessage, error)
+	CountPending(ctx context.Context, channelID string) (int64, error)
+	// DeleteUnlocked удаляет неотправленное сообщение, если его прямо сейчас
+	// не отправляет планировщик. false — сообщение уже в отправке.
+	DeleteUnlocked(ctx context.Context, id string, now time.Time) (bool, error)
+	// ClaimDue берёт в отправку до limit сообщений, чей NextAttemptAt наступил:
+	// ставит аренду до lockedUntil и увеличивает Attempts. Каждую запись
+	// получает только один экземпляр — по условному UPDATE истёкшей аренды.
+	ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ScheduledMessage, error)
+	// Finish переводит сообщение в итоговый статус (sent или failed) и снимает аренду.
+	Finish(ctx context.Context, id string, status models.ScheduledMessageStatus, messageID *uuid.UUID, lastError string) error
+	// Retry снимает аренду и откладывает следующую попытку до retryAt.
+	Retry(ctx context.Context, id string, retryAt time.Time, lastError string) error
+}
+
+// RelationshipRepository хранит друзей, заявки в друзья и блокировки.
+type RelationshipRepository interface {
+	// Find возвращает запись userID о targetID. Ошибка errors.ErrNotFound если её нет.
+	Find(ctx context.Context, userID, targetID string) (*models.Relationship, error)
+	// List возвращает все записи пользователя с профилями Target.
+	List(ctx context.Context, userID string) ([]models.Relationship, error)
+	// CountByType считает записи пользователя указанных типов.
+	CountByType(ctx context.Context, userID string, types ...models.RelationshipType) (int64, error)
+	// Put создаёт или заменяет записи (по паре user_id, target_id).
+	Put(ctx context.Context, rels ...*models.Relationship) error
+	// Delete удаляет запись userID о targetID; отсутствие записи — не ошибка.
+	Delete(ctx context.Context, userID, targetID string) error
+	// IsBlocked проверяет, заблокировал ли кто-то из двоих другого.
+	IsBlocked(ctx context.Context, userID, otherID string) (bool, error)
+	// ListBlockedIDs возвращает пользователей, заблокированных userID.
+	ListBlockedIDs(ctx context.Context, userID string) ([]uuid.UUID, error)
+}
+
+// AuditLogRepository пишет журнал модерации гильдий.
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *models.AuditLog) error
 }
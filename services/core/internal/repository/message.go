@@ -2,13 +2,25 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
 	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-type messageGORMRepo struct{ BaseRepo[models.Message] }
+type messageGORMRepo struct {
+	BaseRepo[models.Message]
+
+	// Есть ли FTS5-индекс (SQLite); проверяется при первом поиске
+	ftsOnce sync.Once
+	fts     bool
+}
 
 func NewMessageRepository(db *gorm.DB) MessageRepository {
 	return &messageGORMRepo{BaseRepo: NewBaseRepo[models.Message](db, domainerr.ErrMessageNotFound)}
@@ -29,35 +41,446 @@ func (r *messageGORMRepo) Create(ctx context.Context, msg *models.Message) error
 		}
 
 		msg.Seq = nextSeq - 1 // Устанавливаем полученный номер
+		if msg.ChangeSeq, err = allocChangeSeq(tx, msg.ChannelID, 1); err != nil {
+			return err
+		}
 		return tx.Create(msg).Error
 	})
 }
 
-func (r *messageGORMRepo) GetHistory(ctx context.Context, channelID string, limit int, beforeID string) ([]models.Message, error) {
-	if limit <= 0 || limit > 100 {
-		limit = 50
-	}
-
+func (r *messageGORMRepo) GetHistory(ctx context.Context, query HistoryQuery) ([]models.Message, error) {
 	q := r.DB(ctx).
 		Preload("Author").
-		Where("channel_id = ?", channelID).
-		Order("created_at DESC").
-		Limit(limit + 1) // +1 чтобы определить has_more
+		Where("channel_id = ?", query.ChannelID).
+		Limit(query.Limit)
 
-	if beforeID != "" {
-		// Курсор: сообщения старше beforeID
-		// UUIDv7 лексикографически сортируется по времени — сравнение по строке работает
-		q = q.Where("id < ?", beforeID)
+	// С after_seq/from_seq идём от курсора вперёд, иначе — назад от конца или before_seq
+	switch {
+	case query.AfterSeq > 0:
+		q = q.Where("seq > ?", query.AfterSeq).Order("seq ASC")
+	case query.FromSeq > 0:
+		q = q.Where("seq >= ?", query.FromSeq).Order("seq ASC")
+	default:
+		q = q.Order("seq DESC")
+		if query.BeforeSeq > 0 {
+			q = q.Where("seq < ?", query.BeforeSeq)
+		}
 	}
 
 	var msgs []models.Message
 	if err := q.Find(&msgs).Error; err != nil {
-		return nil, err
+		return nil, r.MapError(err)
 	}
 
-	// Разворачиваем (DESC → ASC для отображения, старые сверху)
-	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
-		msgs[i], msgs[j] = msgs[j], msgs[i]
+	if !query.Forward() {
+		// Разворачиваем (DESC → ASC для отображения, старые сверху)
+		for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+			msgs[i], msgs[j] = msgs[j], msgs[i]
+		}
 	}
 	return msgs, nil
 }
+
+func (r *messageGORMRepo) FindByIDsWithDeleted(ctx context.Context, ids []uuid.UUID) ([]models.Message, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var msgs []models.Message
+	err := r.DB(ctx).Unscoped().
+		Preload("Author").
+		Where("id IN ?", ids).
+		Find(&msgs).Error
+	return msgs, r.MapError(err)
+}
+
+func (r *messageGORMRepo) ListCrossposts(ctx context.Context, messageIDs []uuid.UUID) ([]models.Message, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	var msgs []models.Message
+	err := r.DB(ctx).
+		Where("crosspost_of_id IN ?", messageIDs).
+		Order("created_at ASC").
+		Find(&msgs).Error
+	return msgs, r.MapError(err)
+}
+
+func (r *messageGORMRepo) UpdateContent(ctx context.Context, id string, editVersion int, content string, editedAt time.Time) error {
+	changed, err := r.updateTracked(ctx, id, map[string]any{
+		"content":      content,
+		"edited_at":    editedAt,
+		"edit_version": gorm.Expr("edit_version + 1"),
+		"flags":        gorm.Expr("flags | ?", models.MessageFlagEdited),
+	}, "edit_version = ?", editVersion)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return err
+		}
+		return domainerr.ErrVersionConflict
+	}
+	return nil
+}
+
+func (r *messageGORMRepo) UpdateComponents(ctx context.Context, id string, editVersion int, content string, components []models.ActionRow) error {
+	raw, err := json.Marshal(components)
+	if err != nil {
+		return err
+	}
+	changed, err := r.updateTracked(ctx, id, map[string]any{
+		"content":      content,
+		"components":   string(raw),
+		"edit_version": gorm.Expr("edit_version + 1"),
+	}, "edit_version = ?", editVersion)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		if _, err := r.FindByID(ctx, id); err != nil {
+			return err
+		}
+		return domainerr.ErrVersionConflict
+	}
+	return nil
+}
+
+func (r *messageGORMRepo) SetEmbeds(ctx context.Context, id string, editVersion int, embeds []models.Embed) (bool, error) {
+	// Updates с map не применяет сериализатор поля — кодируем сами
+	raw, err := json.Marshal(embeds)
+	if err != nil {
+		return false, err
+	}
+	return r.updateTracked(ctx, id, map[string]any{"embeds": string(raw)}, "edit_version = ?", editVersion)
+}
+
+func (r *messageGORMRepo) CreateEdit(ctx context.Context, edit *models.MessageEdit) error {
+	return r.MapError(r.DB(ctx).Create(edit).Error)
+}
+
+func (r *messageGORMRepo) ListEdits(ctx context.Context, messageID string) ([]models.MessageEdit, error) {
+	var edits []models.MessageEdit
+	err := r.DB(ctx).
+		Where("message_id = ?", messageID).
+		Order("edit_version ASC").
+		Find(&edits).Error
+	return edits, r.MapError(err)
+}
+
+func (r *messageGORMRepo) SoftDelete(ctx context.Context, id string, deletedBy uuid.UUID, reason *string) error {
+	// Default scope отсекает уже удалённые — повторное удаление вернёт NotFound
+	changed, err := r.updateTracked(ctx, id, map[string]any{
+		"deleted_at":      time.Now(),
+		"deleted_by":      deletedBy,
+		"deletion_reason": reason,
+	}, "")
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return domainerr.ErrMessageNotFound
+	}
+	return nil
+}
+
+// findIDsBatchSize — сколько ID из MessageFilter.IDs уходит в один IN.
+const findIDsBatchSize = 100
+
+// messageStamp — ID сообщения с временем создания для сортировки.
+type messageStamp struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+func (r *messageGORMRepo) FindIDs(ctx context.Context, filter MessageFilter) ([]uuid.UUID, error) {
+	var rows []messageStamp
+	if len(filter.IDs) == 0 {
+		q := r.filterQuery(ctx, filter).Order("created_at DESC")
+		if filter.Limit > 0 {
+			q = q.Limit(filter.Limit)
+		}
+		if err := q.Find(&rows).Error; err != nil {
+			return nil, r.MapError(err)
+		}
+	} else {
+		// Пачками, чтобы не упереться в лимит параметров запроса; порядок и
+		// Limit применяем к объединённому результату
+		for start := 0; start < len(filter.IDs); start += findIDsBatchSize {
+			batch := filter.IDs[start:min(start+findIDsBatchSize, len(filter.IDs))]
+			var part []messageStamp
+			if err := r.filterQuery(ctx, filter).Where("id IN ?", batch).Find(&part).Error; err != nil {
+				return nil, r.MapError(err)
+			}
+			rows = append(rows, part...)
+		}
+		slices.SortStableFunc(rows, func(a, b messageStamp) int {
+			return b.CreatedAt.Compare(a.CreatedAt)
+		})
+		if filter.Limit > 0 && len(rows) > filter.Limit {
+			rows = rows[:filter.Limit]
+		}
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].ID
+	}
+	return ids, nil
+}
+
+// filterQuery — выборка id и created_at по условиям фильтра, кроме IDs и Limit.
+func (r *messageGORMRepo) filterQuery(ctx context.Context, filter MessageFilter) *gorm.DB {
+	q := r.DB(ctx).Model(&models.Message{}).
+		Select("id, created_at").
+		Where("channel_id = ?", filter.ChannelID)
+	if filter.AuthorID != "" {
+		q = q.Where("author_id = ?", filter.AuthorID)
+	}
+	if filter.After != nil {
+		q = q.Where("created_at > ?", *filter.After)
+	}
+	if filter.Before != nil {
+		q = q.Where("created_at < ?", *filter.Before)
+	}
+	return q
+}
+
+func (r *messageGORMRepo) SoftDeleteMany(ctx context.Context, ids []uuid.UUID, deletedBy uuid.UUID, reason *string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	var deleted int64
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		// Запоминаем живые до удаления: ChangeSeq получат только они
+		var live []struct {
+			ID        uuid.UUID
+			ChannelID uuid.UUID
+		}
+		if err := tx.Model(&models.Message{}).Select("id, channel_id").Where("id IN ?", ids).Find(&live).Error; err != nil {
+			return err
+		}
+		res := tx.Model(&models.Message{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"deleted_at":      time.Now(),
+				"deleted_by":      deletedBy,
+				"deletion_reason": reason,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		deleted = res.RowsAffected
+
+		byChannel := make(map[uuid.UUID][]uuid.UUID)
+		for _, m := range live {
+			byChannel[m.ChannelID] = append(byChannel[m.ChannelID], m.ID)
+		}
+		for channelID, ids := range byChannel {
+			if err := touch(tx, channelID, ids); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return deleted, r.MapError(err)
+}
+
+func (r *messageGORMRepo) AddReaction(ctx context.Context, reaction *models.MessageReaction) error {
+	return r.MapError(r.DB(ctx).Create(reaction).Error)
+}
+
+func (r *messageGORMRepo) RemoveReaction(ctx context.Context, messageID, userID, emoji string) (bool, error) {
+	res := r.DB(ctx).
+		Where("message_id = ? AND user_id = ? AND emoji = ?", messageID, userID, emoji).
+		Delete(&models.MessageReaction{})
+	return res.RowsAffected > 0, r.MapError(res.Error)
+}
+
+func (r *messageGORMRepo) RemoveReactions(ctx context.Context, messageID, emoji string) (int64, error) {
+	q := r.DB(ctx).Where("message_id = ?", messageID)
+	if emoji != "" {
+		q = q.Where("emoji = ?", emoji)
+	}
+	res := q.Delete(&models.MessageReaction{})
+	return res.RowsAffected, r.MapError(res.Error)
+}
+
+func (r *messageGORMRepo) ReactionEmojis(ctx context.Context, messageID string) ([]string, error) {
+	var emojis []string
+	err := r.DB(ctx).Model(&models.MessageReaction{}).
+		Where("message_id = ?", messageID).
+		Distinct().
+		Pluck("emoji", &emojis).Error
+	return emojis, r.MapError(err)
+}
+
+func (r *messageGORMRepo) ReactionSummaries(ctx context.Context, messageIDs []uuid.UUID, viewerID string) ([]models.ReactionSummary, error) {
+	if len(messageIDs) == 0 {
+		return nil, nil
+	}
+	var summaries []models.ReactionSummary
+	err := r.DB(ctx).Model(&models.MessageReaction{}).
+		Select("message_id, emoji, COUNT(*) AS count, "+
+			"MAX(CASE WHEN user_id = ? THEN 1 ELSE 0 END) = 1 AS me", viewerID).
+		Where("message_id IN ?", messageIDs).
+		Group("message_id, emoji").
+		Order("MIN(created_at)"). // В порядке первого появления
+		Scan(&summaries).Error
+	return summaries, r.MapError(err)
+}
+
+func (r *messageGORMRepo) ListReactors(ctx context.Context, messageID, emoji string, limit int, afterUserID string) ([]models.User, error) {
+	// Только публичные поля профиля
+	q := r.DB(ctx).Model(&models.User{}).
+		Select("users.id, users.username, users.display_name, users.avatar_url").
+		Joins("JOIN message_reactions ON message_reactions.user_id = users.id").
+		Where("message_reactions.message_id = ? AND message_reactions.emoji = ?", messageID, emoji).
+		Order("users.id ASC").
+		Limit(limit)
+	if afterUserID != "" {
+		q = q.Where("users.id > ?", afterUserID)
+	}
+
+	var users []models.User
+	err := q.Find(&users).Error
+	return users, r.MapError(err)
+}
+
+func (r *messageGORMRepo) AttachThread(ctx context.Context, messageID string, threadID uuid.UUID) error {
+	changed, err := r.updateTracked(ctx, messageID, map[string]any{
+		"thread_id": threadID,
+		"flags":     gorm.Expr("flags | ?", models.MessageFlagHasThread),
+	}, "thread_id IS NULL")
+	if err != nil {
+		return err
+	}
+	if !changed {
+		if _, err := r.FindByID(ctx, messageID); err != nil {
+			return err
+		}
+		return domainerr.ErrThreadExists
+	}
+	return nil
+}
+
+func (r *messageGORMRepo) SetPinned(ctx context.Context, id string, pinned bool, maxPins int) (bool, error) {
+	fields := map[string]any{"is_pinned": pinned, "pinned_at": nil}
+	if pinned {
+		fields["pinned_at"] = time.Now()
+	}
+
+	var changed bool
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		txCtx := database.WithTransaction(ctx, tx)
+		msg, err := r.FindByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if msg.IsPinned == pinned {
+			return nil
+		}
+		if pinned && maxPins > 0 {
+			// Блокируем строку канала до конца транзакции: параллельные
+			// закрепы считают по очереди и не превысят лимит
+			err := tx.Model(&models.Channel{}).
+				Where("id = ?", msg.ChannelID).
+				UpdateColumn("next_change_seq", gorm.Expr("next_change_seq")).Error
+			if err != nil {
+				return err
+			}
+			count, err := r.CountPinned(txCtx, msg.ChannelID.String())
+			if err != nil {
+				return err
+			}
+			if count >= int64(maxPins) {
+				return domainerr.MaxResourceReached(domainerr.CodeMaxPinsReached, "pins", maxPins)
+			}
+		}
+		changed, err = r.updateTracked(txCtx, id, fields, "is_pinned = ?", !pinned)
+		return err
+	})
+	if err != nil {
+		return false, r.MapError(err)
+	}
+	return changed, nil
+}
+
+func (r *messageGORMRepo) CountPinned(ctx context.Context, channelID string) (int64, error) {
+	var count int64
+	err := r.DB(ctx).Model(&models.Message{}).
+		Where("channel_id = ? AND is_pinned = ?", channelID, true).
+		Count(&count).Error
+	return count, r.MapError(err)
+}
+
+func (r *messageGORMRepo) ListPinned(ctx context.Context, channelID string) ([]models.Message, error) {
+	var msgs []models.Message
+	err := r.DB(ctx).
+		Preload("Author").
+		Where("channel_id = ? AND is_pinned = ?", channelID, true).
+		Order("pinned_at DESC").
+		Find(&msgs).Error
+	return msgs, r.MapError(err)
+}
+
+func (r *messageGORMRepo) CreateMentions(ctx context.Context, mentions []models.MessageMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+	return r.MapError(r.DB(ctx).CreateInBatches(mentions, 500).Error)
+}
+
+func (r *messageGORMRepo) ReplaceMentions(ctx context.Context, messageID string, mentions *models.MessageMentions, rows []models.MessageMention) error {
+	var column any // NULL — упоминаний не осталось
+	if mentions != nil {
+		raw, err := json.Marshal(mentions)
+		if err != nil {
+			return err
+		}
+		column = string(raw)
+	}
+
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&models.Message{}).Where("id = ?", messageID).
+			UpdateColumn("mentions", column).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("message_id = ?", messageID).Delete(&models.MessageMention{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(rows, 500).Error
+	})
+	return r.MapError(err)
+}
+
+func (r *messageGORMRepo) ListMentions(ctx context.Context, filter MentionFilter) ([]models.Message, error) {
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 25
+	}
+
+	q := r.DB(ctx).
+		Preload("Author").
+		Joins("JOIN message_mentions mm ON mm.message_id = messages.id AND mm.user_id = ?", filter.UserID).
+		Joins("JOIN guild_members gm ON gm.guild_id = mm.guild_id AND gm.user_id = mm.user_id").
+		Order("mm.created_at DESC").
+		Limit(filter.Limit)
+	if filter.GuildID != "" {
+		q = q.Where("mm.guild_id = ?", filter.GuildID)
+	}
+	if filter.Before != nil {
+		q = q.Where("mm.created_at < ?", *filter.Before)
+	}
+	if len(filter.HiddenChannelIDs) > 0 {
+		q = q.Joins("JOIN channels c ON c.id = mm.channel_id").
+			Where("COALESCE(c.parent_id, c.id) NOT IN ?", filter.HiddenChannelIDs)
+	}
+
+	var msgs []models.Message
+	err := q.Find(&msgs).Error
+	return msgs, r.MapError(err)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+func (r *messageGORMRepo) FindByNonce(ctx context.Context, authorID, channelID, nonce string, since time.Time) (*models.Message, error) {
+	messageID := r.DB(ctx).Model(&models.MessageNonce{}).
+		Select("message_id").
+		Where("author_id = ? AND channel_id = ? AND nonce = ? AND created_at >= ?", authorID, channelID, nonce, since)
+
+	var msg models.Message
+	err := r.DB(ctx).
+		Preload("Author").
+		Where("id = (?)", messageID).
+		First(&msg).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+	return &msg, nil
+}
+
+func (r *messageGORMRepo) ClaimNonce(ctx context.Context, nonce *models.MessageNonce, since time.Time) error {
+	// Устаревшие записи автора (и ту же, если окно истекло) удаляем, чтобы
+	// таблица не росла, — свежий дубликат после этого упрётся в первичный ключ
+	err := r.DB(ctx).
+		Where("author_id = ? AND created_at < ?", nonce.AuthorID, since).
+		Delete(&models.MessageNonce{}).Error
+	if err != nil {
+		return r.MapError(err)
+	}
+	return r.MapError(r.DB(ctx).Create(nonce).Error)
+}
@@ -0,0 +1,72 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+func TestMessageRepository_Nonces(t *testing.T) {
+	db := newMessageTestDB(t)
+	if err := db.AutoMigrate(&models.MessageNonce{}); err != nil {
+		t.Fatalf("failed to migrate nonces: %v", err)
+	}
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "hello")
+	authorID, channelID := msg.AuthorID.String(), msg.ChannelID.String()
+	now := time.Now()
+	window := now.Add(-10 * time.Minute)
+
+	claim := func(target *models.Message, createdAt, since time.Time) error {
+		return repo.ClaimNonce(ctx, &models.MessageNonce{
+			AuthorID:  msg.AuthorID,
+			ChannelID: msg.ChannelID,
+			Nonce:     "n-1",
+			MessageID: target.ID,
+			CreatedAt: createdAt,
+		}, since)
+	}
+
+	t.Run("claims a fresh nonce", func(t *testing.T) {
+		if err := claim(msg, now, window); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		found, err := repo.FindByNonce(ctx, authorID, channelID, "n-1", window)
+		if err != nil {
+			t.Fatalf("FindByNonce failed: %v", err)
+		}
+		if found.ID != msg.ID {
+			t.Errorf("expected message %s, got %s", msg.ID, found.ID)
+		}
+	})
+
+	t.Run("rejects a duplicate within the window", func(t *testing.T) {
+		other := makeMessage(t, db, "retry")
+		if err := claim(other, now, window); !errors.Is(err, domainerr.ErrConflict) {
+			t.Errorf("expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("expired nonce is ignored and can be reused", func(t *testing.T) {
+		later := window.Add(20 * time.Minute)
+		if _, err := repo.FindByNonce(ctx, authorID, channelID, "n-1", later); !errors.Is(err, domainerr.ErrMessageNotFound) {
+			t.Errorf("expected ErrMessageNotFound, got %v", err)
+		}
+
+		other := makeMessage(t, db, "new")
+		if err := claim(other, later, later); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		found, err := repo.FindByNonce(ctx, authorID, channelID, "n-1", later)
+		if err != nil || found.ID != other.ID {
+			t.Errorf("expected message %s, got %v (err %v)", other.ID, found, err)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+func (r *messageGORMRepo) Search(ctx context.Context, search MessageSearch) ([]models.Message, error) {
+	var msgs []models.Message
+	if len(search.ChannelIDs) == 0 {
+		return msgs, nil
+	}
+
+	db := r.DB(ctx)
+	q := db.
+		Preload("Author").
+		Where("messages.channel_id IN (?) OR messages.channel_id IN (?)",
+			search.ChannelIDs,
+			db.Model(&models.Channel{}).Select("id").Where("parent_id IN ?", search.ChannelIDs),
+		).
+		Order("messages.created_at DESC").
+		Limit(search.Limit + 1).
+		Offset(search.Offset)
+
+	if terms := strings.Fields(search.Query); len(terms) > 0 {
+		q = r.matchText(q, search.Query, terms)
+	}
+	if search.AuthorID != "" {
+		q = q.Where("messages.author_id = ?", search.AuthorID)
+	}
+	if search.After != nil {
+		q = q.Where("messages.created_at > ?", *search.After)
+	}
+	if search.Before != nil {
+		q = q.Where("messages.created_at < ?", *search.Before)
+	}
+	if search.Pinned {
+		q = q.Where("messages.is_pinned = ?", true)
+	}
+	if search.HasLink {
+		q = q.Where("messages.content LIKE ? OR messages.content LIKE ?", "%http://%", "%https://%")
+	}
+	if search.HasAttachment {
+		q = q.Where("EXISTS (?)", db.Model(&models.MessageAttachment{}).Select("1").
+			Where("message_attachments.message_id = messages.id"))
+	}
+	if search.MentionsUserID != "" {
+		q = q.Where("EXISTS (?)", db.Table("message_mentions mm").Select("1").
+			Where("mm.message_id = messages.id AND mm.user_id = ?", search.MentionsUserID))
+	}
+
+	err := q.Find(&msgs).Error
+	return msgs, r.MapError(err)
+}
+
+// matchText добавляет условие полнотекстового поиска под текущую СУБД.
+func (r *messageGORMRepo) matchText(q *gorm.DB, query string, terms []string) *gorm.DB {
+	switch {
+	case q.Dialector.Name() == "postgres":
+		return q.Where("messages.search_vector @@ websearch_to_tsquery('simple', ?)", query)
+	case r.hasFTS(q):
+		return q.Where("messages.rowid IN (SELECT rowid FROM "+database.MessagesFTSTable+" WHERE "+
+			database.MessagesFTSTable+" MATCH ?)", ftsQuery(terms))
+	}
+	// Запасной вариант: все слова должны встречаться в тексте
+	for _, term := range terms {
+		q = q.Where("LOWER(messages.content) LIKE LOWER(?) ESCAPE '\\'", "%"+escapeLike(term)+"%")
+	}
+	return q
+}
+
+// hasFTS проверяет (один раз), создан ли FTS5-индекс в SQLite.
+func (r *messageGORMRepo) hasFTS(db *gorm.DB) bool {
+	r.ftsOnce.Do(func() {
+		r.fts = db.Migrator().HasTable(database.MessagesFTSTable)
+	})
+	return r.fts
+}
+
+// ftsQuery превращает слова в FTS5-запрос «все слова» без операторов:
+// каждое слово берётся в кавычки, кавычки внутри удваиваются.
+func ftsQuery(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, t := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(t, `"`, `""`) + `"`
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/google/uuid"
+)
+
+func TestMessageRepository_Search(t *testing.T) {
+	db := newMessageTestDB(t)
+	if err := db.AutoMigrate(&models.MessageMention{}, &models.MessageAttachment{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	// Без FTS5 в сборке драйвера поиск идёт через LIKE — проверяем оба пути одинаково
+	if err := database.SetupSearch(db); err != nil {
+		t.Logf("FTS5 unavailable, testing LIKE fallback: %v", err)
+	}
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	channelID, hiddenID, authorID, guildID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	thread := &models.Channel{GuildID: &guildID, Name: "t", Type: models.ChannelTypeThread, ParentID: &channelID}
+	if err := db.Create(thread).Error; err != nil {
+		t.Fatalf("failed to create thread: %v", err)
+	}
+
+	create := func(ch uuid.UUID, content string, pinned bool) *models.Message {
+		t.Helper()
+		msg := &models.Message{ChannelID: ch, AuthorID: authorID, Content: content, IsPinned: pinned, Seq: int64(len(content))}
+		if err := db.Create(msg).Error; err != nil {
+			t.Fatalf("failed to create message: %v", err)
+		}
+		return msg
+	}
+	match := create(channelID, "Tournament bracket is ready", false)
+	link := create(channelID, "bracket: https://example.com/bracket", true)
+	inThread := create(thread.ID, "bracket for group B", false)
+	create(hiddenID, "secret bracket", false)
+	deleted := create(channelID, "old bracket draft", false)
+	if err := repo.SoftDelete(ctx, deleted.ID.String(), authorID, nil); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+
+	search := func(s repository.MessageSearch) map[uuid.UUID]bool {
+		t.Helper()
+		s.ChannelIDs = []uuid.UUID{channelID}
+		s.Limit = 10
+		msgs, err := repo.Search(ctx, s)
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		found := make(map[uuid.UUID]bool, len(msgs))
+		for _, m := range msgs {
+			found[m.ID] = true
+		}
+		return found
+	}
+
+	t.Run("text matches visible channels and their threads", func(t *testing.T) {
+		found := search(repository.MessageSearch{Query: "BRACKET"})
+		if len(found) != 3 || !found[match.ID] || !found[link.ID] || !found[inThread.ID] {
+			t.Errorf("expected 3 live visible matches, got %v", found)
+		}
+	})
+
+	t.Run("all words must match", func(t *testing.T) {
+		found := search(repository.MessageSearch{Query: "bracket ready"})
+		if len(found) != 1 || !found[match.ID] {
+			t.Errorf("expected only %s, got %v", match.ID, found)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		if found := search(repository.MessageSearch{HasLink: true}); len(found) != 1 || !found[link.ID] {
+			t.Errorf("has_link: got %v", found)
+		}
+		if found := search(repository.MessageSearch{Pinned: true}); len(found) != 1 || !found[link.ID] {
+			t.Errorf("pinned: got %v", found)
+		}
+		if found := search(repository.MessageSearch{Query: "bracket", AuthorID: uuid.NewString()}); len(found) != 0 {
+			t.Errorf("author filter: got %v", found)
+		}
+	})
+}
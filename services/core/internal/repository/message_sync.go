@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+func (r *messageGORMRepo) ListChanges(ctx context.Context, channelID string, afterChangeSeq int64, limit int) ([]models.Message, error) {
+	var msgs []models.Message
+	err := r.DB(ctx).Unscoped().
+		Preload("Author").
+		Where("channel_id = ? AND change_seq > ?", channelID, afterChangeSeq).
+		Order("change_seq ASC").
+		Limit(limit).
+		Find(&msgs).Error
+	return msgs, r.MapError(err)
+}
+
+// updateTracked выполняет UPDATE живого сообщения (с доп. условием where, если
+// оно задано) и, если строка изменилась, в той же транзакции выдаёт ей новый ChangeSeq.
+func (r *messageGORMRepo) updateTracked(ctx context.Context, id string, fields map[string]any, where string, args ...any) (bool, error) {
+	var changed bool
+	err := r.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		q := tx.Model(&models.Message{}).Where("id = ?", id)
+		if where != "" {
+			q = q.Where(where, args...)
+		}
+		res := q.Updates(fields)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		changed = true
+
+		var channelIDs []uuid.UUID
+		if err := tx.Unscoped().Model(&models.Message{}).Where("id = ?", id).Pluck("channel_id", &channelIDs).Error; err != nil {
+			return err
+		}
+		return touch(tx, channelIDs[0], []uuid.UUID{uuid.MustParse(id)})
+	})
+	return changed, r.MapError(err)
+}
+
+// allocChangeSeq резервирует n номеров изменений канала и возвращает первый.
+// Строка канала остаётся заблокированной до конца транзакции, поэтому номера
+// выдаются в порядке коммитов и SyncChannel не пропустит изменение.
+func allocChangeSeq(tx *gorm.DB, channelID uuid.UUID, n int) (int64, error) {
+	var next int64
+	err := tx.Model(&models.Channel{}).
+		Where("id = ?", channelID).
+		UpdateColumn("next_change_seq", gorm.Expr("next_change_seq + ?", n)).
+		Select("next_change_seq").
+		Scan(&next).Error
+	return next - int64(n), err
+}
+
+// touch выдаёт сообщениям одного канала новые ChangeSeq, по одному на сообщение.
+func touch(tx *gorm.DB, channelID uuid.UUID, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	first, err := allocChangeSeq(tx, channelID, len(ids))
+	if err != nil {
+		return err
+	}
+
+	var expr strings.Builder
+	args := make([]any, 0, len(ids)*2)
+	expr.WriteString("CASE id")
+	for i, id := range ids {
+		expr.WriteString(" WHEN ? THEN CAST(? AS BIGINT)")
+		args = append(args, id, first+int64(i))
+	}
+	expr.WriteString(" END")
+
+	return tx.Unscoped().Model(&models.Message{}).
+		Where("id IN ?", ids).
+		UpdateColumn("change_seq", gorm.Expr(expr.String(), args...)).Error
+}
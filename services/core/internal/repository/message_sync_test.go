@@ -0,0 +1,122 @@
+package repository_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/google/uuid"
+)
+
+func TestMessageRepository_SeqCursorsAndChanges(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	guildID := uuid.New()
+	ch := &models.Channel{GuildID: &guildID, Name: "general", Type: models.ChannelTypeText}
+	if err := db.Create(ch).Error; err != nil {
+		t.Fatalf("failed to create channel: %v", err)
+	}
+	msgs := make([]*models.Message, 5)
+	for i := range msgs {
+		msgs[i] = &models.Message{ChannelID: ch.ID, AuthorID: uuid.New(), Content: "msg"}
+		if err := repo.Create(ctx, msgs[i]); err != nil {
+			t.Fatalf("failed to create message: %v", err)
+		}
+	}
+
+	seqs := func(page []models.Message) []int64 {
+		out := make([]int64, len(page))
+		for i := range page {
+			out[i] = page[i].Seq
+		}
+		return out
+	}
+	history := func(q repository.HistoryQuery) []int64 {
+		t.Helper()
+		q.ChannelID = ch.ID.String()
+		page, err := repo.GetHistory(ctx, q)
+		if err != nil {
+			t.Fatalf("GetHistory failed: %v", err)
+		}
+		return seqs(page)
+	}
+
+	t.Run("creation assigns seq and change seq", func(t *testing.T) {
+		for i, m := range msgs {
+			if m.Seq != int64(i+1) || m.ChangeSeq != int64(i+1) {
+				t.Errorf("message %d: seq=%d change_seq=%d", i, m.Seq, m.ChangeSeq)
+			}
+		}
+	})
+
+	t.Run("history cursors", func(t *testing.T) {
+		cases := []struct {
+			name  string
+			query repository.HistoryQuery
+			want  []int64
+		}{
+			{"latest", repository.HistoryQuery{Limit: 2}, []int64{4, 5}},
+			{"before seq", repository.HistoryQuery{BeforeSeq: 4, Limit: 2}, []int64{2, 3}},
+			{"after seq", repository.HistoryQuery{AfterSeq: 1, Limit: 2}, []int64{2, 3}},
+			{"from first seq", repository.HistoryQuery{FromSeq: 1, Limit: 2}, []int64{1, 2}},
+		}
+		for _, tc := range cases {
+			if got := history(tc.query); !slices.Equal(got, tc.want) {
+				t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+			}
+		}
+	})
+
+	t.Run("edits and deletions are listed as changes", func(t *testing.T) {
+		if err := repo.UpdateContent(ctx, msgs[0].ID.String(), 0, "edited", time.Now()); err != nil {
+			t.Fatalf("edit failed: %v", err)
+		}
+		if err := repo.SoftDelete(ctx, msgs[1].ID.String(), uuid.New(), nil); err != nil {
+			t.Fatalf("delete failed: %v", err)
+		}
+		ids := []uuid.UUID{msgs[2].ID, msgs[3].ID, msgs[1].ID} // msgs[1] уже удалено
+		if n, err := repo.SoftDeleteMany(ctx, ids, uuid.New(), nil); err != nil || n != 2 {
+			t.Fatalf("bulk delete: n=%d err=%v", n, err)
+		}
+
+		changes, err := repo.ListChanges(ctx, ch.ID.String(), 5, 100)
+		if err != nil {
+			t.Fatalf("ListChanges failed: %v", err)
+		}
+		if len(changes) != 4 {
+			t.Fatalf("expected 4 changes, got %d", len(changes))
+		}
+		if changes[0].ID != msgs[0].ID || changes[0].Content != "edited" || changes[0].ChangeSeq != 6 {
+			t.Errorf("first change should be the edit, got %+v", changes[0])
+		}
+		for i, c := range changes[1:] {
+			if !c.DeletedAt.Valid {
+				t.Errorf("change %d should be a deletion", i+1)
+			}
+			if c.ChangeSeq != int64(7+i) {
+				t.Errorf("change %d: expected change_seq %d, got %d", i+1, 7+i, c.ChangeSeq)
+			}
+		}
+
+		var updated models.Channel
+		db.First(&updated, "id = ?", ch.ID)
+		if updated.NextChangeSeq != 10 {
+			t.Errorf("expected next change seq 10, got %d", updated.NextChangeSeq)
+		}
+	})
+
+	t.Run("stale edits do not allocate changes", func(t *testing.T) {
+		if _, err := repo.SetEmbeds(ctx, msgs[0].ID.String(), 0, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		changes, _ := repo.ListChanges(ctx, ch.ID.String(), 9, 100)
+		if len(changes) != 0 {
+			t.Errorf("expected no new changes, got %d", len(changes))
+		}
+	})
+}
@@ -0,0 +1,409 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// newMessageTestDB добавляет к тестовой БД таблицы сообщений.
+func newMessageTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db := newGuildTestDB(t)
+	if err := db.AutoMigrate(&models.Message{}, &models.MessageEdit{}, &models.MessageReaction{}); err != nil {
+		t.Fatalf("failed to migrate message tables: %v", err)
+	}
+	return db
+}
+
+// makeMessage пишет сообщение напрямую, минуя счётчик Seq канала.
+func makeMessage(t *testing.T, db *gorm.DB, content string) *models.Message {
+	t.Helper()
+	msg := &models.Message{
+		ChannelID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Content:   content,
+	}
+	if err := db.Create(msg).Error; err != nil {
+		t.Fatalf("failed to create message: %v", err)
+	}
+	return msg
+}
+
+func TestMessageRepository_UpdateContent(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "hello")
+
+	t.Run("replaces content and bumps edit version", func(t *testing.T) {
+		if err := repo.UpdateContent(ctx, msg.ID.String(), 0, "hello, world", time.Now()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		updated, _ := repo.FindByID(ctx, msg.ID.String())
+		if updated.Content != "hello, world" {
+			t.Errorf("content not updated, got: %q", updated.Content)
+		}
+		if updated.EditVersion != 1 {
+			t.Errorf("expected edit version 1, got %d", updated.EditVersion)
+		}
+		if !updated.Flags.Has(models.MessageFlagEdited) || updated.EditedAt == nil {
+			t.Error("message should be marked as edited")
+		}
+	})
+
+	t.Run("returns ErrVersionConflict for stale edit version", func(t *testing.T) {
+		err := repo.UpdateContent(ctx, msg.ID.String(), 0, "stale", time.Now())
+		if !domainerr.Is(err, domainerr.ErrVersionConflict) {
+			t.Errorf("expected ErrVersionConflict, got: %v", err)
+		}
+	})
+
+	t.Run("returns ErrMessageNotFound for unknown id", func(t *testing.T) {
+		err := repo.UpdateContent(ctx, uuid.NewString(), 0, "ghost", time.Now())
+		if !domainerr.Is(err, domainerr.ErrMessageNotFound) {
+			t.Errorf("expected ErrMessageNotFound, got: %v", err)
+		}
+	})
+}
+
+func TestMessageRepository_UpdateComponents(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "Регистрация на турнир")
+	rows := []models.ActionRow{{Components: []models.Component{
+		{Type: models.ComponentTypeButton, CustomID: "join", Label: "Участвую", Style: models.ButtonStyleSuccess},
+	}}}
+
+	t.Run("replaces content and components without marking edited", func(t *testing.T) {
+		if err := repo.UpdateComponents(ctx, msg.ID.String(), 0, "Регистрация закрыта", rows); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		updated, _ := repo.FindByID(ctx, msg.ID.String())
+		if updated.Content != "Регистрация закрыта" || updated.EditVersion != 1 {
+			t.Errorf("unexpected message state: %q, version %d", updated.Content, updated.EditVersion)
+		}
+		if updated.Flags.Has(models.MessageFlagEdited) {
+			t.Error("interaction update should not mark message as edited")
+		}
+		if c := updated.FindComponent("join"); c == nil || c.Label != "Участвую" {
+			t.Errorf("components not stored: %+v", updated.Components)
+		}
+	})
+
+	t.Run("returns ErrVersionConflict for stale edit version", func(t *testing.T) {
+		err := repo.UpdateComponents(ctx, msg.ID.String(), 0, "stale", nil)
+		if !domainerr.Is(err, domainerr.ErrVersionConflict) {
+			t.Errorf("expected ErrVersionConflict, got: %v", err)
+		}
+	})
+}
+
+func TestMessageRepository_SetEmbeds(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "https://example.com")
+	embeds := []models.Embed{{URL: "https://example.com", Type: models.EmbedTypeLink, Title: "Example"}}
+
+	t.Run("stores embeds for current edit version", func(t *testing.T) {
+		ok, err := repo.SetEmbeds(ctx, msg.ID.String(), 0, embeds)
+		if err != nil || !ok {
+			t.Fatalf("expected embeds to be stored, got ok=%v err=%v", ok, err)
+		}
+		updated, _ := repo.FindByID(ctx, msg.ID.String())
+		if len(updated.Embeds) != 1 || updated.Embeds[0] != embeds[0] {
+			t.Errorf("unexpected embeds: %+v", updated.Embeds)
+		}
+	})
+
+	t.Run("skips stale edit version", func(t *testing.T) {
+		if err := repo.UpdateContent(ctx, msg.ID.String(), 0, "edited", time.Now()); err != nil {
+			t.Fatalf("failed to edit: %v", err)
+		}
+		ok, err := repo.SetEmbeds(ctx, msg.ID.String(), 0, nil)
+		if err != nil || ok {
+			t.Errorf("stale embeds should be skipped, got ok=%v err=%v", ok, err)
+		}
+	})
+}
+
+func TestMessageRepository_ListEdits(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "v0")
+	for i, content := range []string{"v0", "v1"} {
+		edit := &models.MessageEdit{MessageID: msg.ID, EditorID: msg.AuthorID, Content: content, EditVersion: i}
+		if err := repo.CreateEdit(ctx, edit); err != nil {
+			t.Fatalf("failed to create edit: %v", err)
+		}
+	}
+
+	t.Run("returns edits oldest first", func(t *testing.T) {
+		edits, err := repo.ListEdits(ctx, msg.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(edits) != 2 || edits[0].Content != "v0" || edits[1].Content != "v1" {
+			t.Errorf("unexpected edits: %+v", edits)
+		}
+	})
+
+	t.Run("rejects duplicate edit version", func(t *testing.T) {
+		dup := &models.MessageEdit{MessageID: msg.ID, EditorID: msg.AuthorID, Content: "dup", EditVersion: 1}
+		if err := repo.CreateEdit(ctx, dup); !domainerr.Is(err, domainerr.ErrConflict) {
+			t.Errorf("expected ErrConflict, got: %v", err)
+		}
+	})
+}
+
+func TestMessageRepository_SoftDelete(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "spam")
+	moderatorID := uuid.New()
+	reason := "spam"
+
+	t.Run("records who deleted and why", func(t *testing.T) {
+		if err := repo.SoftDelete(ctx, msg.ID.String(), moderatorID, &reason); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var deleted models.Message
+		if err := db.Unscoped().Where("id = ?", msg.ID).First(&deleted).Error; err != nil {
+			t.Fatalf("failed to load deleted message: %v", err)
+		}
+		if !deleted.DeletedAt.Valid {
+			t.Error("deleted_at should be set")
+		}
+		if deleted.DeletedBy == nil || *deleted.DeletedBy != moderatorID {
+			t.Errorf("unexpected deleted_by: %v", deleted.DeletedBy)
+		}
+		if deleted.DeletionReason == nil || *deleted.DeletionReason != reason {
+			t.Errorf("unexpected deletion_reason: %v", deleted.DeletionReason)
+		}
+	})
+
+	t.Run("hides message from reads", func(t *testing.T) {
+		if _, err := repo.FindByID(ctx, msg.ID.String()); !domainerr.Is(err, domainerr.ErrMessageNotFound) {
+			t.Errorf("expected ErrMessageNotFound, got: %v", err)
+		}
+	})
+
+	t.Run("second delete returns ErrMessageNotFound", func(t *testing.T) {
+		err := repo.SoftDelete(ctx, msg.ID.String(), moderatorID, nil)
+		if !domainerr.Is(err, domainerr.ErrMessageNotFound) {
+			t.Errorf("expected ErrMessageNotFound, got: %v", err)
+		}
+	})
+}
+
+func TestMessageRepository_BulkDelete(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	channelID, spammerID, memberID := uuid.New(), uuid.New(), uuid.New()
+	base := time.Now().Add(-time.Hour)
+	var spam []uuid.UUID // Сообщения спамера, от старых к новым
+	for i := range 6 {
+		author := spammerID
+		if i%2 == 1 {
+			author = memberID
+		}
+		msg := &models.Message{ChannelID: channelID, AuthorID: author, Content: "msg", Seq: int64(i)}
+		msg.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		if err := db.Create(msg).Error; err != nil {
+			t.Fatalf("failed to create message: %v", err)
+		}
+		if author == spammerID {
+			spam = append(spam, msg.ID)
+		}
+	}
+
+	t.Run("filters by author and limit, newest first", func(t *testing.T) {
+		ids, err := repo.FindIDs(ctx, repository.MessageFilter{
+			ChannelID: channelID.String(),
+			AuthorID:  spammerID.String(),
+			Limit:     2,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(ids) != 2 {
+			t.Fatalf("expected 2 ids, got %d", len(ids))
+		}
+		if ids[0] != spam[2] || ids[1] != spam[1] {
+			t.Errorf("expected the two newest spam messages newest first, got %v", ids)
+		}
+	})
+
+	t.Run("explicit ids are batched and ordered newest first", func(t *testing.T) {
+		requested := []string{spam[0].String(), spam[2].String()}
+		// Несуществующие ID сверх размера пачки: ответ всё равно один и упорядоченный
+		for range 150 {
+			requested = append(requested, uuid.NewString())
+		}
+		requested = append(requested, spam[1].String())
+		ids, err := repo.FindIDs(ctx, repository.MessageFilter{ChannelID: channelID.String(), IDs: requested})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(ids) != 3 || ids[0] != spam[2] || ids[1] != spam[1] || ids[2] != spam[0] {
+			t.Errorf("expected spam messages newest first, got %v", ids)
+		}
+	})
+
+	t.Run("soft deletes matched messages", func(t *testing.T) {
+		ids, _ := repo.FindIDs(ctx, repository.MessageFilter{ChannelID: channelID.String(), AuthorID: spammerID.String()})
+		n, err := repo.SoftDeleteMany(ctx, ids, memberID, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n != 3 {
+			t.Errorf("expected 3 deleted, got %d", n)
+		}
+		left, _ := repo.FindIDs(ctx, repository.MessageFilter{ChannelID: channelID.String()})
+		if len(left) != 3 {
+			t.Errorf("expected 3 messages left, got %d", len(left))
+		}
+	})
+}
+
+func TestMessageRepository_Reactions(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "gg")
+	alice, bob := uuid.New(), uuid.New()
+	for _, r := range []struct {
+		user  uuid.UUID
+		emoji string
+	}{{alice, "🔥"}, {bob, "🔥"}, {bob, "👍"}} {
+		err := repo.AddReaction(ctx, &models.MessageReaction{MessageID: msg.ID, UserID: r.user, Emoji: r.emoji})
+		if err != nil {
+			t.Fatalf("failed to add reaction: %v", err)
+		}
+	}
+
+	t.Run("duplicate reaction returns ErrConflict", func(t *testing.T) {
+		err := repo.AddReaction(ctx, &models.MessageReaction{MessageID: msg.ID, UserID: alice, Emoji: "🔥"})
+		if !domainerr.Is(err, domainerr.ErrConflict) {
+			t.Errorf("expected ErrConflict, got: %v", err)
+		}
+	})
+
+	t.Run("summaries are aggregated per viewer", func(t *testing.T) {
+		summaries, err := repo.ReactionSummaries(ctx, []uuid.UUID{msg.ID}, alice.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := make(map[string]models.ReactionSummary)
+		for _, s := range summaries {
+			got[s.Emoji] = s
+		}
+		if fire := got["🔥"]; fire.Count != 2 || !fire.Me {
+			t.Errorf("unexpected 🔥 summary: %+v", fire)
+		}
+		if like := got["👍"]; like.Count != 1 || like.Me {
+			t.Errorf("unexpected 👍 summary: %+v", like)
+		}
+	})
+
+	t.Run("remove reaction reports whether it existed", func(t *testing.T) {
+		removed, err := repo.RemoveReaction(ctx, msg.ID.String(), bob.String(), "👍")
+		if err != nil || !removed {
+			t.Fatalf("expected reaction to be removed, got removed=%v err=%v", removed, err)
+		}
+		removed, _ = repo.RemoveReaction(ctx, msg.ID.String(), bob.String(), "👍")
+		if removed {
+			t.Error("second remove should report false")
+		}
+		emojis, _ := repo.ReactionEmojis(ctx, msg.ID.String())
+		if len(emojis) != 1 || emojis[0] != "🔥" {
+			t.Errorf("unexpected emojis: %v", emojis)
+		}
+	})
+}
+
+func TestMessageRepository_Pins(t *testing.T) {
+	db := newMessageTestDB(t)
+	repo := repository.NewMessageRepository(db)
+	ctx := context.Background()
+
+	msg := makeMessage(t, db, "rules")
+
+	t.Run("pin reports change only once", func(t *testing.T) {
+		changed, err := repo.SetPinned(ctx, msg.ID.String(), true, 0)
+		if err != nil || !changed {
+			t.Fatalf("expected pin to change state, got changed=%v err=%v", changed, err)
+		}
+		changed, _ = repo.SetPinned(ctx, msg.ID.String(), true, 0)
+		if changed {
+			t.Error("second pin should be a no-op")
+		}
+	})
+
+	t.Run("pinned messages are listed and counted", func(t *testing.T) {
+		count, _ := repo.CountPinned(ctx, msg.ChannelID.String())
+		if count != 1 {
+			t.Errorf("expected 1 pin, got %d", count)
+		}
+		var pinned []models.Message
+		if err := db.Where("channel_id = ? AND is_pinned = ?", msg.ChannelID, true).Find(&pinned).Error; err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pinned) != 1 || pinned[0].PinnedAt == nil {
+			t.Errorf("unexpected pinned messages: %+v", pinned)
+		}
+	})
+
+	t.Run("unpin clears pinned_at", func(t *testing.T) {
+		changed, err := repo.SetPinned(ctx, msg.ID.String(), false, 0)
+		if err != nil || !changed {
+			t.Fatalf("expected unpin to change state, got changed=%v err=%v", changed, err)
+		}
+		updated, _ := repo.FindByID(ctx, msg.ID.String())
+		if updated.IsPinned || updated.PinnedAt != nil {
+			t.Errorf("message should be unpinned: %+v", updated)
+		}
+	})
+
+	t.Run("unknown message returns ErrMessageNotFound", func(t *testing.T) {
+		if _, err := repo.SetPinned(ctx, uuid.NewString(), true, 0); !domainerr.Is(err, domainerr.ErrMessageNotFound) {
+			t.Errorf("expected ErrMessageNotFound, got: %v", err)
+		}
+	})
+	t.Run("pin over the limit is rejected", func(t *testing.T) {
+		first := makeMessage(t, db, "first")
+		second := &models.Message{ChannelID: first.ChannelID, AuthorID: first.AuthorID, Content: "second", Seq: 1}
+		if err := db.Create(second).Error; err != nil {
+			t.Fatalf("failed to create message: %v", err)
+		}
+		if _, err := repo.SetPinned(ctx, first.ID.String(), true, 1); err != nil {
+			t.Fatalf("failed to pin: %v", err)
+		}
+		_, err := repo.SetPinned(ctx, second.ID.String(), true, 1)
+		if appErr := domainerr.AsAppError(err); err == nil || appErr.Code != domainerr.CodeMaxPinsReached {
+			t.Fatalf("expected max pins error, got %v", err)
+		}
+		// Повторный закреп уже закреплённого — не ошибка, даже на лимите
+		if changed, err := repo.SetPinned(ctx, first.ID.String(), true, 1); err != nil || changed {
+			t.Errorf("expected no-op, got changed=%v err=%v", changed, err)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type readStateGORMRepo struct{ BaseRepo[models.ReadState] }
+
+func NewReadStateRepository(db *gorm.DB) ReadStateRepository {
+	return &readStateGORMRepo{BaseRepo: NewBaseRepo[models.ReadState](db, nil)}
+}
+
+func (r *readStateGORMRepo) Ack(ctx context.Context, state *models.ReadState) error {
+	err := r.DB(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "channel_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "last_read_seq"}, Value: gorm.Expr(
+				"CASE WHEN read_states.last_read_seq < excluded.last_read_seq " +
+					"THEN excluded.last_read_seq ELSE read_states.last_read_seq END")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("excluded.updated_at")},
+		},
+	}).Create(state).Error
+	return r.MapError(err)
+}
+
+func (r *readStateGORMRepo) Find(ctx context.Context, userID, channelID string) (*models.ReadState, error) {
+	var state models.ReadState
+	err := r.DB(ctx).
+		Where("user_id = ? AND channel_id = ?", userID, channelID).
+		First(&state).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+	return &state, nil
+}
+
+func (r *readStateGORMRepo) ListUnread(ctx context.Context, userID string) ([]models.ChannelUnread, error) {
+	var unread []models.ChannelUnread
+	err := r.DB(ctx).
+		Table("channels AS c").
+		Select("c.id AS channel_id, c.guild_id, c.parent_id, c.next_seq - 1 AS last_seq, "+
+			"COALESCE(rs.last_read_seq, 0) AS last_read_seq, "+
+			// Удалённые и системные сообщения непрочитанными не считаются
+			"(SELECT COUNT(*) FROM messages m WHERE m.channel_id = c.id "+
+			"AND m.seq > COALESCE(rs.last_read_seq, 0) AND m.deleted_at IS NULL "+
+			"AND (m.flags & ?) = 0) AS unread_count, "+
+			"(SELECT COUNT(*) FROM message_mentions mm WHERE mm.user_id = ? AND mm.channel_id = c.id "+
+			"AND mm.seq > COALESCE(rs.last_read_seq, 0) "+
+			"AND EXISTS (SELECT 1 FROM messages m WHERE m.id = mm.message_id AND m.deleted_at IS NULL)) AS mention_count",
+			models.MessageFlagSystem, userID).
+		Joins("LEFT JOIN read_states rs ON rs.channel_id = c.id AND rs.user_id = ?", userID).
+		Where("c.deleted_at IS NULL").
+		Where("(EXISTS (?) AND (c.type IN ? OR (c.type = ? AND EXISTS (?)))) OR EXISTS (?)",
+			r.DB(ctx).Table("guild_members gm").Select("1").
+				Where("gm.guild_id = c.guild_id AND gm.user_id = ?", userID),
+			[]models.ChannelType{models.ChannelTypeText, models.ChannelTypeAnnouncement},
+			models.ChannelTypeThread,
+			r.DB(ctx).Table("thread_members tm").Select("1").
+				Where("tm.thread_id = c.id AND tm.user_id = ?", userID),
+			// Личные каналы — по участию, без гильдии
+			r.DB(ctx).Table("channel_recipients cr").Select("1").
+				Where("cr.channel_id = c.id AND cr.user_id = ?", userID),
+		).
+		// Дешёвый отсев по счётчику канала; точное число — в unread_count
+		Where("c.next_seq - 1 > COALESCE(rs.last_read_seq, 0)").
+		Scan(&unread).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+
+	// Всё новое могло оказаться удалённым или системным
+	withUnread := unread[:0]
+	for _, u := range unread {
+		if u.UnreadCount > 0 {
+			withUnread = append(withUnread, u)
+		}
+	}
+	return withUnread, nil
+}
@@ -0,0 +1,153 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/google/uuid"
+	"gorm.io/gorm/clause"
+)
+
+func TestReadStateRepository(t *testing.T) {
+	db := newGuildTestDB(t)
+	if err := db.AutoMigrate(&models.ReadState{}, &models.ThreadMember{}, &models.MessageMention{}, &models.Message{}); err != nil {
+		t.Fatalf("failed to migrate read states: %v", err)
+	}
+	repo := repository.NewReadStateRepository(db)
+	ctx := context.Background()
+
+	guildID, userID := uuid.New(), uuid.New()
+	member := &models.GuildMember{GuildID: guildID, UserID: userID}
+	if err := db.Omit(clause.Associations).Create(member).Error; err != nil {
+		t.Fatalf("failed to create member: %v", err)
+	}
+	// В general 10 живых сообщений плюс удалённое и системное, в offtopic — 3,
+	// голосовой и чужая ветка не считаются
+	general := &models.Channel{GuildID: &guildID, Name: "general", Type: models.ChannelTypeText, NextSeq: 13}
+	offtopic := &models.Channel{GuildID: &guildID, Name: "offtopic", Type: models.ChannelTypeText, NextSeq: 4}
+	voice := &models.Channel{GuildID: &guildID, Name: "voice", Type: models.ChannelTypeVoice, NextSeq: 5}
+	thread := &models.Channel{GuildID: &guildID, Name: "thread", Type: models.ChannelTypeThread, ParentID: &general.ID, NextSeq: 6}
+	for _, ch := range []*models.Channel{general, offtopic, voice, thread} {
+		if err := db.Create(ch).Error; err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+	}
+
+	messages := make(map[uuid.UUID][]*models.Message)
+	for _, ch := range []*models.Channel{general, offtopic, thread} {
+		for seq := int64(1); seq < ch.NextSeq; seq++ {
+			msg := &models.Message{ChannelID: ch.ID, AuthorID: userID, Seq: seq, Content: "hi"}
+			if ch == general && seq == 12 {
+				msg.Flags.Add(models.MessageFlagSystem)
+			}
+			if err := db.Create(msg).Error; err != nil {
+				t.Fatalf("failed to create message: %v", err)
+			}
+			messages[ch.ID] = append(messages[ch.ID], msg)
+		}
+	}
+	if err := db.Delete(messages[general.ID][10]).Error; err != nil {
+		t.Fatalf("failed to delete message: %v", err)
+	}
+
+	ack := func(ch *models.Channel, seq int64) {
+		t.Helper()
+		err := repo.Ack(ctx, &models.ReadState{UserID: userID, ChannelID: ch.ID, LastReadSeq: seq, UpdatedAt: time.Now()})
+		if err != nil {
+			t.Fatalf("ack failed: %v", err)
+		}
+	}
+
+	t.Run("Ack only moves forward", func(t *testing.T) {
+		ack(general, 7)
+		ack(general, 4)
+		state, err := repo.Find(ctx, userID.String(), general.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if state.LastReadSeq != 7 {
+			t.Errorf("expected last_read_seq 7, got %d", state.LastReadSeq)
+		}
+	})
+
+	t.Run("ListUnread counts live non-system messages", func(t *testing.T) {
+		// Упоминания в прочитанном (seq 5), непрочитанном (seq 9) и удалённом
+		// (seq 11) сообщениях
+		for _, seq := range []int64{5, 9, 11} {
+			err := db.Create(&models.MessageMention{
+				MessageID: messages[general.ID][seq-1].ID, UserID: userID, GuildID: guildID,
+				ChannelID: general.ID, Seq: seq, CreatedAt: time.Now(),
+			}).Error
+			if err != nil {
+				t.Fatalf("failed to create mention: %v", err)
+			}
+		}
+
+		unread, err := repo.ListUnread(ctx, userID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := make(map[uuid.UUID]int64)
+		for _, u := range unread {
+			got[u.ChannelID] = u.UnreadCount
+		}
+		if len(got) != 2 || got[general.ID] != 3 || got[offtopic.ID] != 3 {
+			t.Errorf("expected general=3 offtopic=3, got %+v", unread)
+		}
+		for _, u := range unread {
+			if u.ChannelID == general.ID && u.LastSeq != 12 {
+				t.Errorf("expected last_seq 12 in general, got %d", u.LastSeq)
+			}
+		}
+		for _, u := range unread {
+			if u.ChannelID == general.ID && u.MentionCount != 1 {
+				t.Errorf("expected 1 unread mention in general, got %d", u.MentionCount)
+			}
+		}
+	})
+
+	t.Run("only deleted and system messages left unread", func(t *testing.T) {
+		ack(general, 10)
+		defer func() {
+			// Позиция чтения назад не двигается — возвращаем её напрямую
+			db.Model(&models.ReadState{}).Where("channel_id = ?", general.ID).Update("last_read_seq", 7)
+		}()
+
+		unread, err := repo.ListUnread(ctx, userID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, u := range unread {
+			if u.ChannelID == general.ID {
+				t.Errorf("expected general to have nothing unread, got %+v", u)
+			}
+		}
+	})
+
+	t.Run("joined threads and fully read channels", func(t *testing.T) {
+		if err := db.Create(&models.ThreadMember{ThreadID: thread.ID, UserID: userID}).Error; err != nil {
+			t.Fatalf("failed to join thread: %v", err)
+		}
+		ack(offtopic, 3)
+
+		unread, err := repo.ListUnread(ctx, userID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := make(map[uuid.UUID]int64)
+		for _, u := range unread {
+			got[u.ChannelID] = u.UnreadCount
+		}
+		if len(got) != 2 || got[general.ID] != 3 || got[thread.ID] != 5 {
+			t.Errorf("expected general=3 thread=5, got %+v", unread)
+		}
+		for _, u := range unread {
+			if u.ChannelID == thread.ID && (u.ParentID == nil || *u.ParentID != general.ID) {
+				t.Errorf("expected thread parent %s, got %v", general.ID, u.ParentID)
+			}
+		}
+	})
+}
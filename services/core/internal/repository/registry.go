@@ -16,20 +16,28 @@ import "gorm.io/gorm"
 //	authSvc := service.NewAuthService(repos.Users, cfg)
 //	userSvc := service.NewUserService(repos.Users)
 type Registry struct {
-	Realms   RealmRepository
-	Users    UserRepository
-	Guilds   GuildRepository
-	Channels ChannelRepository
-	Messages MessageRepository
+	Realms     RealmRepository
+	Users      UserRepository
+	Guilds     GuildRepository
+	Channels   ChannelRepository
+	Messages   MessageRepository
+	AuditLogs  AuditLogRepository
+	ReadStates ReadStateRepository
+	Relations  RelationshipRepository
+	Scheduled  ScheduledMessageRepository
 }
 
 // NewRegistry создаёт все GORM-репозитории и упаковывает в Registry.
 func NewRegistry(db *gorm.DB) *Registry {
 	return &Registry{
-		Realms:   NewRealmRepository(db),
-		Users:    NewUserRepository(db),
-		Guilds:   NewGuildRepository(db),
-		Channels: NewChannelRepository(db),
-		Messages: NewMessageRepository(db),
+		Realms:     NewRealmRepository(db),
+		Users:      NewUserRepository(db),
+		Guilds:     NewGuildRepository(db),
+		Channels:   NewChannelRepository(db),
+		Messages:   NewMessageRepository(db),
+		AuditLogs:  NewAuditLogRepository(db),
+		ReadStates: NewReadStateRepository(db),
+		Relations:  NewRelationshipRepository(db),
+		Scheduled:  NewScheduledMessageRepository(db),
 	}
 }
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type relationshipGORMRepo struct{ BaseRepo[models.Relationship] }
+
+func NewRelationshipRepository(db *gorm.DB) RelationshipRepository {
+	return &relationshipGORMRepo{BaseRepo: NewBaseRepo[models.Relationship](db, nil)}
+}
+
+func (r *relationshipGORMRepo) Find(ctx context.Context, userID, targetID string) (*models.Relationship, error) {
+	var rel models.Relationship
+	err := r.DB(ctx).
+		Where("user_id = ? AND target_id = ?", userID, targetID).
+		First(&rel).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+	return &rel, nil
+}
+
+func (r *relationshipGORMRepo) List(ctx context.Context, userID string) ([]models.Relationship, error) {
+	var rels []models.Relationship
+	err := r.DB(ctx).
+		Preload("Target").
+		Where("user_id = ?", userID).
+		Order("created_at DESC, target_id ASC").
+		Find(&rels).Error
+	return rels, r.MapError(err)
+}
+
+func (r *relationshipGORMRepo) CountByType(ctx context.Context, userID string, types ...models.RelationshipType) (int64, error) {
+	var count int64
+	err := r.DB(ctx).Model(&models.Relationship{}).
+		Where("user_id = ? AND type IN ?", userID, types).
+		Count(&count).Error
+	return count, r.MapError(err)
+}
+
+func (r *relationshipGORMRepo) Put(ctx context.Context, rels ...*models.Relationship) error {
+	err := r.DB(ctx).Omit("Target").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"type", "created_at"}),
+	}).Create(rels).Error
+	return r.MapError(err)
+}
+
+func (r *relationshipGORMRepo) Delete(ctx context.Context, userID, targetID string) error {
+	err := r.DB(ctx).
+		Where("user_id = ? AND target_id = ?", userID, targetID).
+		Delete(&models.Relationship{}).Error
+	return r.MapError(err)
+}
+
+func (r *relationshipGORMRepo) IsBlocked(ctx context.Context, userID, otherID string) (bool, error) {
+	var count int64
+	err := r.DB(ctx).Model(&models.Relationship{}).
+		Where("type = ? AND ((user_id = ? AND target_id = ?) OR (user_id = ? AND target_id = ?))",
+			models.RelationshipBlocked, userID, otherID, otherID, userID).
+		Count(&count).Error
+	return count > 0, r.MapError(err)
+}
+
+func (r *relationshipGORMRepo) ListBlockedIDs(ctx context.Context, userID string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.DB(ctx).Model(&models.Relationship{}).
+		Where("user_id = ? AND type = ?", userID, models.RelationshipBlocked).
+		Pluck("target_id", &ids).Error
+	return ids, r.MapError(err)
+}
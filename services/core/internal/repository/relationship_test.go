@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestRelationshipRepository(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&models.Relationship{}); err != nil {
+		t.Fatalf("failed to migrate relationships: %v", err)
+	}
+	repo := repository.NewRelationshipRepository(db)
+	ctx := context.Background()
+
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	rel := func(user, target uuid.UUID, typ models.RelationshipType) *models.Relationship {
+		return &models.Relationship{UserID: user, TargetID: target, Type: typ, CreatedAt: time.Now()}
+	}
+
+	if err := repo.Put(ctx, rel(alice, bob, models.RelationshipOutgoing), rel(bob, alice, models.RelationshipIncoming)); err != nil {
+		t.Fatalf("failed to put request: %v", err)
+	}
+
+	t.Run("Put replaces type", func(t *testing.T) {
+		if err := repo.Put(ctx, rel(alice, bob, models.RelationshipFriend), rel(bob, alice, models.RelationshipFriend)); err != nil {
+			t.Fatalf("failed to accept: %v", err)
+		}
+		got, err := repo.Find(ctx, bob.String(), alice.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Type != models.RelationshipFriend {
+			t.Errorf("expected friend, got %q", got.Type)
+		}
+		n, _ := repo.CountByType(ctx, alice.String(), models.RelationshipFriend, models.RelationshipOutgoing)
+		if n != 1 {
+			t.Errorf("expected 1 friend, got %d", n)
+		}
+	})
+
+	t.Run("blocks are checked both ways", func(t *testing.T) {
+		if err := repo.Put(ctx, rel(carol, alice, models.RelationshipBlocked)); err != nil {
+			t.Fatalf("failed to block: %v", err)
+		}
+		for _, pair := range [][2]uuid.UUID{{alice, carol}, {carol, alice}} {
+			blocked, err := repo.IsBlocked(ctx, pair[0].String(), pair[1].String())
+			if err != nil || !blocked {
+				t.Errorf("expected blocked for %v, got %v, %v", pair, blocked, err)
+			}
+		}
+		if blocked, _ := repo.IsBlocked(ctx, alice.String(), bob.String()); blocked {
+			t.Error("friends should not be blocked")
+		}
+		ids, err := repo.ListBlockedIDs(ctx, carol.String())
+		if err != nil || len(ids) != 1 || ids[0] != alice {
+			t.Errorf("expected [alice], got %v, %v", ids, err)
+		}
+		if ids, _ := repo.ListBlockedIDs(ctx, alice.String()); len(ids) != 0 {
+			t.Errorf("blocked user should not see the block, got %v", ids)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := repo.Delete(ctx, carol.String(), alice.String()); err != nil {
+			t.Fatalf("failed to delete: %v", err)
+		}
+		if _, err := repo.Find(ctx, carol.String(), alice.String()); !domainerr.Is(err, domainerr.ErrNotFound) {
+			t.Errorf("expected not found, got %v", err)
+		}
+		if err := repo.Delete(ctx, carol.String(), alice.String()); err != nil {
+			t.Errorf("deleting a missing relationship should not fail, got %v", err)
+		}
+	})
+}
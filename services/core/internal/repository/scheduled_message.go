@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type scheduledMessageGORMRepo struct {
+	BaseRepo[models.ScheduledMessage]
+}
+
+func NewScheduledMessageRepository(db *gorm.DB) ScheduledMessageRepository {
+	return &scheduledMessageGORMRepo{BaseRepo: NewBaseRepo[models.ScheduledMessage](db, nil)}
+}
+
+// unlocked — записи, которые сейчас никто не отправляет.
+func unlocked(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("locked_until IS NULL OR locked_until < ?", now)
+}
+
+func (r *scheduledMessageGORMRepo) ListUnsent(ctx context.Context, channelID, authorID string) ([]models.ScheduledMessage, error) {
+	q := r.DB(ctx).
+		Where("channel_id = ? AND status IN ?", channelID,
+			[]models.ScheduledMessageStatus{models.ScheduledMessagePending, models.ScheduledMessageFailed})
+	if authorID != "" {
+		q = q.Where("author_id = ?", authorID)
+	}
+	var list []models.ScheduledMessage
+	err := q.Order("send_at ASC, id ASC").Find(&list).Error
+	return list, r.MapError(err)
+}
+
+func (r *scheduledMessageGORMRepo) CountPending(ctx context.Context, channelID string) (int64, error) {
+	var count int64
+	err := r.DB(ctx).Model(&models.ScheduledMessage{}).
+		Where("channel_id = ? AND status = ?", channelID, models.ScheduledMessagePending).
+		Count(&count).Error
+	return count, r.MapError(err)
+}
+
+func (r *scheduledMessageGORMRepo) DeleteUnlocked(ctx context.Context, id string, now time.Time) (bool, error) {
+	res := r.DB(ctx).
+		Where("id = ? AND status <> ?", id, models.ScheduledMessageSent).
+		Where(unlocked(r.DB(ctx), now)).
+		Delete(&models.ScheduledMessage{})
+	if res.Error != nil {
+		return false, r.MapError(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *scheduledMessageGORMRepo) ClaimDue(ctx context.Context, now, lockedUntil time.Time, limit int) ([]models.ScheduledMessage, error) {
+	var due []models.ScheduledMessage
+	err := r.DB(ctx).
+		Where("status = ? AND next_attempt_at <= ?", models.ScheduledMessagePending, now).
+		Where(unlocked(r.DB(ctx), now)).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&due).Error
+	if err != nil {
+		return nil, r.MapError(err)
+	}
+
+	claimed := due[:0]
+	for _, sm := range due {
+		// Кандидата мог перехватить другой экземпляр между SELECT и UPDATE
+		res := r.DB(ctx).Model(&models.ScheduledMessage{}).
+			Where("id = ? AND status = ?", sm.ID, models.ScheduledMessagePending).
+			Where(unlocked(r.DB(ctx), now)).
+			Updates(map[string]any{
+				"locked_until": lockedUntil,
+				"attempts":     gorm.Expr("attempts + 1"),
+			})
+		if res.Error != nil {
+			return claimed, r.MapError(res.Error)
+		}
+		if res.RowsAffected == 1 {
+			sm.LockedUntil = &lockedUntil
+			sm.Attempts++
+			claimed = append(claimed, sm)
+		}
+	}
+	return claimed, nil
+}
+
+func (r *scheduledMessageGORMRepo) Finish(ctx context.Context, id string, status models.ScheduledMessageStatus, messageID *uuid.UUID, lastError string) error {
+	err := r.DB(ctx).Model(&models.ScheduledMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status":       status,
+			"message_id":   messageID,
+			"last_error":   lastError,
+			"locked_until": nil,
+		}).Error
+	return r.MapError(err)
+}
+
+func (r *scheduledMessageGORMRepo) Retry(ctx context.Context, id string, retryAt time.Time, lastError string) error {
+	err := r.DB(ctx).Model(&models.ScheduledMessage{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"next_attempt_at": retryAt,
+			"last_error":      lastError,
+			"locked_until":    nil,
+		}).Error
+	return r.MapError(err)
+}
@@ -0,0 +1,100 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/google/uuid"
+)
+
+func TestScheduledMessageRepository(t *testing.T) {
+	db := newTestDB(t)
+	if err := db.AutoMigrate(&models.ScheduledMessage{}); err != nil {
+		t.Fatalf("failed to migrate scheduled messages: %v", err)
+	}
+	repo := repository.NewScheduledMessageRepository(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	channelID, author := uuid.New(), uuid.New()
+	schedule := func(sendAt time.Time) *models.ScheduledMessage {
+		t.Helper()
+		sm := &models.ScheduledMessage{
+			ChannelID:     channelID,
+			AuthorID:      author,
+			Content:       "doors open",
+			Status:        models.ScheduledMessagePending,
+			SendAt:        sendAt,
+			NextAttemptAt: sendAt,
+		}
+		if err := repo.Create(ctx, sm); err != nil {
+			t.Fatalf("failed to schedule: %v", err)
+		}
+		return sm
+	}
+	due := schedule(now.Add(-time.Second))
+	later := schedule(now.Add(time.Hour))
+
+	t.Run("ClaimDue hands out each message once", func(t *testing.T) {
+		claimed, err := repo.ClaimDue(ctx, now, now.Add(time.Minute), 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(claimed) != 1 || claimed[0].ID != due.ID || claimed[0].Attempts != 1 {
+			t.Fatalf("expected only the due message with 1 attempt, got %+v", claimed)
+		}
+		again, err := repo.ClaimDue(ctx, now, now.Add(time.Minute), 10)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(again) != 0 {
+			t.Errorf("leased message must not be claimed twice, got %+v", again)
+		}
+		if ok, _ := repo.DeleteUnlocked(ctx, due.ID.String(), now); ok {
+			t.Error("leased message must not be cancelled")
+		}
+	})
+
+	t.Run("Retry releases the lease until retryAt", func(t *testing.T) {
+		if err := repo.Retry(ctx, due.ID.String(), now.Add(time.Minute), "db is down"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if claimed, _ := repo.ClaimDue(ctx, now, now.Add(time.Minute), 10); len(claimed) != 0 {
+			t.Errorf("retry is not due yet, got %+v", claimed)
+		}
+		claimed, _ := repo.ClaimDue(ctx, now.Add(2*time.Minute), now.Add(3*time.Minute), 10)
+		if len(claimed) != 1 || claimed[0].Attempts != 2 {
+			t.Fatalf("expected second attempt, got %+v", claimed)
+		}
+	})
+
+	t.Run("Finish removes sent messages from the list", func(t *testing.T) {
+		msgID := uuid.New()
+		if err := repo.Finish(ctx, due.ID.String(), models.ScheduledMessageSent, &msgID, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		list, err := repo.ListUnsent(ctx, channelID.String(), "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(list) != 1 || list[0].ID != later.ID {
+			t.Errorf("expected only the later message, got %+v", list)
+		}
+		if list, _ := repo.ListUnsent(ctx, channelID.String(), uuid.NewString()); len(list) != 0 {
+			t.Errorf("expected no messages of another author, got %+v", list)
+		}
+	})
+
+	t.Run("DeleteUnlocked cancels a waiting message", func(t *testing.T) {
+		ok, err := repo.DeleteUnlocked(ctx, later.ID.String(), now)
+		if err != nil || !ok {
+			t.Fatalf("expected cancel, got %v, %v", ok, err)
+		}
+		if n, _ := repo.CountPending(ctx, channelID.String()); n != 0 {
+			t.Errorf("expected no pending messages, got %d", n)
+		}
+	})
+}
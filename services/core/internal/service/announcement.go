@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxFollowsPerChannel — на сколько каналов объявлений можно подписать один канал.
+	maxFollowsPerChannel = 10
+	// maxFollowersPerChannel — сколько каналов могут подписаться на один канал
+	// объявлений. Копии рассылаются прямо в запросе отправки, поэтому их число ограничено.
+	maxFollowersPerChannel = 50
+)
+
+// FollowChannel подписывает targetID на канал объявлений sourceID. Нужен доступ
+// к источнику и MANAGE_CHANNELS в гильдии получателя.
+func (s *ChatService) FollowChannel(ctx context.Context, sourceID, targetID, callerID string) (*models.ChannelFollower, error) {
+	const op = "ChatService.FollowChannel"
+
+	source, _, err := s.getAccessibleChannel(ctx, sourceID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if source.Type != models.ChannelTypeAnnouncement {
+		return nil, errors.ValidationError("channel_id", "Only announcement channels can be followed").WithOp(op)
+	}
+
+	target, member, err := s.getAccessibleChannel(ctx, targetID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if target.Type != models.ChannelTypeText && target.Type != models.ChannelTypeAnnouncement {
+		return nil, errors.ValidationError("target_channel_id", "Must be a text or announcement channel").WithOp(op)
+	}
+	if target.ID == source.ID {
+		return nil, errors.ValidationError("target_channel_id", "A channel cannot follow itself").WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermManageChannels) {
+		return nil, errors.PermissionError("MANAGE_CHANNELS", target.GuildIDString()).WithOp(op)
+	}
+
+	following, err := s.channels.ListFollowing(ctx, targetID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if len(following) >= maxFollowsPerChannel {
+		return nil, errors.LimitReached("follows_per_channel", maxFollowsPerChannel).WithOp(op)
+	}
+	followers, err := s.channels.ListFollowers(ctx, sourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if len(followers) >= maxFollowersPerChannel {
+		return nil, errors.LimitReached("followers_per_channel", maxFollowersPerChannel).WithOp(op)
+	}
+
+	follower := &models.ChannelFollower{
+		RealmID:         middleware.MustRealmID(ctx),
+		SourceChannelID: source.ID,
+		TargetChannelID: target.ID,
+		CreatedBy:       member.UserID,
+	}
+	if err := s.channels.AddFollower(ctx, follower); err != nil {
+		if errors.Is(err, errors.ErrConflict) {
+			return nil, errors.ErrConflict.WithOp(op).WithMsg("This channel already follows the announcement channel")
+		}
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	follower.SourceChannel = *source
+	follower.TargetChannel = *target
+	return follower, nil
+}
+
+// UnfollowChannel снимает подписку. Отписать может как гильдия-получатель,
+// так и владельцы источника (MANAGE_CHANNELS в любой из двух гильдий).
+func (s *ChatService) UnfollowChannel(ctx context.Context, sourceID, targetID, callerID string) error {
+	const op = "ChatService.UnfollowChannel"
+
+	target, err := s.channels.FindByID(ctx, targetID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	allowed, err := s.canManageChannels(ctx, target, callerID)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !allowed {
+		// Источник мог быть уже удалён — тогда решает только гильдия-получатель
+		if source, err := s.channels.FindByID(ctx, sourceID); err == nil {
+			if allowed, err = s.canManageChannels(ctx, source, callerID); err != nil {
+				return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+			}
+		}
+	}
+	if !allowed {
+		return errors.PermissionError("MANAGE_CHANNELS", target.GuildIDString()).WithOp(op)
+	}
+
+	removed, err := s.channels.RemoveFollower(ctx, sourceID, targetID)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !removed {
+		return errors.ErrNotFound.WithOp(op).WithMsg("This channel does not follow the announcement channel")
+	}
+	return nil
+}
+
+// ListChannelFollows возвращает, на какие каналы объявлений подписан канал
+// и какие каналы подписаны на него. Требует MANAGE_CHANNELS.
+func (s *ChatService) ListChannelFollows(ctx context.Context, channelID, callerID string) (following, followers []models.ChannelFollower, err error) {
+	const op = "ChatService.ListChannelFollows"
+
+	ch, member, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ch.IsDM() {
+		return nil, nil, errors.ValidationError("channel_id", "Direct messages cannot follow channels").WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermManageChannels) {
+		return nil, nil, errors.PermissionError("MANAGE_CHANNELS", ch.GuildIDString()).WithOp(op)
+	}
+
+	following, err = s.channels.ListFollowing(ctx, channelID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	for i := range following {
+		following[i].TargetChannel = *ch
+	}
+	if ch.Type == models.ChannelTypeAnnouncement {
+		followers, err = s.channels.ListFollowers(ctx, channelID)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		for i := range followers {
+			followers[i].SourceChannel = *ch
+		}
+	}
+	return following, followers, nil
+}
+
+// canManageChannels — есть ли у userID право MANAGE_CHANNELS в гильдии канала.
+func (s *ChatService) canManageChannels(ctx context.Context, ch *models.Channel, userID string) (bool, error) {
+	if ch.IsDM() {
+		return false, nil
+	}
+	member, err := s.guilds.FindMember(ctx, ch.GuildIDString(), userID)
+	if errors.Is(err, errors.ErrMemberNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return member.EffectivePermissions.Can(models.PermManageChannels), nil
+}
+
+// crosspost копирует объявление в каналы-подписчики и возвращает созданные
+// копии. Сбой в одном канале не мешает остальным и не отменяет отправку оригинала.
+func (s *ChatService) crosspost(ctx context.Context, ch *models.Channel, msg *models.Message) []*models.Message {
+	log := logger.FromContext(ctx)
+
+	followers, err := s.channels.ListFollowers(ctx, ch.ID.String())
+	if err != nil {
+		log.Warn("failed to list channel followers", "channel_id", ch.ID, "error", err)
+		return nil
+	}
+	if len(followers) == 0 {
+		return nil
+	}
+
+	source := &models.CrosspostSource{
+		GuildID:     ch.GuildIDString(),
+		ChannelID:   ch.ID.String(),
+		ChannelName: ch.Name,
+	}
+	if guild, err := s.guilds.FindByID(ctx, ch.GuildIDString()); err == nil {
+		source.GuildName = guild.Name
+	}
+
+	copies := make([]*models.Message, 0, len(followers))
+	for _, f := range followers {
+		// Упоминания в копиях не действуют: адресаты — участники другой гильдии.
+		// Компоненты тоже не копируются: нажатий из чужих гильдий бот не ждёт
+		copied := &models.Message{
+			BaseEntity:    models.BaseEntity{RealmID: msg.RealmID},
+			ChannelID:     f.TargetChannelID,
+			AuthorID:      msg.AuthorID,
+			Content:       msg.Content,
+			ContentType:   msg.ContentType,
+			CrosspostOfID: &msg.ID,
+			Crosspost:     source,
+		}
+		if err := s.messages.Create(ctx, copied); err != nil {
+			log.Warn("failed to crosspost announcement",
+				"message_id", msg.ID, "target_channel_id", f.TargetChannelID, "error", err)
+			continue
+		}
+		copied.Author = msg.Author
+
+		s.hub.Publish(f.TargetChannelID.String(), &pb.ChatEvent{
+			Payload: &pb.ChatEvent_MessageCreated{
+				MessageCreated: MessageToProto(copied),
+			},
+		})
+		copies = append(copies, copied)
+	}
+	return copies
+}
+
+// updateCrossposts переносит правку объявления в его копии и возвращает
+// обновлённые. Истории правок у копий нет: она ведётся у оригинала.
+func (s *ChatService) updateCrossposts(ctx context.Context, msg *models.Message) []*models.Message {
+	return s.rewriteCrossposts(ctx, msg, func(copied *models.Message) error {
+		if err := s.messages.UpdateContent(ctx, copied.ID.String(), copied.EditVersion, msg.Content, *msg.EditedAt); err != nil {
+			return err
+		}
+		copied.EditedAt = msg.EditedAt
+		copied.Flags.Add(models.MessageFlagEdited)
+		return nil
+	})
+}
+
+// updateInteractionCrossposts переносит в копии текст, который бот заменил
+// ответом на взаимодействие. Компоненты остаются только у оригинала.
+func (s *ChatService) updateInteractionCrossposts(ctx context.Context, msg *models.Message) {
+	s.rewriteCrossposts(ctx, msg, func(copied *models.Message) error {
+		return s.messages.UpdateComponents(ctx, copied.ID.String(), copied.EditVersion, msg.Content, nil)
+	})
+}
+
+// rewriteCrossposts применяет update к живым копиям объявления, рассылает
+// message_updated и возвращает обновлённые копии. Сбой в одной копии не
+// мешает остальным.
+func (s *ChatService) rewriteCrossposts(ctx context.Context, msg *models.Message, update func(copied *models.Message) error) []*models.Message {
+	log := logger.FromContext(ctx)
+
+	copies, err := s.messages.ListCrossposts(ctx, []uuid.UUID{msg.ID})
+	if err != nil {
+		log.Warn("failed to list crossposts", "message_id", msg.ID, "error", err)
+		return nil
+	}
+	updated := make([]*models.Message, 0, len(copies))
+	for i := range copies {
+		copied := &copies[i]
+		if err := update(copied); err != nil {
+			log.Warn("failed to update crosspost", "message_id", copied.ID, "error", err)
+			continue
+		}
+		copied.Content = msg.Content
+		copied.EditVersion++
+		copied.Author = msg.Author
+
+		s.hub.Publish(copied.ChannelID.String(), &pb.ChatEvent{
+			Payload: &pb.ChatEvent_MessageUpdated{
+				MessageUpdated: MessageToProto(copied),
+			},
+		})
+		updated = append(updated, copied)
+	}
+	return updated
+}
+
+// deleteCrossposts удаляет копии удалённых объявлений. Подписчики каждого
+// канала получают одно событие: message_deleted или messages_bulk_deleted.
+func (s *ChatService) deleteCrossposts(ctx context.Context, messageIDs []uuid.UUID, deletedBy uuid.UUID) {
+	log := logger.FromContext(ctx)
+
+	byChannel := make(map[uuid.UUID][]string)
+	for start := 0; start < len(messageIDs); start += bulkDeleteBatchSize {
+		batch := messageIDs[start:min(start+bulkDeleteBatchSize, len(messageIDs))]
+		copies, err := s.messages.ListCrossposts(ctx, batch)
+		if err != nil {
+			log.Warn("failed to list crossposts", "error", err)
+			continue
+		}
+		// Подписчиков у канала может быть много — копии тоже удаляем пачками
+		for from := 0; from < len(copies); from += bulkDeleteBatchSize {
+			chunk := copies[from:min(from+bulkDeleteBatchSize, len(copies))]
+			ids := make([]uuid.UUID, len(chunk))
+			for i := range chunk {
+				ids[i] = chunk[i].ID
+			}
+			if _, err := s.messages.SoftDeleteMany(ctx, ids, deletedBy, nil); err != nil {
+				log.Warn("failed to delete crossposts", "error", err)
+				continue
+			}
+			for i := range chunk {
+				byChannel[chunk[i].ChannelID] = append(byChannel[chunk[i].ChannelID], chunk[i].ID.String())
+			}
+		}
+	}
+
+	for channelID, ids := range byChannel {
+		event := &pb.ChatEvent{
+			Payload: &pb.ChatEvent_MessagesBulkDeleted{
+				MessagesBulkDeleted: &pb.MessagesBulkDeleted{
+					ChannelId:  channelID.String(),
+					MessageIds: ids,
+					DeletedBy:  deletedBy.String(),
+				},
+			},
+		}
+		if len(ids) == 1 {
+			event = &pb.ChatEvent{
+				Payload: &pb.ChatEvent_MessageDeleted{
+					MessageDeleted: &pb.MessageDeleted{
+						MessageId: ids[0],
+						ChannelId: channelID.String(),
+						DeletedBy: deletedBy.String(),
+					},
+				},
+			}
+		}
+		s.hub.Publish(channelID.String(), event)
+	}
+}
+
+// ChannelFollowToProto конвертирует подписку в proto.
+func ChannelFollowToProto(f *models.ChannelFollower) *pb.ChannelFollow {
+	return &pb.ChannelFollow{
+		SourceChannelId:   f.SourceChannelID.String(),
+		SourceChannelName: f.SourceChannel.Name,
+		SourceGuildId:     f.SourceChannel.GuildIDString(),
+		TargetChannelId:   f.TargetChannelID.String(),
+		TargetChannelName: f.TargetChannel.Name,
+		TargetGuildId:     f.TargetChannel.GuildIDString(),
+		CreatedBy:         f.CreatedBy.String(),
+		CreatedAt:         timestamppb.New(f.CreatedAt),
+	}
+}
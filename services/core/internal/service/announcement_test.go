@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/markup"
+)
+
+// announcementEnv — канал объявлений гильдии publisher и канал #general
+// гильдии reader, подписанный на него.
+type announcementEnv struct {
+	*testEnv
+	publisher string
+	reader    string
+	news      string
+	general   string
+}
+
+func newAnnouncementEnv(t *testing.T) *announcementEnv {
+	t.Helper()
+	e := newTestEnv(t)
+	publisher := e.newUser(t, "publisher")
+	reader := e.newUser(t, "reader")
+	source, _ := e.newGuild(t, publisher, "Source")
+	_, general := e.newGuild(t, reader, "Target")
+	e.join(t, source, reader)
+
+	news, err := e.guilds.CreateChannel(e.ctx(publisher), source.ID.String(), publisher, "news", models.ChannelTypeAnnouncement)
+	if err != nil {
+		t.Fatalf("failed to create announcement channel: %v", err)
+	}
+	if _, err := e.chat.FollowChannel(e.ctx(reader), news.ID.String(), general, reader); err != nil {
+		t.Fatalf("failed to follow channel: %v", err)
+	}
+	return &announcementEnv{testEnv: e, publisher: publisher, reader: reader, news: news.ID.String(), general: general}
+}
+
+// crossposts возвращает копии объявлений в канале channelID.
+func (e *announcementEnv) crossposts(t *testing.T, channelID string) []models.Message {
+	t.Helper()
+	var copies []models.Message
+	for _, m := range e.history(t, channelID) {
+		if m.CrosspostOfID != nil {
+			copies = append(copies, m)
+		}
+	}
+	return copies
+}
+
+func TestCrosspost_Propagation(t *testing.T) {
+	e := newAnnouncementEnv(t)
+
+	msg, err := e.chat.SendMessage(e.ctx(e.publisher), SendMessageParams{ChannelID: e.news, AuthorID: e.publisher, Content: "release"})
+	if err != nil {
+		t.Fatalf("failed to send announcement: %v", err)
+	}
+
+	t.Run("create", func(t *testing.T) {
+		copies := e.crossposts(t, e.general)
+		if len(copies) != 1 {
+			t.Fatalf("expected 1 crosspost, got %d", len(copies))
+		}
+		if *copies[0].CrosspostOfID != msg.ID || copies[0].Content != "release" {
+			t.Errorf("unexpected crosspost: of=%v content=%q", copies[0].CrosspostOfID, copies[0].Content)
+		}
+		if copies[0].Crosspost == nil || copies[0].Crosspost.ChannelName != "news" || copies[0].Crosspost.GuildName != "Source" {
+			t.Errorf("unexpected crosspost source: %+v", copies[0].Crosspost)
+		}
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		if _, err := e.chat.EditMessage(e.ctx(e.publisher), msg.ID.String(), e.publisher, "release v2"); err != nil {
+			t.Fatalf("failed to edit announcement: %v", err)
+		}
+		copies := e.crossposts(t, e.general)
+		if len(copies) != 1 {
+			t.Fatalf("expected 1 crosspost, got %d", len(copies))
+		}
+		if copies[0].Content != "release v2" || copies[0].EditedAt == nil || !copies[0].Flags.Has(models.MessageFlagEdited) {
+			t.Errorf("edit did not reach the crosspost: content=%q edited=%v", copies[0].Content, copies[0].EditedAt)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := e.chat.DeleteMessage(e.ctx(e.publisher), msg.ID.String(), e.publisher, ""); err != nil {
+			t.Fatalf("failed to delete announcement: %v", err)
+		}
+		if copies := e.crossposts(t, e.general); len(copies) != 0 {
+			t.Errorf("expected crosspost to be deleted, got %d", len(copies))
+		}
+	})
+}
+
+func TestCrosspost_InteractionUpdate(t *testing.T) {
+	e := newAnnouncementEnv(t)
+	buttons := []models.ActionRow{{Components: []models.Component{
+		{Type: models.ComponentTypeButton, CustomID: "join", Label: "Join", Style: models.ButtonStylePrimary},
+	}}}
+	msg, err := e.chat.SendMessage(e.ctx(e.publisher), SendMessageParams{
+		ChannelID: e.news, AuthorID: e.publisher, Content: "tournament", Components: buttons,
+	})
+	if err != nil {
+		t.Fatalf("failed to send announcement: %v", err)
+	}
+	if copies := e.crossposts(t, e.general); len(copies) != 1 || len(copies[0].Components) != 0 {
+		t.Fatalf("expected 1 crosspost without components, got %+v", copies)
+	}
+
+	events, unsubscribe := e.hub.Subscribe(hub.UserTopic(e.publisher), e.publisher)
+	defer unsubscribe()
+	done := make(chan error, 1)
+	go func() {
+		_, err := e.chat.InteractWithComponent(e.ctx(e.reader), msg.ID.String(), e.reader, "join", nil)
+		done <- err
+	}()
+	ic := nextInteraction(t, events)
+	err = e.chat.RespondToInteraction(e.ctx(e.publisher), e.publisher, InteractionResponse{
+		InteractionID: ic.InteractionId,
+		Token:         ic.Token,
+		Type:          InteractionUpdateMessage,
+		Content:       "tournament (full)",
+	})
+	if err != nil {
+		t.Fatalf("failed to respond: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("interaction failed: %v", err)
+	}
+
+	copies := e.crossposts(t, e.general)
+	if len(copies) != 1 {
+		t.Fatalf("expected 1 crosspost, got %d", len(copies))
+	}
+	if copies[0].Content != "tournament (full)" || len(copies[0].Components) != 0 {
+		t.Errorf("expected updated text without components, got %q %+v", copies[0].Content, copies[0].Components)
+	}
+}
+
+func TestFollowChannel_FollowersLimit(t *testing.T) {
+	e := newAnnouncementEnv(t)
+	guild, _ := e.newGuild(t, e.reader, "Many")
+
+	// Одна подписка уже есть — #general гильдии Target
+	for i := 1; i < maxFollowersPerChannel; i++ {
+		ch, err := e.guilds.CreateChannel(e.ctx(e.reader), guild.ID.String(), e.reader, fmt.Sprintf("feed-%d", i), models.ChannelTypeText)
+		if err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+		if _, err := e.chat.FollowChannel(e.ctx(e.reader), e.news, ch.ID.String(), e.reader); err != nil {
+			t.Fatalf("follow %d failed: %v", i, err)
+		}
+	}
+
+	extra, err := e.guilds.CreateChannel(e.ctx(e.reader), guild.ID.String(), e.reader, "extra", models.ChannelTypeText)
+	if err != nil {
+		t.Fatalf("failed to create channel: %v", err)
+	}
+	_, err = e.chat.FollowChannel(e.ctx(e.reader), e.news, extra.ID.String(), e.reader)
+	if !hasCode(err, errors.ErrorCode("MAX_followers_per_channel_REACHED")) {
+		t.Errorf("expected followers limit error, got %v", err)
+	}
+}
+
+// countingFetcher отдаёт превью для любой ссылки и считает запросы.
+type countingFetcher struct{ calls atomic.Int32 }
+
+func (f *countingFetcher) Fetch(_ context.Context, rawURL string) (*models.Embed, error) {
+	f.calls.Add(1)
+	return &models.Embed{URL: rawURL, Type: models.EmbedTypeLink, Title: "Page"}, nil
+}
+
+func TestUnfurler_SharesEmbedsWithCrossposts(t *testing.T) {
+	e := newAnnouncementEnv(t)
+	fetcher := &countingFetcher{}
+	u := NewUnfurler(fetcher, e.repos.Messages, e.hub, 5)
+
+	msg, err := e.chat.SendMessage(e.ctx(e.publisher), SendMessageParams{ChannelID: e.news, AuthorID: e.publisher, Content: "see https://example.com"})
+	if err != nil {
+		t.Fatalf("failed to send announcement: %v", err)
+	}
+	copies := e.crossposts(t, e.general)
+	if len(copies) != 1 {
+		t.Fatalf("expected 1 crosspost, got %d", len(copies))
+	}
+
+	// run — тело фоновой задачи Enqueue, вызываем синхронно
+	u.run(context.Background(), msg, markup.Links(markup.Parse(msg.Content), 5), copies)
+
+	if n := fetcher.calls.Load(); n != 1 {
+		t.Errorf("expected 1 fetch, got %d", n)
+	}
+	for _, id := range []string{msg.ID.String(), copies[0].ID.String()} {
+		stored, err := e.repos.Messages.FindByID(context.Background(), id)
+		if err != nil {
+			t.Fatalf("failed to load message: %v", err)
+		}
+		if len(stored.Embeds) != 1 || stored.Embeds[0].URL != "https://example.com" {
+			t.Errorf("message %s: unexpected embeds %+v", id, stored.Embeds)
+		}
+	}
+}
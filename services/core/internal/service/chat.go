@@ -2,127 +2,1271 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/ratelimit"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
 	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/markup"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/validator"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ChatService struct {
-	messages repository.MessageRepository
-	channels repository.ChannelRepository
-	guilds   repository.GuildRepository
-	users    *UserService
-	hub      *hub.Hub
+	messages  repository.MessageRepository
+	channels  repository.ChannelRepository
+	guilds    repository.GuildRepository
+	audit     repository.AuditLogRepository
+	reads     repository.ReadStateRepository
+	relations repository.RelationshipRepository
+	scheduled repository.ScheduledMessageRepository
+	users     *UserService
+	system    *SystemMessenger
+	unfurler  *Unfurler // nil — превью ссылок выключены
+	tm        database.TransactionManager
+	hub       *hub.Hub
+	typing    *ratelimit.Limiter
+
+	interactions *interactionBroker
 }
 
+// Typing: событие живёт typingTTL, пользователь может отправить
+// typingBurst событий подряд и дальше одно в typingEvery.
+const (
+	typingTTL   = 10 * time.Second
+	typingEvery = 3 * time.Second
+	typingBurst = 3
+)
+
+// Повтор SendMessage с тем же nonce в течение nonceWindow вернёт уже
+// созданное сообщение вместо дубликата.
+const (
+	nonceWindow    = 10 * time.Minute
+	maxNonceLength = 64
+)
+
 func NewChatService(
 	messages repository.MessageRepository,
 	channels repository.ChannelRepository,
 	guilds repository.GuildRepository,
+	audit repository.AuditLogRepository,
+	reads repository.ReadStateRepository,
+	relations repository.RelationshipRepository,
+	scheduled repository.ScheduledMessageRepository,
 	users *UserService,
+	system *SystemMessenger,
+	unfurler *Unfurler,
+	tm database.TransactionManager,
 	hub *hub.Hub,
 ) *ChatService {
-	return &ChatService{messages: messages, channels: channels, guilds: guilds, users: users, hub: hub}
+	return &ChatService{
+		messages:  messages,
+		channels:  channels,
+		guilds:    guilds,
+		audit:     audit,
+		reads:     reads,
+		relations: relations,
+		scheduled: scheduled,
+		users:     users,
+		system:    system,
+		unfurler:  unfurler,
+		tm:        tm,
+		hub:       hub,
+		typing:    ratelimit.New(typingEvery, typingBurst),
+
+		interactions: newInteractionBroker(),
+	}
 }
 
-func (s *ChatService) getAccessibleChannel(ctx context.Context, channelID, userID, op string) (*models.Channel, error) {
+// getAccessibleChannel загружает канал и участника гильдии, от имени которого идёт запрос.
+// В личном канале участник гильдии заменяется его участником с models.DMPermissions.
+func (s *ChatService) getAccessibleChannel(ctx context.Context, channelID, userID, op string) (*models.Channel, *models.GuildMember, error) {
 	ch, err := s.channels.FindByID(ctx, channelID)
 	if err != nil {
-		return nil, errors.AsAppError(err).WithOp(op).WithMsg("Channel not found")
+		return nil, nil, errors.AsAppError(err).WithOp(op).WithMsg("Channel not found")
+	}
+	if ch.IsDM() {
+		member, err := dmRecipient(ctx, s.channels, ch, userID, op)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ch, member, nil
 	}
 
-	isMember, err := s.guilds.IsMember(ctx, ch.GuildID.String(), userID)
+	member, err := s.guilds.FindMember(ctx, ch.GuildIDString(), userID)
 	if err != nil {
-		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		if errors.Is(err, errors.ErrMemberNotFound) {
+			// FIXME: Вариативная проверка на права VIEW_CHANNEL и SEND_MESSAGES
+			return nil, nil, errors.PermissionError("VIEW_CHANNEL", ch.GuildIDString()).WithOp(op)
+		}
+		return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	return ch, member, nil
+}
+
+// attachAuthorMembers подгружает профили авторов в гильдии одним запросом на страницу истории.
+func (s *ChatService) attachAuthorMembers(ctx context.Context, guildID string, msgs []models.Message) {
+	if len(msgs) == 0 || guildID == "" {
+		return
 	}
-	if !isMember {
-		// FIXME: Вариативная проверка на права VIEW_CHANNEL и SEND_MESSAGES
-		return nil, errors.PermissionError("VIEW_CHANNEL", ch.GuildID.String()).WithOp(op)
+	seen := make(map[string]struct{}, len(msgs))
+	authorIDs := make([]string, 0, len(msgs))
+	for i := range msgs {
+		id := msgs[i].AuthorID.String()
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			authorIDs = append(authorIDs, id)
+		}
 	}
 
-	return ch, nil
+	members, err := s.guilds.ListMembersByUserIDs(ctx, guildID, authorIDs)
+	if err != nil {
+		return // без профилей гильдии покажем глобальные
+	}
+	byUser := make(map[uuid.UUID]*models.GuildMember, len(members))
+	for i := range members {
+		byUser[members[i].UserID] = &members[i]
+	}
+	for i := range msgs {
+		msgs[i].AuthorMember = byUser[msgs[i].AuthorID]
+	}
 }
 
-func (s *ChatService) SendMessage(ctx context.Context, channelID, authorID, content string) (*models.Message, error) {
-	const op = "ChatService.SendMessage"
+// Лимиты текста: видимый текст считается в символах после разбора разметки,
+// сырой размер ограничен отдельно, чтобы разметка не раздувала сообщение.
+const (
+	maxContentLength   = 4000
+	maxRawContentBytes = 16000
+)
 
+// validateContent — общие правила для текста при отправке и правке.
+func validateContent(content string, contentType models.MessageContentType) *errors.AppError {
 	if len(content) == 0 {
-		return nil, errors.ErrCannotSendEmpty.WithOp(op).
+		return errors.ErrCannotSendEmpty.
 			WithRemedy("Please type something before sending.")
 	}
-	if len(content) > 4000 {
-		return nil, errors.ErrMessageTooLong.WithOp(op).
-			WithMeta("limit", 4000).
-			WithMeta("current", len(content)).
+	if len(content) > maxRawContentBytes {
+		return errors.ErrMessageTooLong.
+			WithMeta("limit_bytes", maxRawContentBytes).
+			WithMeta("current_bytes", len(content)).
+			WithRemedy("Try splitting your message into multiple parts.")
+	}
+
+	length := utf8.RuneCountInString(content)
+	if contentType == models.MessageContentTypeMarkdown {
+		length = markup.TextLength(markup.Parse(content))
+		if length == 0 {
+			return errors.ErrCannotSendEmpty.
+				WithRemedy("The message has formatting but no visible text.")
+		}
+	}
+	if length > maxContentLength {
+		return errors.ErrMessageTooLong.
+			WithMeta("limit", maxContentLength).
+			WithMeta("current", length).
 			WithRemedy("Try splitting your message into multiple parts.")
 	}
+	return nil
+}
 
-	ch, err := s.getAccessibleChannel(ctx, channelID, authorID, op)
+// SendMessageParams — параметры отправки сообщения.
+type SendMessageParams struct {
+	ChannelID string
+	AuthorID  string
+	Content   string
+	ReplyToID string // Пусто — не ответ
+	Markdown  bool   // Текст размечен (см. pkg/markup)
+	// Components — кнопки и меню; нажатия придут автору в SubscribeUserEvents.
+	// Ботов как отдельного вида аккаунтов в ядре нет, поэтому компоненты
+	// может прикрепить любой автор: отвечать на нажатия ему всё равно
+	// придётся самому, из своего потока событий
+	Components []models.ActionRow
+	// Nonce — ключ идемпотентности от клиента; пусто — без дедупликации
+	Nonce string
+}
+
+func (s *ChatService) SendMessage(ctx context.Context, p SendMessageParams) (*models.Message, error) {
+	const op = "ChatService.SendMessage"
+	channelID, authorID := p.ChannelID, p.AuthorID
+
+	contentType := models.MessageContentTypeText
+	if p.Markdown {
+		contentType = models.MessageContentTypeMarkdown
+	}
+	if err := validateContent(p.Content, contentType); err != nil {
+		return nil, err.WithOp(op)
+	}
+	if err := validateComponents(p.Components); err != nil {
+		return nil, err.WithOp(op)
+	}
+	if len(p.Nonce) > maxNonceLength {
+		return nil, errors.ValidationError("nonce", "Must be at most 64 characters").WithOp(op)
+	}
+
+	ch, member, err := s.getAccessibleChannel(ctx, channelID, authorID, op)
 	if err != nil {
 		return nil, err
 	}
 
-	if ch.Type != models.ChannelTypeText && ch.Type != models.ChannelTypeAnnouncement {
+	// Повтор уже прошедшей отправки (ответ не дошёл до клиента)
+	if p.Nonce != "" {
+		sent, err := s.sentWithNonce(ctx, p, member)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		if sent != nil {
+			return sent, nil
+		}
+	}
+
+	if member.IsTimedOut(time.Now()) {
+		return nil, errors.MemberTimedOut(*member.TimeoutUntil).WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermSendMessages) {
+		return nil, errors.PermissionError("SEND_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+	if ch.Type == models.ChannelTypeAnnouncement && !member.EffectivePermissions.Can(models.PermPublishMessages) {
+		return nil, errors.PermissionError("PUBLISH_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+	if ch.Type == models.ChannelTypeDM {
+		if err := s.checkDMBlocked(ctx, ch, authorID, op); err != nil {
+			return nil, err
+		}
+	}
+
+	if !ch.IsTextBased() {
 		return nil, errors.New(errors.CodeChannelAccessDenied, "This channel does not support text messages.", 3).
 			WithOp(op).
 			WithMeta("channel_type", ch.Type)
 	}
+	if ch.Type == models.ChannelTypeThread {
+		if ch.IsArchived {
+			return nil, errors.ErrThreadArchived.WithOp(op).
+				WithRemedy("Unarchive the thread to continue the discussion.")
+		}
+		if ch.IsLocked && !member.EffectivePermissions.Can(models.PermManageThreads) {
+			return nil, errors.ErrThreadLocked.WithOp(op)
+		}
+	}
 
 	msg := &models.Message{
-		BaseEntity: models.BaseEntity{RealmID: middleware.MustRealmID(ctx)},
-		ChannelID:  uuid.MustParse(channelID),
-		AuthorID:   uuid.MustParse(authorID),
-		Content:    content,
+		BaseEntity:  models.BaseEntity{RealmID: middleware.MustRealmID(ctx)},
+		ChannelID:   uuid.MustParse(channelID),
+		AuthorID:    uuid.MustParse(authorID),
+		Content:     p.Content,
+		ContentType: contentType,
+		Components:  p.Components,
+		Nonce:       p.Nonce,
+	}
+
+	if p.ReplyToID != "" {
+		if _, err := uuid.Parse(p.ReplyToID); err != nil {
+			return nil, errors.ValidationError("reply_to_message_id", "Must be a valid message ID").WithOp(op)
+		}
+		ref, err := s.messages.FindByID(ctx, p.ReplyToID)
+		if err != nil {
+			return nil, errors.AsAppError(err).WithOp(op).WithMeta("reply_to_message_id", p.ReplyToID)
+		}
+		if ref.ChannelID != msg.ChannelID {
+			return nil, errors.ValidationError("reply_to_message_id", "Must reference a message in the same channel").WithOp(op)
+		}
+		if refAuthor, err := s.users.GetProfile(ctx, ref.AuthorID.String()); err == nil {
+			ref.Author = *refAuthor
+		}
+		msg.ReplyToID = &ref.ID
+		msg.ReferencedMessage = ref
+	}
+
+	mentions, recipients, err := s.resolveMentions(ctx, ch, member, p.Content, contentType, op)
+	if err != nil {
+		return nil, err
 	}
-	if err := s.messages.Create(ctx, msg); err != nil {
+	msg.Mentions = mentions
+
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.messages.Create(txCtx, msg); err != nil {
+			return err
+		}
+		if p.Nonce != "" {
+			now := time.Now()
+			err := s.messages.ClaimNonce(txCtx, &models.MessageNonce{
+				AuthorID:  msg.AuthorID,
+				ChannelID: msg.ChannelID,
+				Nonce:     p.Nonce,
+				MessageID: msg.ID,
+				CreatedAt: now,
+			}, now.Add(-nonceWindow))
+			if err != nil {
+				return err
+			}
+		}
+		if ch.IsDM() {
+			return nil // Входящие упоминания ведутся только по гильдиям
+		}
+		return s.messages.CreateMentions(txCtx, mentionRows(msg, *ch.GuildID, recipients))
+	})
+	if err != nil && p.Nonce != "" && errors.Is(err, errors.ErrConflict) {
+		// Параллельный повтор успел раньше — отдаём его сообщение
+		sent, findErr := s.sentWithNonce(ctx, p, member)
+		if findErr == nil && sent != nil {
+			return sent, nil
+		}
+	}
+	if err != nil {
 		return nil, errors.AsAppError(err).WithOp(op).
 			WithMsg("Failed to persist message in database")
 	}
 
+	if ch.Type == models.ChannelTypeThread {
+		// Написавший в ветку автоматически в неё вступает
+		err := s.channels.AddThreadMember(ctx, &models.ThreadMember{
+			RealmID:  ch.RealmID,
+			ThreadID: ch.ID,
+			UserID:   member.UserID,
+		})
+		if err != nil && !errors.Is(err, errors.ErrConflict) {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+	}
+
 	if authorProfile, err := s.users.GetProfile(ctx, authorID); err == nil {
 		msg.Author = *authorProfile
 	}
+	msg.AuthorMember = member
+
+	// Своё сообщение прочитано сразу
+	s.markRead(ctx, msg.RealmID, msg.ChannelID, msg.AuthorID, msg.Seq)
 
 	// Публикуем в хаб (не ждём — fire and forget)
-	s.hub.Publish(channelID, &pb.ChatEvent{
+	event := &pb.ChatEvent{
 		Payload: &pb.ChatEvent_MessageCreated{
 			MessageCreated: MessageToProto(msg),
 		},
+	}
+	s.hub.Publish(channelID, event)
+	if ch.IsDM() {
+		s.publishToRecipients(ctx, channelID, event)
+	}
+	var copies []*models.Message
+	if ch.Type == models.ChannelTypeAnnouncement {
+		copies = s.crosspost(ctx, ch, msg)
+	}
+	s.unfurler.Enqueue(ctx, msg, copies...)
+
+	return msg, nil
+}
+
+// sentWithNonce ищет сообщение, уже отправленное автором с p.Nonce в пределах
+// nonceWindow. nil — такой отправки не было (или сообщение удалено).
+func (s *ChatService) sentWithNonce(ctx context.Context, p SendMessageParams, member *models.GuildMember) (*models.Message, error) {
+	msg, err := s.messages.FindByNonce(ctx, p.AuthorID, p.ChannelID, p.Nonce, time.Now().Add(-nonceWindow))
+	if errors.Is(err, errors.ErrMessageNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := []models.Message{*msg}
+	s.attachReactions(ctx, p.AuthorID, msgs)
+	s.attachReferences(ctx, msgs)
+	sent := &msgs[0]
+	sent.AuthorMember = member
+	sent.Nonce = p.Nonce
+	return sent, nil
+}
+
+// publishToRecipients дублирует событие личного канала в SubscribeUserEvents
+// его участников: так новые сообщения доходят и без подписки на сам канал.
+func (s *ChatService) publishToRecipients(ctx context.Context, channelID string, event *pb.ChatEvent) {
+	ids, err := s.channels.ListRecipientIDs(ctx, channelID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to list dm recipients", "channel_id", channelID, "error", err)
+		return
+	}
+	for _, id := range ids {
+		s.hub.PublishUser(id.String(), event)
+	}
+}
+
+// checkDMBlocked запрещает писать в dm, если один из собеседников заблокировал другого.
+// В group_dm блокировка писать не мешает (иначе один участник мог бы заглушить
+// другого для всех): сообщения заблокированного там скрывает клиент по
+// AuthorBlocked в ответах и по своему списку блокировок в real-time событиях.
+func (s *ChatService) checkDMBlocked(ctx context.Context, ch *models.Channel, authorID, op string) error {
+	ids, err := s.channels.ListRecipientIDs(ctx, ch.ID.String())
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	for _, id := range ids {
+		if id.String() == authorID {
+			continue
+		}
+		blocked, err := s.relations.IsBlocked(ctx, authorID, id.String())
+		if err != nil {
+			return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		if blocked {
+			return errors.ErrDMNotAllowed.WithOp(op).WithMeta("user_id", id.String())
+		}
+	}
+	return nil
+}
+
+// SendTyping рассылает остальным подписчикам канала, что пользователь печатает.
+// Ничего не сохраняет; частота ограничена на пользователя.
+func (s *ChatService) SendTyping(ctx context.Context, channelID, callerID string) error {
+	const op = "ChatService.SendTyping"
+
+	if ok, retryAfter := s.typing.Allow(callerID); !ok {
+		return errors.RateLimit(retryAfter.Seconds(), false).WithOp(op)
+	}
+
+	ch, member, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return err
+	}
+	if !ch.IsTextBased() {
+		return errors.ValidationError("channel_id", "This channel does not support text messages").WithOp(op)
+	}
+	if member.IsTimedOut(time.Now()) {
+		return errors.MemberTimedOut(*member.TimeoutUntil).WithOp(op)
+	}
+	// Печатать там, куда нельзя писать, незачем
+	if !member.EffectivePermissions.Can(models.PermSendMessages) {
+		return errors.PermissionError("SEND_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	s.hub.PublishExcept(channelID, callerID, &pb.ChatEvent{
+		Payload: &pb.ChatEvent_TypingStarted{
+			TypingStarted: &pb.TypingStarted{
+				ChannelId: channelID,
+				UserId:    callerID,
+				ExpiresAt: timestamppb.New(time.Now().Add(typingTTL)),
+			},
+		},
+	})
+	return nil
+}
+
+// EditMessage меняет текст своего сообщения. Прежний текст сохраняется в истории правок.
+func (s *ChatService) EditMessage(ctx context.Context, messageID, callerID, content string) (*models.Message, error) {
+	const op = "ChatService.EditMessage"
+
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	// Тип текста сохраняется: правка markdown-сообщения — тоже markdown
+	if err := validateContent(content, msg.ContentType); err != nil {
+		return nil, err.WithOp(op)
+	}
+	if msg.AuthorID.String() != callerID || msg.Flags.Has(models.MessageFlagSystem) {
+		return nil, errors.ErrForbidden.WithOp(op).WithMsg("You can only edit your own messages")
+	}
+	if msg.CrosspostOfID != nil {
+		return nil, errors.ErrForbidden.WithOp(op).
+			WithMsg("Crossposted announcements can only be edited at the source")
+	}
+
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if member.IsTimedOut(time.Now()) {
+		return nil, errors.MemberTimedOut(*member.TimeoutUntil).WithOp(op)
+	}
+
+	// Упоминания разбираются заново: правка может добавить или убрать адресатов
+	mentions, recipients, err := s.resolveMentions(ctx, ch, member, content, msg.ContentType, op)
+	if err != nil {
+		return nil, err
+	}
+
+	editedAt := time.Now()
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		// Сначала условный UPDATE: при гонке двух правок проигравший получит ErrVersionConflict
+		if err := s.messages.UpdateContent(txCtx, messageID, msg.EditVersion, content, editedAt); err != nil {
+			return err
+		}
+		err := s.messages.CreateEdit(txCtx, &models.MessageEdit{
+			BaseEntity:  models.BaseEntity{RealmID: msg.RealmID},
+			MessageID:   msg.ID,
+			EditorID:    msg.AuthorID,
+			Content:     msg.Content,
+			EditVersion: msg.EditVersion,
+		})
+		if err != nil {
+			return err
+		}
+		var rows []models.MessageMention
+		if !ch.IsDM() {
+			rows = mentionRows(msg, *ch.GuildID, recipients)
+		}
+		return s.messages.ReplaceMentions(txCtx, messageID, mentions, rows)
+	})
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	if msg.ReplyToID != nil {
+		page := []models.Message{*msg}
+		s.attachReferences(ctx, page)
+		msg.ReferencedMessage = page[0].ReferencedMessage
+	}
+	msg.Content = content
+	msg.Mentions = mentions
+	msg.EditedAt = &editedAt
+	msg.EditVersion++
+	msg.Flags.Add(models.MessageFlagEdited)
+	msg.AuthorMember = member
+	if authorProfile, err := s.users.GetProfile(ctx, callerID); err == nil {
+		msg.Author = *authorProfile
+	}
+
+	s.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessageUpdated{
+			MessageUpdated: MessageToProto(msg),
+		},
 	})
+	var copies []*models.Message
+	if ch.Type == models.ChannelTypeAnnouncement {
+		copies = s.updateCrossposts(ctx, msg)
+	}
+	s.unfurler.Enqueue(ctx, msg, copies...)
 
 	return msg, nil
 }
 
-func (s *ChatService) GetHistory(ctx context.Context, channelID, callerID string, limit int, beforeID string) ([]models.Message, bool, error) {
+// ListMessageEdits возвращает прежние редакции сообщения. Требует MANAGE_MESSAGES.
+func (s *ChatService) ListMessageEdits(ctx context.Context, messageID, callerID string) ([]models.MessageEdit, error) {
+	const op = "ChatService.ListMessageEdits"
+
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return nil, errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	edits, err := s.messages.ListEdits(ctx, messageID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return edits, nil
+}
+
+// DeleteMessage удаляет сообщение (soft delete). Автор удаляет своё без прав,
+// чужое — только с MANAGE_MESSAGES; такое удаление попадает в журнал аудита.
+func (s *ChatService) DeleteMessage(ctx context.Context, messageID, callerID, reason string) error {
+	const op = "ChatService.DeleteMessage"
+
+	if utf8.RuneCountInString(reason) > 255 {
+		return errors.ValidationError("reason", "Must be at most 255 characters").WithOp(op)
+	}
+
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return err
+	}
+
+	isAuthor := msg.AuthorID == member.UserID && !msg.Flags.Has(models.MessageFlagSystem)
+	if !isAuthor && !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.messages.SoftDelete(txCtx, messageID, member.UserID, reasonPtr); err != nil {
+			return err
+		}
+		if isAuthor {
+			return nil
+		}
+		if ch.IsDM() {
+			return nil // Журнал аудита ведётся только по гильдиям
+		}
+		meta, _ := json.Marshal(map[string]string{
+			"channel_id": msg.ChannelID.String(),
+			"author_id":  msg.AuthorID.String(),
+			"reason":     reason,
+		})
+		return s.audit.Create(txCtx, &models.AuditLog{
+			GuildID:  *ch.GuildID,
+			ActorID:  member.UserID,
+			Action:   models.AuditActionMessageDelete,
+			TargetID: &msg.ID,
+			Meta:     meta,
+		})
+	})
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+
+	s.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessageDeleted{
+			MessageDeleted: &pb.MessageDeleted{
+				MessageId: messageID,
+				ChannelId: msg.ChannelID.String(),
+				DeletedBy: callerID,
+			},
+		},
+	})
+	if ch.Type == models.ChannelTypeAnnouncement {
+		s.deleteCrossposts(ctx, []uuid.UUID{msg.ID}, member.UserID)
+	}
+	return nil
+}
+
+const (
+	maxBulkDelete       = 1000
+	bulkDeleteBatchSize = 100
+)
+
+// BulkDelete — параметры массового удаления. Пустые поля не учитываются.
+type BulkDelete struct {
+	MessageIDs []string
+	AuthorID   string
+	After      *time.Time
+	Before     *time.Time
+	LastN      int
+	Reason     string
+}
+
+// BulkDeleteMessages удаляет пачку сообщений канала одной транзакцией.
+// Подписчики получают одно событие messages_bulk_deleted, в аудит пишется одна запись.
+func (s *ChatService) BulkDeleteMessages(ctx context.Context, channelID, callerID string, req BulkDelete) ([]uuid.UUID, error) {
+	const op = "ChatService.BulkDeleteMessages"
+
+	if len(req.MessageIDs) == 0 && req.AuthorID == "" && req.After == nil && req.Before == nil && req.LastN <= 0 {
+		return nil, errors.ValidationError("filter", "Specify message IDs or at least one filter").WithOp(op)
+	}
+	if len(req.MessageIDs) > maxBulkDelete || req.LastN > maxBulkDelete {
+		return nil, errors.LimitReached("messages_per_bulk_delete", maxBulkDelete).WithOp(op)
+	}
+	for _, id := range req.MessageIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, errors.ValidationError("message_ids", "Must contain valid message IDs").WithOp(op)
+		}
+	}
+	if req.AuthorID != "" {
+		if _, err := uuid.Parse(req.AuthorID); err != nil {
+			return nil, errors.ValidationError("author_id", "Must be a valid user ID").WithOp(op)
+		}
+	}
+	if utf8.RuneCountInString(req.Reason) > 255 {
+		return nil, errors.ValidationError("reason", "Must be at most 255 characters").WithOp(op)
+	}
+
+	ch, member, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return nil, errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	// Без last_n берём на одно больше лимита: лишнее значит, что фильтр шире
+	// допустимого, и запрос отклоняется, а не обрезается молча
+	limit := req.LastN
+	if limit <= 0 {
+		limit = maxBulkDelete + 1
+	}
+	var reasonPtr *string
+	if req.Reason != "" {
+		reasonPtr = &req.Reason
+	}
+
+	var deleted []uuid.UUID
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		ids, err := s.messages.FindIDs(txCtx, repository.MessageFilter{
+			ChannelID: channelID,
+			IDs:       req.MessageIDs,
+			AuthorID:  req.AuthorID,
+			After:     req.After,
+			Before:    req.Before,
+			Limit:     limit,
+		})
+		if err != nil {
+			return err
+		}
+		if len(ids) > maxBulkDelete {
+			return errors.LimitReached("messages_per_bulk_delete", maxBulkDelete).WithOp(op).
+				WithRemedy("Narrow the filter or set last_n.")
+		}
+		// Пачками, чтобы не упереться в лимит параметров запроса
+		for start := 0; start < len(ids); start += bulkDeleteBatchSize {
+			batch := ids[start:min(start+bulkDeleteBatchSize, len(ids))]
+			if _, err := s.messages.SoftDeleteMany(txCtx, batch, member.UserID, reasonPtr); err != nil {
+				return err
+			}
+		}
+		deleted = ids
+		if len(ids) == 0 {
+			return nil
+		}
+		if ch.IsDM() {
+			return nil // Журнал аудита ведётся только по гильдиям
+		}
+
+		meta, _ := json.Marshal(map[string]any{
+			"channel_id": channelID,
+			"count":      len(ids),
+			"reason":     req.Reason,
+		})
+		return s.audit.Create(txCtx, &models.AuditLog{
+			GuildID:  *ch.GuildID,
+			ActorID:  member.UserID,
+			Action:   models.AuditActionMessageBulkDelete,
+			TargetID: &ch.ID,
+			Meta:     meta,
+		})
+	})
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	if len(deleted) > 0 {
+		ids := make([]string, len(deleted))
+		for i, id := range deleted {
+			ids[i] = id.String()
+		}
+		s.hub.Publish(channelID, &pb.ChatEvent{
+			Payload: &pb.ChatEvent_MessagesBulkDeleted{
+				MessagesBulkDeleted: &pb.MessagesBulkDeleted{
+					ChannelId:  channelID,
+					MessageIds: ids,
+					DeletedBy:  callerID,
+				},
+			},
+		})
+		if ch.Type == models.ChannelTypeAnnouncement {
+			s.deleteCrossposts(ctx, deleted, member.UserID)
+		}
+	}
+	return deleted, nil
+}
+
+const maxReactionEmojis = 20
+
+// AddReaction ставит реакцию на сообщение. Повторная реакция — не ошибка.
+func (s *ChatService) AddReaction(ctx context.Context, messageID, callerID, emoji string) error {
+	const op = "ChatService.AddReaction"
+
+	if err := validator.ValidateEmoji(emoji); err != nil {
+		return err.WithOp(op)
+	}
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return err
+	}
+	if member.IsTimedOut(time.Now()) {
+		return errors.MemberTimedOut(*member.TimeoutUntil).WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermAddReactions) {
+		return errors.PermissionError("ADD_REACTIONS", ch.GuildIDString()).WithOp(op)
+	}
+
+	emojis, err := s.messages.ReactionEmojis(ctx, messageID)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if len(emojis) >= maxReactionEmojis && !slices.Contains(emojis, emoji) {
+		return errors.LimitReached("reactions_per_message", maxReactionEmojis).WithOp(op)
+	}
+
+	err = s.messages.AddReaction(ctx, &models.MessageReaction{
+		RealmID:   msg.RealmID,
+		MessageID: msg.ID,
+		UserID:    member.UserID,
+		Emoji:     emoji,
+	})
+	if errors.Is(err, errors.ErrConflict) {
+		return nil // уже стоит
+	}
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	s.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_ReactionAdded{
+			ReactionAdded: &pb.ReactionEvent{
+				MessageId: messageID,
+				ChannelId: msg.ChannelID.String(),
+				UserId:    callerID,
+				Emoji:     emoji,
+			},
+		},
+	})
+	return nil
+}
+
+// RemoveReaction снимает реакцию. Пустой targetID — своя; чужую может снять модератор.
+func (s *ChatService) RemoveReaction(ctx context.Context, messageID, callerID, targetID, emoji string) error {
+	const op = "ChatService.RemoveReaction"
+
+	if targetID == "" {
+		targetID = callerID
+	}
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return err
+	}
+	if targetID != callerID && !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	removed, err := s.messages.RemoveReaction(ctx, messageID, targetID, emoji)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !removed {
+		return nil
+	}
+
+	s.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_ReactionRemoved{
+			ReactionRemoved: &pb.ReactionEvent{
+				MessageId: messageID,
+				ChannelId: msg.ChannelID.String(),
+				UserId:    targetID,
+				Emoji:     emoji,
+			},
+		},
+	})
+	return nil
+}
+
+// RemoveAllReactions снимает все реакции с сообщения (или только emoji). Требует MANAGE_MESSAGES.
+func (s *ChatService) RemoveAllReactions(ctx context.Context, messageID, callerID, emoji string) error {
+	const op = "ChatService.RemoveAllReactions"
+
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return err
+	}
+	if !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	removed, err := s.messages.RemoveReactions(ctx, messageID, emoji)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if removed == 0 {
+		return nil
+	}
+
+	s.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_ReactionsCleared{
+			ReactionsCleared: &pb.ReactionsCleared{
+				MessageId: messageID,
+				ChannelId: msg.ChannelID.String(),
+				Emoji:     emoji,
+			},
+		},
+	})
+	return nil
+}
+
+// ListReactors возвращает пользователей, поставивших реакцию emoji.
+func (s *ChatService) ListReactors(ctx context.Context, messageID, callerID, emoji string, limit int, afterUserID string) ([]models.User, error) {
+	const op = "ChatService.ListReactors"
+
+	if limit <= 0 {
+		limit = 25
+	}
+	if limit > 100 {
+		return nil, errors.LimitReached("reactors_per_request", 100).WithOp(op)
+	}
+	if afterUserID != "" {
+		if _, err := uuid.Parse(afterUserID); err != nil {
+			return nil, errors.ValidationError("after_user_id", "Must be a valid user ID").WithOp(op)
+		}
+	}
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if _, _, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op); err != nil {
+		return nil, err
+	}
+
+	users, err := s.messages.ListReactors(ctx, messageID, emoji, limit, afterUserID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return users, nil
+}
+
+const maxPinsPerChannel = 50
+
+// PinMessage закрепляет сообщение и пишет об этом системное сообщение в канал.
+func (s *ChatService) PinMessage(ctx context.Context, messageID, callerID string) error {
+	return s.setPinned(ctx, messageID, callerID, true, "ChatService.PinMessage")
+}
+
+// UnpinMessage открепляет сообщение.
+func (s *ChatService) UnpinMessage(ctx context.Context, messageID, callerID string) error {
+	return s.setPinned(ctx, messageID, callerID, false, "ChatService.UnpinMessage")
+}
+
+func (s *ChatService) setPinned(ctx context.Context, messageID, callerID string, pinned bool, op string) error {
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return err
+	}
+	if !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	changed, err := s.messages.SetPinned(ctx, messageID, pinned, maxPinsPerChannel)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	if !changed {
+		return nil // уже в нужном состоянии
+	}
+
+	s.hub.Publish(ch.ID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessagePinUpdated{
+			MessagePinUpdated: &pb.MessagePinUpdated{
+				MessageId: messageID,
+				ChannelId: ch.ID.String(),
+				Pinned:    pinned,
+				ActorId:   callerID,
+			},
+		},
+	})
+	if pinned {
+		s.system.Post(ctx, ch.RealmID, ch.ID, models.SystemPayload{
+			Type:     models.SystemMessageMessagePin,
+			ActorID:  callerID,
+			TargetID: messageID,
+		})
+	}
+	return nil
+}
+
+// ListPinnedMessages возвращает закреплённые сообщения канала.
+func (s *ChatService) ListPinnedMessages(ctx context.Context, channelID, callerID string) ([]models.Message, error) {
+	const op = "ChatService.ListPinnedMessages"
+
+	ch, _, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := s.messages.ListPinned(ctx, channelID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	s.attachAuthorMembers(ctx, ch.GuildIDString(), msgs)
+	s.attachReactions(ctx, callerID, msgs)
+	s.attachReferences(ctx, msgs)
+	s.markBlockedAuthors(ctx, callerID, msgs)
+	return msgs, nil
+}
+
+// attachReferences подгружает превью цитируемых сообщений одним запросом на страницу.
+// Удалённые оригиналы (в том числе физически) помечаются как удалённые.
+func (s *ChatService) attachReferences(ctx context.Context, msgs []models.Message) {
+	var ids []uuid.UUID
+	for i := range msgs {
+		if msgs[i].ReplyToID != nil {
+			ids = append(ids, *msgs[i].ReplyToID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	refs, err := s.messages.FindByIDsWithDeleted(ctx, ids)
+	if err != nil {
+		return // без превью клиент покажет только ID
+	}
+	byID := make(map[uuid.UUID]*models.Message, len(refs))
+	for i := range refs {
+		byID[refs[i].ID] = &refs[i]
+	}
+	for i := range msgs {
+		if msgs[i].ReplyToID == nil {
+			continue
+		}
+		ref, ok := byID[*msgs[i].ReplyToID]
+		if !ok {
+			ref = &models.Message{BaseEntity: models.BaseEntity{ID: *msgs[i].ReplyToID}}
+			ref.DeletedAt.Valid = true
+		}
+		msgs[i].ReferencedMessage = ref
+	}
+}
+
+// attachReactions подгружает агрегаты реакций на страницу истории одним запросом.
+func (s *ChatService) attachReactions(ctx context.Context, viewerID string, msgs []models.Message) {
+	if len(msgs) == 0 {
+		return
+	}
+	ids := make([]uuid.UUID, len(msgs))
+	for i := range msgs {
+		ids[i] = msgs[i].ID
+	}
+	summaries, err := s.messages.ReactionSummaries(ctx, ids, viewerID)
+	if err != nil {
+		return // история важнее счётчиков реакций
+	}
+	byMessage := make(map[uuid.UUID]int, len(msgs))
+	for i := range msgs {
+		byMessage[msgs[i].ID] = i
+	}
+	for _, rs := range summaries {
+		if i, ok := byMessage[rs.MessageID]; ok {
+			msgs[i].ReactionSummaries = append(msgs[i].ReactionSummaries, rs)
+		}
+	}
+}
+
+// markBlockedAuthors помечает сообщения авторов, заблокированных viewerID.
+func (s *ChatService) markBlockedAuthors(ctx context.Context, viewerID string, msgs []models.Message) {
+	if len(msgs) == 0 {
+		return
+	}
+	blocked, err := s.relations.ListBlockedIDs(ctx, viewerID)
+	if err != nil || len(blocked) == 0 {
+		return // без пометки сообщения просто покажутся как обычно
+	}
+	for i := range msgs {
+		msgs[i].AuthorBlocked = slices.Contains(blocked, msgs[i].AuthorID)
+	}
+}
+
+// HistoryParams — запрос страницы истории. Задаётся не больше одного курсора;
+// без курсоров возвращаются самые последние сообщения.
+type HistoryParams struct {
+	ChannelID       string
+	CallerID        string
+	Limit           int
+	BeforeMessageID string // Старше сообщения; то же, что BeforeSeq = его Seq
+	BeforeSeq       int64  // Старше Seq
+	AfterSeq        int64  // Новее Seq
+	AroundMessageID string // Окно вокруг сообщения, включая его самого
+}
+
+// HistoryPage — страница истории, сообщения от старых к новым.
+type HistoryPage struct {
+	Messages     []models.Message
+	HasMore      bool // Есть сообщения старше страницы (кроме AfterSeq)
+	HasMoreAfter bool // Есть сообщения новее страницы (только AfterSeq и AroundMessageID)
+	// SyncSeq — курсор для SyncChannel: изменения после него страница не отражает
+	SyncSeq int64
+}
+
+func (s *ChatService) GetHistory(ctx context.Context, p HistoryParams) (*HistoryPage, error) {
 	const op = "ChatService.GetHistory"
 
-	if _, err := s.getAccessibleChannel(ctx, channelID, callerID, op); err != nil {
-		return nil, false, err
+	ch, _, err := s.getAccessibleChannel(ctx, p.ChannelID, p.CallerID, op)
+	if err != nil {
+		return nil, err
 	}
 
+	limit := p.Limit
 	if limit <= 0 {
 		limit = 50
 	}
 	if limit > 100 {
-		return nil, false, errors.LimitReached("messages_per_request", 100).WithOp(op)
+		return nil, errors.LimitReached("messages_per_request", 100).WithOp(op)
+	}
+	if p.BeforeSeq < 0 || p.AfterSeq < 0 {
+		return nil, errors.ValidationError("seq", "Must not be negative").WithOp(op)
+	}
+	cursors := 0
+	for _, set := range []bool{p.BeforeMessageID != "", p.BeforeSeq > 0, p.AfterSeq > 0, p.AroundMessageID != ""} {
+		if set {
+			cursors++
+		}
+	}
+	if cursors > 1 {
+		return nil, errors.ValidationError("cursor", "Specify at most one of before_message_id, before_seq, after_seq, around_message_id").WithOp(op)
+	}
+
+	// Читаем до выборки: всё, что изменится после, клиент получит через SyncChannel
+	page := &HistoryPage{SyncSeq: ch.NextChangeSeq - 1}
+
+	switch {
+	case p.AroundMessageID != "":
+		target, err := s.findChannelMessage(ctx, ch, p.AroundMessageID, "around_message_id", op)
+		if err != nil {
+			return nil, err
+		}
+		// Старшая половина — до целевого сообщения, остальное — начиная с него
+		olderLimit := limit / 2
+		older, hasMore, err := s.historySlice(ctx, repository.HistoryQuery{ChannelID: p.ChannelID, BeforeSeq: target.Seq}, olderLimit)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		newer, hasMoreAfter, err := s.historySlice(ctx, repository.HistoryQuery{ChannelID: p.ChannelID, FromSeq: target.Seq}, limit-olderLimit)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		page.Messages = append(older, newer...)
+		page.HasMore, page.HasMoreAfter = hasMore, hasMoreAfter
+	case p.AfterSeq > 0:
+		page.Messages, page.HasMoreAfter, err = s.historySlice(ctx, repository.HistoryQuery{ChannelID: p.ChannelID, AfterSeq: p.AfterSeq}, limit)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+	default:
+		beforeSeq := p.BeforeSeq
+		if p.BeforeMessageID != "" {
+			before, err := s.findChannelMessage(ctx, ch, p.BeforeMessageID, "before_message_id", op)
+			if err != nil {
+				return nil, err
+			}
+			beforeSeq = before.Seq
+		}
+		page.Messages, page.HasMore, err = s.historySlice(ctx, repository.HistoryQuery{ChannelID: p.ChannelID, BeforeSeq: beforeSeq}, limit)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+	}
+
+	s.attachAuthorMembers(ctx, ch.GuildIDString(), page.Messages)
+	s.attachReactions(ctx, p.CallerID, page.Messages)
+	s.attachReferences(ctx, page.Messages)
+	s.markBlockedAuthors(ctx, p.CallerID, page.Messages)
+	return page, nil
+}
+
+// historySlice запрашивает на одно сообщение больше limit, чтобы узнать, есть ли
+// продолжение в направлении курсора. Лишнее отрезается с дальнего от курсора края.
+func (s *ChatService) historySlice(ctx context.Context, q repository.HistoryQuery, limit int) ([]models.Message, bool, error) {
+	if limit == 0 {
+		return nil, false, nil
+	}
+	q.Limit = limit + 1
+	msgs, err := s.messages.GetHistory(ctx, q)
+	if err != nil || len(msgs) <= limit {
+		return msgs, false, err
+	}
+	if q.Forward() {
+		return msgs[:limit], true, nil
+	}
+	return msgs[len(msgs)-limit:], true, nil
+}
+
+// findChannelMessage загружает сообщение-курсор и проверяет, что оно из канала ch.
+// Удалённое тоже подходит: от курсора нужен только Seq, а клиент мог листать
+// с сообщения, которое успели удалить.
+func (s *ChatService) findChannelMessage(ctx context.Context, ch *models.Channel, messageID, field, op string) (*models.Message, error) {
+	id, err := uuid.Parse(messageID)
+	if err != nil {
+		return nil, errors.ValidationError(field, "Must be a valid message ID").WithOp(op)
+	}
+	found, err := s.messages.FindByIDsWithDeleted(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if len(found) == 0 {
+		return nil, errors.ErrMessageNotFound.WithOp(op).WithMeta(field, messageID)
+	}
+	msg := &found[0]
+	if msg.ChannelID != ch.ID {
+		return nil, errors.ValidationError(field, "Must reference a message in the same channel").WithOp(op)
+	}
+	return msg, nil
+}
+
+// ChannelSync — изменения канала после курсора синхронизации.
+type ChannelSync struct {
+	// Messages — созданные и изменённые сообщения, по возрастанию ChangeSeq
+	Messages   []models.Message
+	DeletedIDs []uuid.UUID
+	SyncSeq    int64 // Курсор для следующего вызова
+	HasMore    bool  // Изменений больше limit — повторить с SyncSeq
+}
+
+// SyncChannel возвращает всё, что изменилось в канале после sinceSyncSeq:
+// новые, отредактированные и удалённые сообщения. Курсор берётся из
+// GetHistory или предыдущего SyncChannel.
+func (s *ChatService) SyncChannel(ctx context.Context, channelID, callerID string, sinceSyncSeq int64, limit int) (*ChannelSync, error) {
+	const op = "ChatService.SyncChannel"
+
+	ch, _, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		return nil, errors.LimitReached("changes_per_request", 500).WithOp(op)
+	}
+	if sinceSyncSeq < 0 {
+		return nil, errors.ValidationError("sync_seq", "Must not be negative").WithOp(op)
 	}
 
-	msgs, err := s.messages.GetHistory(ctx, channelID, limit+1, beforeID)
+	changed, err := s.messages.ListChanges(ctx, channelID, sinceSyncSeq, limit+1)
 	if err != nil {
-		return nil, false, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	res := &ChannelSync{SyncSeq: max(sinceSyncSeq, ch.NextChangeSeq-1)}
+	if res.HasMore = len(changed) > limit; res.HasMore {
+		changed = changed[:limit]
+	}
+	if n := len(changed); n > 0 && (res.HasMore || changed[n-1].ChangeSeq > res.SyncSeq) {
+		res.SyncSeq = changed[n-1].ChangeSeq
 	}
 
-	hasMore := len(msgs) > limit
-	if hasMore {
-		msgs = msgs[:limit]
+	for _, m := range changed {
+		if m.DeletedAt.Valid {
+			res.DeletedIDs = append(res.DeletedIDs, m.ID)
+		} else {
+			res.Messages = append(res.Messages, m)
+		}
 	}
-	return msgs, hasMore, nil
+	s.attachAuthorMembers(ctx, ch.GuildIDString(), res.Messages)
+	s.attachReactions(ctx, callerID, res.Messages)
+	s.attachReferences(ctx, res.Messages)
+	s.markBlockedAuthors(ctx, callerID, res.Messages)
+	return res, nil
 }
 
 // Hub возвращает hub для использования в transport слое.
@@ -130,28 +1274,189 @@ func (s *ChatService) Hub() *hub.Hub {
 	return s.hub
 }
 
-// CanSubscribe проверяет права на подписку.
+// CanSubscribe проверяет права на подписку. На ветку подписываются только её участники.
 func (s *ChatService) CanSubscribe(ctx context.Context, channelID, userID string) error {
-	_, err := s.getAccessibleChannel(ctx, channelID, userID, "ChatService.CanSubscribe")
-	return err
+	const op = "ChatService.CanSubscribe"
+
+	ch, _, err := s.getAccessibleChannel(ctx, channelID, userID, op)
+	if err != nil {
+		return err
+	}
+	if ch.Type != models.ChannelTypeThread {
+		return nil
+	}
+	joined, err := s.channels.IsThreadMember(ctx, channelID, userID)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !joined {
+		return errors.ErrForbidden.WithOp(op).WithMsg("Join the thread to receive its events").
+			WithRemedy("Call JoinThread first.")
+	}
+	return nil
 }
 
 // MessageToProto конвертирует domain.Message в proto.
 func MessageToProto(m *models.Message) *pb.ChatMessage {
 	msg := &pb.ChatMessage{
-		Id:        m.ID.String(),
-		ChannelId: m.ChannelID.String(),
-		AuthorId:  m.AuthorID.String(),
-		Content:   m.Content,
-		CreatedAt: timestamppb.New(m.CreatedAt),
+		Id:            m.ID.String(),
+		ChannelId:     m.ChannelID.String(),
+		AuthorId:      m.AuthorID.String(),
+		Content:       m.Content,
+		CreatedAt:     timestamppb.New(m.CreatedAt),
+		EditVersion:   uint32(m.EditVersion),
+		Pinned:        m.IsPinned,
+		Seq:           m.Seq,
+		Ephemeral:     m.Flags.Has(models.MessageFlagEphemeral),
+		Nonce:         m.Nonce,
+		AuthorBlocked: m.AuthorBlocked,
 	}
 	// Автор может быть не загружен (lazy)
 	if m.Author.Username != "" {
 		msg.AuthorUsername = m.Author.Username
 		msg.AuthorAvatarUrl = m.Author.AvatarURL
+		msg.AuthorDisplayName = m.Author.Username
+		if m.Author.DisplayName != nil && *m.Author.DisplayName != "" {
+			msg.AuthorDisplayName = *m.Author.DisplayName
+		}
+	}
+	// Профиль в гильдии перекрывает глобальный
+	if m.AuthorMember != nil {
+		if m.AuthorMember.Nickname != "" {
+			msg.AuthorDisplayName = m.AuthorMember.Nickname
+		}
+		if m.AuthorMember.AvatarURL != "" {
+			msg.AuthorAvatarUrl = m.AuthorMember.AvatarURL
+		}
 	}
 	if m.EditedAt != nil {
 		msg.EditedAt = timestamppb.New(*m.EditedAt)
 	}
+	if m.System != nil {
+		msg.System = systemPayloadToProto(m.System)
+	}
+	if m.ThreadID != nil {
+		msg.ThreadId = m.ThreadID.String()
+	}
+	if m.ReplyToID != nil {
+		msg.ReferencedMessage = messageReferenceToProto(*m.ReplyToID, m.ReferencedMessage)
+	}
+	if m.ContentType == models.MessageContentTypeMarkdown {
+		msg.Markdown = true
+		msg.Ast = markupToProto(markup.Parse(m.Content))
+	}
+	if m.CrosspostOfID != nil && m.Crosspost != nil {
+		msg.Crosspost = &pb.CrosspostSource{
+			GuildId:     m.Crosspost.GuildID,
+			GuildName:   m.Crosspost.GuildName,
+			ChannelId:   m.Crosspost.ChannelID,
+			ChannelName: m.Crosspost.ChannelName,
+			MessageId:   m.CrosspostOfID.String(),
+		}
+	}
+	if m.Mentions != nil {
+		msg.MentionEveryone = m.Mentions.Everyone
+		msg.MentionHere = m.Mentions.Here
+		for _, id := range m.Mentions.UserIDs {
+			msg.MentionUserIds = append(msg.MentionUserIds, id.String())
+		}
+		for _, id := range m.Mentions.RoleIDs {
+			msg.MentionRoleIds = append(msg.MentionRoleIds, id.String())
+		}
+	}
+	for i := range m.Embeds {
+		msg.Embeds = append(msg.Embeds, embedToProto(&m.Embeds[i]))
+	}
+	if len(m.Components) > 0 {
+		msg.Components = componentsToProto(m.Components)
+	}
+	for _, rs := range m.ReactionSummaries {
+		msg.Reactions = append(msg.Reactions, &pb.ReactionSummary{
+			Emoji: rs.Emoji,
+			Count: int32(rs.Count),
+			Me:    rs.Me,
+		})
+	}
 	return msg
 }
+
+var embedTypes = map[models.EmbedType]pb.EmbedType{
+	models.EmbedTypeLink:  pb.EmbedType_EMBED_TYPE_LINK,
+	models.EmbedTypeImage: pb.EmbedType_EMBED_TYPE_IMAGE,
+	models.EmbedTypeVideo: pb.EmbedType_EMBED_TYPE_VIDEO,
+	models.EmbedTypeRich:  pb.EmbedType_EMBED_TYPE_RICH,
+}
+
+func embedToProto(e *models.Embed) *pb.Embed {
+	return &pb.Embed{
+		Url:         e.URL,
+		Type:        embedTypes[e.Type],
+		Title:       e.Title,
+		Description: e.Description,
+		SiteName:    e.SiteName,
+		AuthorName:  e.AuthorName,
+		ImageUrl:    e.ImageURL,
+		ImageWidth:  int32(e.ImageWidth),
+		ImageHeight: int32(e.ImageHeight),
+	}
+}
+
+const referencePreviewLength = 100
+
+// messageReferenceToProto строит превью ответа. ref == nil — превью не загружено.
+func messageReferenceToProto(id uuid.UUID, ref *models.Message) *pb.MessageReference {
+	out := &pb.MessageReference{MessageId: id.String()}
+	if ref == nil {
+		return out
+	}
+	if ref.DeletedAt.Valid {
+		out.Deleted = true
+		return out
+	}
+	out.AuthorId = ref.AuthorID.String()
+	out.AuthorUsername = ref.Author.Username
+	out.Content = ref.Content
+	if utf8.RuneCountInString(out.Content) > referencePreviewLength {
+		out.Content = string([]rune(out.Content)[:referencePreviewLength]) + "…"
+	}
+	return out
+}
+
+// markupToProto конвертирует AST разметки в proto.
+func markupToProto(nodes []markup.Node) []*pb.MarkupNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+	out := make([]*pb.MarkupNode, len(nodes))
+	for i := range nodes {
+		n := &nodes[i]
+		out[i] = &pb.MarkupNode{
+			Type:     markupNodeTypes[n.Type],
+			Text:     n.Text,
+			Lang:     n.Lang,
+			Url:      n.URL,
+			Id:       n.ID,
+			Name:     n.Name,
+			Animated: n.Animated,
+			Children: markupToProto(n.Children),
+		}
+	}
+	return out
+}
+
+var markupNodeTypes = map[markup.NodeType]pb.MarkupNodeType{
+	markup.NodeText:        pb.MarkupNodeType_MARKUP_NODE_TYPE_TEXT,
+	markup.NodeLineBreak:   pb.MarkupNodeType_MARKUP_NODE_TYPE_LINE_BREAK,
+	markup.NodeBold:        pb.MarkupNodeType_MARKUP_NODE_TYPE_BOLD,
+	markup.NodeItalic:      pb.MarkupNodeType_MARKUP_NODE_TYPE_ITALIC,
+	markup.NodeSpoiler:     pb.MarkupNodeType_MARKUP_NODE_TYPE_SPOILER,
+	markup.NodeInlineCode:  pb.MarkupNodeType_MARKUP_NODE_TYPE_INLINE_CODE,
+	markup.NodeCodeBlock:   pb.MarkupNodeType_MARKUP_NODE_TYPE_CODE_BLOCK,
+	markup.NodeQuote:       pb.MarkupNodeType_MARKUP_NODE_TYPE_QUOTE,
+	markup.NodeLink:        pb.MarkupNodeType_MARKUP_NODE_TYPE_LINK,
+	markup.NodeUserMention: pb.MarkupNodeType_MARKUP_NODE_TYPE_USER_MENTION,
+	markup.NodeRoleMention: pb.MarkupNodeType_MARKUP_NODE_TYPE_ROLE_MENTION,
+	markup.NodeEveryone:    pb.MarkupNodeType_MARKUP_NODE_TYPE_EVERYONE,
+	markup.NodeHere:        pb.MarkupNodeType_MARKUP_NODE_TYPE_HERE,
+	markup.NodeCustomEmoji: pb.MarkupNodeType_MARKUP_NODE_TYPE_CUSTOM_EMOJI,
+}
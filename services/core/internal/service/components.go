@@ -0,0 +1,243 @@
+package service
+
+import (
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+const (
+	maxActionRows       = 5
+	maxButtonsPerRow    = 5
+	maxSelectOptions    = 25
+	maxCustomIDLength   = 100
+	maxComponentLabel   = 80
+	maxOptionTextLength = 100
+	maxPlaceholder      = 150
+)
+
+// validateComponents проверяет строки компонентов и проставляет значения по
+// умолчанию (MinValues/MaxValues меню). CustomID уникальны в пределах сообщения.
+func validateComponents(rows []models.ActionRow) *errors.AppError {
+	if len(rows) > maxActionRows {
+		return errors.LimitReached("component_rows", maxActionRows)
+	}
+	customIDs := make(map[string]struct{})
+	for i := range rows {
+		row := rows[i].Components
+		field := fmt.Sprintf("components[%d]", i)
+		if len(row) == 0 {
+			return errors.ValidationError(field, "Row must not be empty")
+		}
+		if len(row) > maxButtonsPerRow {
+			return errors.ValidationError(field, "Row holds at most 5 buttons")
+		}
+		for j := range row {
+			c := &row[j]
+			field := fmt.Sprintf("components[%d][%d]", i, j)
+			if err := validateComponent(c, field, len(row)); err != nil {
+				return err
+			}
+			if c.CustomID == "" {
+				continue
+			}
+			if _, dup := customIDs[c.CustomID]; dup {
+				return errors.ValidationError(field+".custom_id", "Must be unique within the message")
+			}
+			customIDs[c.CustomID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+func validateComponent(c *models.Component, field string, rowSize int) *errors.AppError {
+	isLink := c.Type == models.ComponentTypeButton && c.Style == models.ButtonStyleLink
+	if isLink {
+		if c.CustomID != "" {
+			return errors.ValidationError(field+".custom_id", "Link buttons have no custom ID")
+		}
+		if u, err := url.Parse(c.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.ValidationError(field+".url", "Must be an http(s) URL")
+		}
+	} else {
+		if c.CustomID == "" || utf8.RuneCountInString(c.CustomID) > maxCustomIDLength {
+			return errors.ValidationError(field+".custom_id", "Must be 1-100 characters")
+		}
+		if c.URL != "" {
+			return errors.ValidationError(field+".url", "Only link buttons have a URL")
+		}
+	}
+
+	switch c.Type {
+	case models.ComponentTypeButton:
+		switch c.Style {
+		case models.ButtonStylePrimary, models.ButtonStyleSecondary, models.ButtonStyleSuccess,
+			models.ButtonStyleDanger, models.ButtonStyleLink:
+		default:
+			return errors.ValidationError(field+".style", "Unknown button style")
+		}
+		if c.Label == "" && c.Emoji == "" {
+			return errors.ValidationError(field+".label", "Button needs a label or an emoji")
+		}
+		if utf8.RuneCountInString(c.Label) > maxComponentLabel {
+			return errors.ValidationError(field+".label", "Must be at most 80 characters")
+		}
+
+	case models.ComponentTypeSelect:
+		if rowSize != 1 {
+			return errors.ValidationError(field, "Select menu must be alone in its row")
+		}
+		if len(c.Options) == 0 || len(c.Options) > maxSelectOptions {
+			return errors.ValidationError(field+".options", "Must have 1-25 options")
+		}
+		if utf8.RuneCountInString(c.Placeholder) > maxPlaceholder {
+			return errors.ValidationError(field+".placeholder", "Must be at most 150 characters")
+		}
+		values := make(map[string]struct{}, len(c.Options))
+		for k := range c.Options {
+			o := &c.Options[k]
+			if o.Label == "" || o.Value == "" ||
+				utf8.RuneCountInString(o.Label) > maxOptionTextLength ||
+				utf8.RuneCountInString(o.Value) > maxOptionTextLength ||
+				utf8.RuneCountInString(o.Description) > maxOptionTextLength {
+				return errors.ValidationError(fmt.Sprintf("%s.options[%d]", field, k), "Label and value must be 1-100 characters")
+			}
+			if _, dup := values[o.Value]; dup {
+				return errors.ValidationError(fmt.Sprintf("%s.options[%d].value", field, k), "Must be unique within the menu")
+			}
+			values[o.Value] = struct{}{}
+		}
+		if c.MinValues == 0 {
+			c.MinValues = 1
+		}
+		if c.MaxValues == 0 {
+			c.MaxValues = 1
+		}
+		if c.MinValues < 0 || c.MinValues > c.MaxValues || c.MaxValues > len(c.Options) {
+			return errors.ValidationError(field+".max_values", "Must satisfy 1 <= min_values <= max_values <= options")
+		}
+
+	default:
+		return errors.ValidationError(field+".type", "Unknown component type")
+	}
+	return nil
+}
+
+// validateInteractionValues проверяет значения, присланные при нажатии компонента.
+func validateInteractionValues(c *models.Component, values []string) *errors.AppError {
+	if c.Type == models.ComponentTypeButton {
+		if len(values) > 0 {
+			return errors.ValidationError("values", "Buttons take no values")
+		}
+		return nil
+	}
+	if len(values) < c.MinValues || len(values) > c.MaxValues {
+		return errors.ValidationError("values", fmt.Sprintf("Select between %d and %d options", c.MinValues, c.MaxValues))
+	}
+	allowed := make(map[string]bool, len(c.Options))
+	for i := range c.Options {
+		allowed[c.Options[i].Value] = true
+	}
+	for _, v := range values {
+		if !allowed[v] {
+			return errors.ValidationError("values", "Unknown option value")
+		}
+		allowed[v] = false // Повтор значения — тоже ошибка
+	}
+	return nil
+}
+
+var componentTypes = map[models.ComponentType]pb.ComponentType{
+	models.ComponentTypeButton: pb.ComponentType_COMPONENT_TYPE_BUTTON,
+	models.ComponentTypeSelect: pb.ComponentType_COMPONENT_TYPE_SELECT,
+}
+
+var buttonStyles = map[models.ButtonStyle]pb.ButtonStyle{
+	models.ButtonStylePrimary:   pb.ButtonStyle_BUTTON_STYLE_PRIMARY,
+	models.ButtonStyleSecondary: pb.ButtonStyle_BUTTON_STYLE_SECONDARY,
+	models.ButtonStyleSuccess:   pb.ButtonStyle_BUTTON_STYLE_SUCCESS,
+	models.ButtonStyleDanger:    pb.ButtonStyle_BUTTON_STYLE_DANGER,
+	models.ButtonStyleLink:      pb.ButtonStyle_BUTTON_STYLE_LINK,
+}
+
+func componentsToProto(rows []models.ActionRow) []*pb.ActionRow {
+	out := make([]*pb.ActionRow, 0, len(rows))
+	for _, row := range rows {
+		pr := &pb.ActionRow{}
+		for i := range row.Components {
+			c := &row.Components[i]
+			pc := &pb.Component{
+				Type:        componentTypes[c.Type],
+				CustomId:    c.CustomID,
+				Disabled:    c.Disabled,
+				Label:       c.Label,
+				Style:       buttonStyles[c.Style],
+				Url:         c.URL,
+				Emoji:       c.Emoji,
+				Placeholder: c.Placeholder,
+				MinValues:   int32(c.MinValues),
+				MaxValues:   int32(c.MaxValues),
+			}
+			for _, o := range c.Options {
+				pc.Options = append(pc.Options, &pb.SelectOption{
+					Label:       o.Label,
+					Value:       o.Value,
+					Description: o.Description,
+					Default:     o.Default,
+				})
+			}
+			pr.Components = append(pr.Components, pc)
+		}
+		out = append(out, pr)
+	}
+	return out
+}
+
+// ComponentsFromProto конвертирует компоненты из запроса. Неизвестные тип и
+// стиль остаются пустыми и отсекаются validateComponents.
+func ComponentsFromProto(rows []*pb.ActionRow) []models.ActionRow {
+	if len(rows) == 0 {
+		return nil
+	}
+	out := make([]models.ActionRow, len(rows))
+	for i, pr := range rows {
+		for _, pc := range pr.GetComponents() {
+			c := models.Component{
+				Type:        reverseLookup(componentTypes, pc.Type),
+				CustomID:    pc.CustomId,
+				Disabled:    pc.Disabled,
+				Label:       pc.Label,
+				Style:       reverseLookup(buttonStyles, pc.Style),
+				URL:         pc.Url,
+				Emoji:       pc.Emoji,
+				Placeholder: pc.Placeholder,
+				MinValues:   int(pc.MinValues),
+				MaxValues:   int(pc.MaxValues),
+			}
+			for _, o := range pc.Options {
+				c.Options = append(c.Options, models.SelectOption{
+					Label:       o.Label,
+					Value:       o.Value,
+					Description: o.Description,
+					Default:     o.Default,
+				})
+			}
+			out[i].Components = append(out[i].Components, c)
+		}
+	}
+	return out
+}
+
+func reverseLookup[K, V comparable](m map[K]V, v V) K {
+	for k, mv := range m {
+		if mv == v {
+			return k
+		}
+	}
+	var zero K
+	return zero
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/validator"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxGroupDMRecipients — сколько человек (вместе с создателем) может быть в group_dm.
+const maxGroupDMRecipients = 10
+
+// DMService управляет личными каналами. Это Channel без гильдии (dm или
+// group_dm): сообщения в них идут через ChatService с тем же Seq и hub,
+// а доступ проверяется по ChannelRecipient вместо членства в гильдии.
+type DMService struct {
+	channels  repository.ChannelRepository
+	guilds    repository.GuildRepository
+	users     repository.UserRepository
+	relations repository.RelationshipRepository
+	tm        database.TransactionManager
+	hub       *hub.Hub
+}
+
+func NewDMService(
+	channels repository.ChannelRepository,
+	guilds repository.GuildRepository,
+	users repository.UserRepository,
+	relations repository.RelationshipRepository,
+	tm database.TransactionManager,
+	hub *hub.Hub,
+) *DMService {
+	return &DMService{channels: channels, guilds: guilds, users: users, relations: relations, tm: tm, hub: hub}
+}
+
+// dmKey — ключ dm двух пользователей, не зависящий от порядка.
+func dmKey(a, b uuid.UUID) string {
+	x, y := a.String(), b.String()
+	if x > y {
+		x, y = y, x
+	}
+	return x + ":" + y
+}
+
+// dmRecipient проверяет, что userID — участник личного канала, и возвращает его
+// в виде участника гильдии, чтобы общие проверки ChatService работали без изменений.
+func dmRecipient(ctx context.Context, channels repository.ChannelRepository, ch *models.Channel, userID, op string) (*models.GuildMember, error) {
+	ok, err := channels.IsRecipient(ctx, ch.ID.String(), userID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !ok {
+		return nil, errors.PermissionError("VIEW_CHANNEL", "").WithOp(op)
+	}
+	return &models.GuildMember{
+		RealmID:              ch.RealmID,
+		UserID:               uuid.MustParse(userID),
+		EffectivePermissions: models.DMPermissions,
+	}, nil
+}
+
+// checkDMPolicy проверяет, разрешает ли target начать с ним переписку.
+// Блокировка с любой стороны запрещает переписку, друзьям не нужна общая гильдия.
+func (s *DMService) checkDMPolicy(ctx context.Context, callerID string, target *models.User, op string) error {
+	blocked, err := s.relations.IsBlocked(ctx, callerID, target.ID.String())
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if blocked {
+		return errors.ErrDMNotAllowed.WithOp(op).WithMeta("user_id", target.ID.String())
+	}
+
+	switch target.DMPolicy {
+	case models.DMPolicyNobody:
+		return errors.ErrDMNotAllowed.WithOp(op).WithMeta("user_id", target.ID.String())
+	case models.DMPolicyGuildMembers:
+		rel, err := s.relations.Find(ctx, target.ID.String(), callerID)
+		if err != nil && !errors.Is(err, errors.ErrNotFound) {
+			return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		if rel != nil && rel.Type == models.RelationshipFriend {
+			return nil
+		}
+		shares, err := s.guilds.SharesGuild(ctx, callerID, target.ID.String())
+		if err != nil {
+			return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		if !shares {
+			return errors.ErrDMNotAllowed.WithOp(op).WithMeta("user_id", target.ID.String()).
+				WithRemedy("This user only accepts direct messages from members of their guilds.")
+		}
+	}
+	return nil
+}
+
+// findUser загружает адресата личного канала.
+func (s *DMService) findUser(ctx context.Context, userID, op string) (*models.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, errors.ValidationError("user_ids", "Must be valid user IDs").WithOp(op)
+	}
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op).WithMeta("user_id", userID)
+	}
+	return user, nil
+}
+
+// getDM загружает личный канал с участниками и проверяет, что вызывающий в нём.
+func (s *DMService) getDM(ctx context.Context, channelID, callerID, op string) (*models.Channel, error) {
+	ch, err := s.channels.FindDM(ctx, channelID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if !slices.ContainsFunc(ch.Recipients, func(r models.ChannelRecipient) bool { return r.UserID.String() == callerID }) {
+		// Чужой личный канал для вызывающего не существует
+		return nil, errors.ErrChannelNotFound.WithOp(op)
+	}
+	return ch, nil
+}
+
+// OpenDM открывает личный канал с userIDs. С одним собеседником — dm: повторный
+// вызов вернёт тот же канал. С несколькими — новый group_dm с вызывающим во главе.
+func (s *DMService) OpenDM(ctx context.Context, callerID string, userIDs []string, name string) (*models.Channel, error) {
+	const op = "DMService.OpenDM"
+
+	callerUUID := uuid.MustParse(callerID)
+	others := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != callerID && !slices.Contains(others, id) {
+			others = append(others, id)
+		}
+	}
+	if len(others) == 0 {
+		return nil, errors.ValidationError("user_ids", "Specify at least one other user").WithOp(op)
+	}
+	if len(others)+1 > maxGroupDMRecipients {
+		return nil, errors.LimitReached("group_dm_recipients", maxGroupDMRecipients).WithOp(op)
+	}
+	if name != "" {
+		if len(others) == 1 {
+			return nil, errors.ValidationError("name", "Only group DMs can have a name").WithOp(op)
+		}
+		if err := validator.ValidateChannelName(name); err != nil {
+			return nil, err.WithOp(op)
+		}
+	}
+
+	targets := make([]*models.User, len(others))
+	for i, id := range others {
+		user, err := s.findUser(ctx, id, op)
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = user
+	}
+
+	ch := &models.Channel{
+		BaseEntity: models.BaseEntity{RealmID: middleware.MustRealmID(ctx)},
+		Type:       models.ChannelTypeGroupDM,
+		Name:       strings.TrimSpace(name),
+	}
+	if len(targets) == 1 {
+		key := dmKey(callerUUID, targets[0].ID)
+		existing, err := s.channels.FindDMByKey(ctx, key)
+		if err == nil {
+			return s.getDM(ctx, existing.ID.String(), callerID, op)
+		}
+		if !errors.Is(err, errors.ErrChannelNotFound) {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		ch.Type, ch.DMKey = models.ChannelTypeDM, &key
+	} else {
+		ch.OwnerID = &callerUUID
+	}
+
+	for _, target := range targets {
+		if err := s.checkDMPolicy(ctx, callerID, target, op); err != nil {
+			return nil, err
+		}
+	}
+
+	// Порядок вступления задаёт очередь передачи владения group_dm
+	now := time.Now()
+	ch.Recipients = append(ch.Recipients, models.ChannelRecipient{RealmID: ch.RealmID, UserID: callerUUID, JoinedAt: now})
+	for i, target := range targets {
+		ch.Recipients = append(ch.Recipients, models.ChannelRecipient{
+			RealmID:  ch.RealmID,
+			UserID:   target.ID,
+			JoinedAt: now.Add(time.Duration(i+1) * time.Microsecond),
+		})
+	}
+
+	if err := s.channels.CreateDM(ctx, ch); err != nil {
+		if ch.Type == models.ChannelTypeDM && errors.Is(err, errors.ErrConflict) {
+			// Собеседник открыл тот же dm одновременно с нами
+			existing, findErr := s.channels.FindDMByKey(ctx, *ch.DMKey)
+			if findErr == nil {
+				return s.getDM(ctx, existing.ID.String(), callerID, op)
+			}
+		}
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	ch, err := s.getDM(ctx, ch.ID.String(), callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	s.publishDM(ch)
+	return ch, nil
+}
+
+// ListMyDMs возвращает личные каналы вызывающего, недавно активные сверху.
+func (s *DMService) ListMyDMs(ctx context.Context, callerID string) ([]models.Channel, error) {
+	const op = "DMService.ListMyDMs"
+
+	channels, err := s.channels.ListDMs(ctx, callerID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return channels, nil
+}
+
+// getGroupDM загружает group_dm вызывающего; у dm состав и название не меняются.
+func (s *DMService) getGroupDM(ctx context.Context, channelID, callerID, op string) (*models.Channel, error) {
+	ch, err := s.getDM(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if ch.Type != models.ChannelTypeGroupDM {
+		return nil, errors.ValidationError("channel_id", "Only group DMs can be changed").WithOp(op)
+	}
+	return ch, nil
+}
+
+// AddRecipient добавляет userID в group_dm. Добавлять может любой участник,
+// но с учётом настроек приватности добавляемого.
+func (s *DMService) AddRecipient(ctx context.Context, channelID, callerID, userID string) (*models.Channel, error) {
+	const op = "DMService.AddRecipient"
+
+	ch, err := s.getGroupDM(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if len(ch.Recipients) >= maxGroupDMRecipients {
+		return nil, errors.LimitReached("group_dm_recipients", maxGroupDMRecipients).WithOp(op)
+	}
+	target, err := s.findUser(ctx, userID, op)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkDMPolicy(ctx, callerID, target, op); err != nil {
+		return nil, err
+	}
+
+	err = s.channels.AddRecipient(ctx, &models.ChannelRecipient{
+		RealmID:   ch.RealmID,
+		ChannelID: ch.ID,
+		UserID:    target.ID,
+		JoinedAt:  time.Now(),
+	})
+	if err != nil && !errors.Is(err, errors.ErrConflict) {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	if ch, err = s.getDM(ctx, channelID, callerID, op); err != nil {
+		return nil, err
+	}
+	s.publishDM(ch)
+	return ch, nil
+}
+
+// RemoveRecipient исключает userID из group_dm. Выйти может любой, исключать
+// других — только владелец. Уходящего владельца сменяет самый давний участник,
+// а канал без участников удаляется.
+func (s *DMService) RemoveRecipient(ctx context.Context, channelID, callerID, userID string) error {
+	const op = "DMService.RemoveRecipient"
+
+	ch, err := s.getGroupDM(ctx, channelID, callerID, op)
+	if err != nil {
+		return err
+	}
+	isOwner := ch.OwnerID != nil && ch.OwnerID.String() == callerID
+	if userID != callerID && !isOwner {
+		return errors.ErrForbidden.WithOp(op).WithMsg("Only the group owner can remove other members")
+	}
+	if !slices.ContainsFunc(ch.Recipients, func(r models.ChannelRecipient) bool { return r.UserID.String() == userID }) {
+		return errors.ErrMemberNotFound.WithOp(op)
+	}
+
+	var remaining []uuid.UUID
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.channels.RemoveRecipient(txCtx, channelID, userID); err != nil {
+			return err
+		}
+		ids, err := s.channels.ListRecipientIDs(txCtx, channelID)
+		if err != nil {
+			return err
+		}
+		remaining = ids
+		if len(remaining) == 0 {
+			return s.channels.Delete(txCtx, channelID)
+		}
+		if ch.OwnerID != nil && ch.OwnerID.String() == userID {
+			ch.OwnerID = &remaining[0]
+			return s.channels.Update(txCtx, channelID, map[string]any{"owner_id": remaining[0]})
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+
+	s.hub.PublishUser(userID, &pb.ChatEvent{
+		Payload: &pb.ChatEvent_DmChannelRemoved{DmChannelRemoved: &pb.DMChannelRemoved{ChannelId: channelID}},
+	})
+	if len(remaining) > 0 {
+		if ch, err = s.channels.FindDM(ctx, channelID); err == nil {
+			s.publishDM(ch)
+		}
+	}
+	return nil
+}
+
+// RenameGroupDM меняет название group_dm; пустое — клиент покажет список участников.
+func (s *DMService) RenameGroupDM(ctx context.Context, channelID, callerID, name string) (*models.Channel, error) {
+	const op = "DMService.RenameGroupDM"
+
+	ch, err := s.getGroupDM(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name != "" {
+		if err := validator.ValidateChannelName(name); err != nil {
+			return nil, err.WithOp(op)
+		}
+	}
+	if name == ch.Name {
+		return ch, nil
+	}
+
+	if err := s.channels.Update(ctx, channelID, map[string]any{"name": name}); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	ch.Name = name
+	s.publishDM(ch)
+	return ch, nil
+}
+
+// publishDM рассылает актуальное состояние канала всем его участникам
+// в SubscribeUserEvents.
+func (s *DMService) publishDM(ch *models.Channel) {
+	event := &pb.ChatEvent{
+		Payload: &pb.ChatEvent_DmChannelUpdated{DmChannelUpdated: DMChannelToProto(ch)},
+	}
+	for _, r := range ch.Recipients {
+		s.hub.PublishUser(r.UserID.String(), event)
+	}
+}
+
+// DMChannelToProto конвертирует личный канал (с загруженными участниками) в proto.
+func DMChannelToProto(ch *models.Channel) *pb.DMChannel {
+	dm := &pb.DMChannel{
+		Id:        ch.ID.String(),
+		Group:     ch.Type == models.ChannelTypeGroupDM,
+		Name:      ch.Name,
+		LastSeq:   ch.NextSeq - 1,
+		CreatedAt: timestamppb.New(ch.CreatedAt),
+	}
+	if ch.OwnerID != nil {
+		dm.OwnerId = ch.OwnerID.String()
+	}
+	for _, r := range ch.Recipients {
+		dm.Recipients = append(dm.Recipients, &pb.User{
+			Id:        r.UserID.String(),
+			Username:  r.User.Username,
+			AvatarUrl: r.User.AvatarURL,
+		})
+	}
+	return dm
+}
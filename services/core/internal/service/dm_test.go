@@ -0,0 +1,160 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+func TestDMService_OpenDM(t *testing.T) {
+	e := newTestEnv(t)
+	alice, bob, carol := e.newUser(t, "alice"), e.newUser(t, "bob"), e.newUser(t, "carol")
+
+	t.Run("one to one dm is reused", func(t *testing.T) {
+		dm, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob, alice}, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if dm.Type != models.ChannelTypeDM || len(dm.Recipients) != 2 {
+			t.Errorf("expected a dm with 2 recipients, got %s with %d", dm.Type, len(dm.Recipients))
+		}
+		again, err := e.dm.OpenDM(e.ctx(bob), bob, []string{alice}, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if again.ID != dm.ID {
+			t.Errorf("expected the same dm %s, got %s", dm.ID, again.ID)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		if _, err := e.dm.OpenDM(e.ctx(alice), alice, []string{alice}, ""); !hasCode(err, errors.CodeBadRequest) {
+			t.Errorf("expected a validation error without other users, got %v", err)
+		}
+		if _, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob}, "chat"); !hasCode(err, errors.CodeBadRequest) {
+			t.Errorf("expected a validation error for a named dm, got %v", err)
+		}
+	})
+
+	t.Run("group dm", func(t *testing.T) {
+		group, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob, carol}, "trio")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if group.Type != models.ChannelTypeGroupDM || group.Name != "trio" || len(group.Recipients) != 3 {
+			t.Errorf("unexpected group: type=%s name=%q recipients=%d", group.Type, group.Name, len(group.Recipients))
+		}
+		if group.OwnerID == nil || group.OwnerID.String() != alice {
+			t.Errorf("expected alice to own the group, got %v", group.OwnerID)
+		}
+	})
+
+	t.Run("dm policy", func(t *testing.T) {
+		if err := e.db.Model(&models.User{}).Where("id = ?", carol).Update("dm_policy", models.DMPolicyGuildMembers).Error; err != nil {
+			t.Fatalf("failed to set dm policy: %v", err)
+		}
+		if _, err := e.dm.OpenDM(e.ctx(bob), bob, []string{carol}, ""); !hasCode(err, errors.CodeDMNotAllowed) {
+			t.Errorf("expected DM_NOT_ALLOWED without a shared guild, got %v", err)
+		}
+		guild, _ := e.newGuild(t, carol, "LAN")
+		e.join(t, guild, bob)
+		if _, err := e.dm.OpenDM(e.ctx(bob), bob, []string{carol}, ""); err != nil {
+			t.Errorf("expected a shared guild to allow the dm, got %v", err)
+		}
+	})
+}
+
+func TestDMService_AddRecipient(t *testing.T) {
+	e := newTestEnv(t)
+	alice, bob, carol, dave := e.newUser(t, "alice"), e.newUser(t, "bob"), e.newUser(t, "carol"), e.newUser(t, "dave")
+
+	group, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob, carol}, "")
+	if err != nil {
+		t.Fatalf("failed to open group: %v", err)
+	}
+	groupID := group.ID.String()
+
+	t.Run("any recipient can add", func(t *testing.T) {
+		got, err := e.dm.AddRecipient(e.ctx(bob), groupID, bob, dave)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(got.Recipients) != 4 {
+			t.Errorf("expected 4 recipients, got %d", len(got.Recipients))
+		}
+		// Повторное добавление ничего не меняет
+		if got, err = e.dm.AddRecipient(e.ctx(bob), groupID, bob, dave); err != nil || len(got.Recipients) != 4 {
+			t.Errorf("expected re-adding to be a no-op, got %v with %d recipients", err, len(got.Recipients))
+		}
+	})
+
+	t.Run("outsiders and one to one dms", func(t *testing.T) {
+		erin := e.newUser(t, "erin")
+		// Чужой личный канал для постороннего не существует
+		if _, err := e.dm.AddRecipient(e.ctx(erin), groupID, erin, erin); !hasCode(err, errors.CodeChannelNotFound) {
+			t.Errorf("expected CHANNEL_NOT_FOUND for a non-recipient, got %v", err)
+		}
+		dm, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob}, "")
+		if err != nil {
+			t.Fatalf("failed to open dm: %v", err)
+		}
+		if _, err := e.dm.AddRecipient(e.ctx(alice), dm.ID.String(), alice, carol); !hasCode(err, errors.CodeBadRequest) {
+			t.Errorf("expected a validation error for a one to one dm, got %v", err)
+		}
+	})
+
+	t.Run("blocked users cannot be added", func(t *testing.T) {
+		frank := e.newUser(t, "frank")
+		if _, err := e.rels.BlockUser(e.ctx(frank), frank, alice); err != nil {
+			t.Fatalf("failed to block: %v", err)
+		}
+		if _, err := e.dm.AddRecipient(e.ctx(alice), groupID, alice, frank); !hasCode(err, errors.CodeDMNotAllowed) {
+			t.Errorf("expected DM_NOT_ALLOWED, got %v", err)
+		}
+	})
+
+	t.Run("recipient limit", func(t *testing.T) {
+		for i := 4; i < maxGroupDMRecipients; i++ {
+			extra := e.newUser(t, fmt.Sprintf("extra%d", i))
+			if _, err := e.dm.AddRecipient(e.ctx(alice), groupID, alice, extra); err != nil {
+				t.Fatalf("failed to add recipient %d: %v", i, err)
+			}
+		}
+		last := e.newUser(t, "last")
+		_, err := e.dm.AddRecipient(e.ctx(alice), groupID, alice, last)
+		if err == nil || errors.AsAppError(err).Code != "MAX_group_dm_recipients_REACHED" {
+			t.Errorf("expected the recipient limit, got %v", err)
+		}
+	})
+}
+
+func TestChatService_DeleteInDM(t *testing.T) {
+	e := newTestEnv(t)
+	alice, bob := e.newUser(t, "alice"), e.newUser(t, "bob")
+	dm, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob}, "")
+	if err != nil {
+		t.Fatalf("failed to open dm: %v", err)
+	}
+	msg, err := e.chat.SendMessage(e.ctx(alice), SendMessageParams{ChannelID: dm.ID.String(), AuthorID: alice, Content: "hi"})
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+
+	// В личке нет модерации: чужое не удалить ни по одному, ни пачкой
+	if err := e.chat.DeleteMessage(e.ctx(bob), msg.ID.String(), bob, ""); !hasCode(err, errors.CodePermMissing) {
+		t.Errorf("expected %s for someone else's message, got %v", errors.CodePermMissing, err)
+	}
+	_, err = e.chat.BulkDeleteMessages(e.ctx(bob), dm.ID.String(), bob, BulkDelete{MessageIDs: []string{msg.ID.String()}})
+	if !hasCode(err, errors.CodePermMissing) {
+		t.Errorf("expected %s for bulk delete, got %v", errors.CodePermMissing, err)
+	}
+
+	if err := e.chat.DeleteMessage(e.ctx(alice), msg.ID.String(), alice, ""); err != nil {
+		t.Fatalf("failed to delete own message: %v", err)
+	}
+	if got := e.history(t, dm.ID.String()); len(got) != 0 {
+		t.Errorf("expected empty dm history, got %d messages", len(got))
+	}
+}
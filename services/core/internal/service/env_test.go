@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/config"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/infra/cache"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// testEnv — сервисы поверх SQLite в памяти, собранные как в app.initServices
+// (без превью ссылок).
+type testEnv struct {
+	db      *gorm.DB
+	repos   *repository.Registry
+	hub     *hub.Hub
+	realmID uuid.UUID
+
+	users  *UserService
+	guilds *GuildService
+	chat   *ChatService
+	thread *ThreadService
+	dm     *DMService
+	rels   *RelationshipService
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	// Каждому тесту — своя база: имя из t.Name(), общий кэш для всех соединений пула
+	dsn := "file:" + strings.ReplaceAll(t.Name(), "/", "_") + "?mode=memory&cache=shared"
+	cfg := &config.Config{Env: "production", DBDriver: "sqlite", DBSQLitePath: dsn}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	db, err := database.Connect(cfg, log)
+	if err != nil {
+		t.Fatalf("failed to open test db: %v", err)
+	}
+	t.Cleanup(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+	provider, err := cache.NewProvider(cfg)
+	if err != nil {
+		t.Fatalf("failed to create cache provider: %v", err)
+	}
+
+	repos := repository.NewRegistry(db)
+	tm := database.NewTransactionManager(db)
+	chatHub := hub.New()
+	users := NewUserService(repos.Users, provider)
+	system := NewSystemMessenger(repos.Messages, chatHub)
+
+	return &testEnv{
+		db:      db,
+		repos:   repos,
+		hub:     chatHub,
+		realmID: uuid.New(),
+		users:   users,
+		guilds:  NewGuildService(repos.Guilds, repos.Channels, tm, chatHub, system),
+		chat:    NewChatService(repos.Messages, repos.Channels, repos.Guilds, repos.AuditLogs, repos.ReadStates, repos.Relations, repos.Scheduled, users, system, nil, tm, chatHub),
+		thread:  NewThreadService(repos.Channels, repos.Messages, repos.Guilds, tm, chatHub),
+		dm:      NewDMService(repos.Channels, repos.Guilds, repos.Users, repos.Relations, tm, chatHub),
+		rels:    NewRelationshipService(repos.Relations, repos.Users, tm, chatHub),
+	}
+}
+
+// ctx — контекст запроса пользователя userID, как после middleware.UnaryAuth.
+func (e *testEnv) ctx(userID string) context.Context {
+	ctx := context.WithValue(context.Background(), middleware.ContextKeyRealmID, e.realmID.String())
+	return context.WithValue(ctx, middleware.ContextKeyUserID, userID)
+}
+
+// newUser создаёт пользователя и возвращает его ID.
+func (e *testEnv) newUser(t *testing.T, username string) string {
+	t.Helper()
+	user := &models.User{
+		BaseEntity: models.BaseEntity{RealmID: e.realmID},
+		Username:   username,
+		// Явное значение: default '{}' SQLite вернёт строкой, а её не сканировать в RawMessage
+		ClientSettings: json.RawMessage(`{}`),
+	}
+	if err := e.repos.Users.Create(context.Background(), user); err != nil {
+		t.Fatalf("failed to create user %s: %v", username, err)
+	}
+	return user.ID.String()
+}
+
+// newGuild создаёт гильдию ownerID и возвращает её вместе с каналом #general.
+func (e *testEnv) newGuild(t *testing.T, ownerID, name string) (*models.Guild, string) {
+	t.Helper()
+	guild, err := e.guilds.CreateGuild(e.ctx(ownerID), ownerID, name, "")
+	if err != nil {
+		t.Fatalf("failed to create guild: %v", err)
+	}
+	return guild, guild.SystemChannelID.String()
+}
+
+// join добавляет userID в гильдию через одноразовый инвайт.
+func (e *testEnv) join(t *testing.T, guild *models.Guild, userID string) {
+	t.Helper()
+	inv, err := e.guilds.CreateInvite(e.ctx(guild.OwnerID.String()), guild.ID.String(), guild.OwnerID.String(), 1, 0)
+	if err != nil {
+		t.Fatalf("failed to create invite: %v", err)
+	}
+	if _, err := e.guilds.JoinByInvite(e.ctx(userID), inv.Code, userID); err != nil {
+		t.Fatalf("failed to join guild: %v", err)
+	}
+}
+
+// history возвращает все живые сообщения канала, старые сверху.
+func (e *testEnv) history(t *testing.T, channelID string) []models.Message {
+	t.Helper()
+	msgs, err := e.repos.Messages.GetHistory(context.Background(), repository.HistoryQuery{ChannelID: channelID, Limit: 100})
+	if err != nil {
+		t.Fatalf("failed to load history: %v", err)
+	}
+	return msgs
+}
+
+// hasCode сообщает, что err — AppError с кодом code. errors.Is тут не
+// подходит: WithOp возвращает копию сентинела.
+func hasCode(err error, code errors.ErrorCode) bool {
+	return err != nil && errors.AsAppError(err).Code == code
+}
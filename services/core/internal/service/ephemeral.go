@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// SendEphemeralParams — сообщение, которое увидит только один участник канала.
+type SendEphemeralParams struct {
+	ChannelID    string
+	AuthorID     string
+	TargetUserID string
+	Content      string
+	Markdown     bool
+}
+
+// SendEphemeral показывает сообщение одному участнику канала. Оно не пишется
+// в историю и не получает Seq: после переподключения клиента его уже нет.
+// Если адресат сейчас не подписан на канал, сообщение некуда доставить —
+// ErrRecipientOffline. Адресат должен видеть канал. Требует MANAGE_MESSAGES —
+// это инструмент ботов и модерации, не личка.
+func (s *ChatService) SendEphemeral(ctx context.Context, p SendEphemeralParams) (*models.Message, error) {
+	const op = "ChatService.SendEphemeral"
+
+	contentType := models.MessageContentTypeText
+	if p.Markdown {
+		contentType = models.MessageContentTypeMarkdown
+	}
+	if err := validateContent(p.Content, contentType); err != nil {
+		return nil, err.WithOp(op)
+	}
+	if _, err := uuid.Parse(p.TargetUserID); err != nil {
+		return nil, errors.ValidationError("user_id", "Must be a valid user ID").WithOp(op)
+	}
+
+	ch, member, err := s.getAccessibleChannel(ctx, p.ChannelID, p.AuthorID, op)
+	if err != nil {
+		return nil, err
+	}
+	if !ch.IsTextBased() {
+		return nil, errors.ValidationError("channel_id", "This channel does not support text messages").WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermManageMessages) {
+		return nil, errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+	target, err := s.guilds.FindMember(ctx, ch.GuildIDString(), p.TargetUserID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op).WithMeta("user_id", p.TargetUserID)
+	}
+	visible, err := s.canViewChannel(ctx, ch, target)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !visible {
+		return nil, errors.ValidationError("user_id", "The user cannot view this channel").WithOp(op)
+	}
+
+	msg, err := newEphemeralMessage(ch, member.UserID, p.Content, contentType)
+	if err != nil {
+		return nil, errors.ErrInternal.WithOp(op)
+	}
+	if authorProfile, err := s.users.GetProfile(ctx, p.AuthorID); err == nil {
+		msg.Author = *authorProfile
+	}
+	msg.AuthorMember = member
+
+	if !s.publishEphemeral(msg, p.TargetUserID) {
+		return nil, errors.ErrRecipientOffline.WithOp(op).WithMeta("user_id", p.TargetUserID)
+	}
+	return msg, nil
+}
+
+// canViewChannel — видит ли участник канал с учётом переопределений прав.
+// Ветка видна по правам своего канала.
+func (s *ChatService) canViewChannel(ctx context.Context, ch *models.Channel, member *models.GuildMember) (bool, error) {
+	if member.EffectivePermissions.IsAdmin() {
+		return true, nil
+	}
+	viewID := ch.ID
+	if ch.ParentID != nil {
+		viewID = *ch.ParentID
+	}
+
+	roleIDs, err := s.guilds.ListMemberRoleIDs(ctx, ch.GuildIDString(), member.UserID.String())
+	if err != nil {
+		return false, err
+	}
+	overwrites, err := s.channels.ListOverwrites(ctx, []uuid.UUID{viewID})
+	if err != nil {
+		return false, err
+	}
+	perms := models.ChannelPermissions(member.EffectivePermissions, viewID, member.UserID, roleIDs, overwrites)
+	return perms.Has(models.PermViewChannels), nil
+}
+
+// newEphemeralMessage собирает сообщение в памяти: ID есть, Seq нет.
+func newEphemeralMessage(ch *models.Channel, authorID uuid.UUID, content string, contentType models.MessageContentType) (*models.Message, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &models.Message{
+		BaseEntity: models.BaseEntity{
+			ID:        id,
+			RealmID:   ch.RealmID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		ChannelID:   ch.ID,
+		AuthorID:    authorID,
+		Content:     content,
+		ContentType: contentType,
+		Flags:       models.MessageFlagEphemeral,
+	}, nil
+}
+
+// publishEphemeral доставляет сообщение только в стримы канала у адресата.
+// false — ни одного такого стрима нет.
+func (s *ChatService) publishEphemeral(msg *models.Message, userID string) bool {
+	return s.hub.PublishTo(msg.ChannelID.String(), userID, &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessageCreated{
+			MessageCreated: MessageToProto(msg),
+		},
+	})
+}
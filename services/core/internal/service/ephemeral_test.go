@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestSendEphemeral_ReportsDelivery(t *testing.T) {
+	e := newTestEnv(t)
+	owner, alice := e.newUser(t, "owner"), e.newUser(t, "alice")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, alice)
+
+	params := SendEphemeralParams{ChannelID: general, AuthorID: owner, TargetUserID: alice, Content: "only for you"}
+	if _, err := e.chat.SendEphemeral(e.ctx(owner), params); !hasCode(err, errors.CodeRecipientOffline) {
+		t.Errorf("expected RECIPIENT_NOT_CONNECTED while alice is offline, got %v", err)
+	}
+
+	events, unsubscribe := e.hub.Subscribe(general, alice)
+	defer unsubscribe()
+	msg, err := e.chat.SendEphemeral(e.ctx(owner), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	select {
+	case ev := <-events:
+		if ev.GetMessageCreated().GetId() != msg.ID.String() {
+			t.Errorf("expected the ephemeral message, got %v", ev)
+		}
+	default:
+		t.Error("expected alice to receive the message")
+	}
+}
+
+func TestSendEphemeral_HiddenChannel(t *testing.T) {
+	e := newTestEnv(t)
+	owner, alice := e.newUser(t, "owner"), e.newUser(t, "alice")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, alice)
+
+	deny := &models.ChannelPermissionOverwrite{
+		RealmID: e.realmID, ChannelID: uuid.MustParse(general),
+		TargetType: models.TargetTypeUser, TargetID: uuid.MustParse(alice),
+		Deny: models.PermViewChannels,
+	}
+	if err := e.db.Create(deny).Error; err != nil {
+		t.Fatalf("failed to create overwrite: %v", err)
+	}
+
+	// Подписка есть, но канал от alice скрыт — доставлять нельзя
+	_, unsubscribe := e.hub.Subscribe(general, alice)
+	defer unsubscribe()
+	params := SendEphemeralParams{ChannelID: general, AuthorID: owner, TargetUserID: alice, Content: "only for you"}
+	if _, err := e.chat.SendEphemeral(e.ctx(owner), params); !hasCode(err, errors.CodeBadRequest) {
+		t.Errorf("expected validation error for a hidden channel, got %v", err)
+	}
+}
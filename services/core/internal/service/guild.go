@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"time"
 
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
@@ -13,6 +14,7 @@ import (
 	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
 	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/validator"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GuildService struct {
@@ -20,10 +22,17 @@ type GuildService struct {
 	channels repository.ChannelRepository
 	tm       database.TransactionManager
 	hub      *hub.Hub
+	system   *SystemMessenger
 }
 
-func NewGuildService(guilds repository.GuildRepository, channels repository.ChannelRepository, tm database.TransactionManager, hub *hub.Hub) *GuildService {
-	return &GuildService{guilds: guilds, channels: channels, tm: tm, hub: hub}
+func NewGuildService(
+	guilds repository.GuildRepository,
+	channels repository.ChannelRepository,
+	tm database.TransactionManager,
+	hub *hub.Hub,
+	system *SystemMessenger,
+) *GuildService {
+	return &GuildService{guilds: guilds, channels: channels, tm: tm, hub: hub, system: system}
 }
 
 // Палитра (Tailwind Colors 600)
@@ -55,6 +64,34 @@ func (s *GuildService) getOwnedGuild(ctx context.Context, guildID, userID string
 	return guild, nil
 }
 
+// requirePermission проверяет, что участник гильдии обладает правом perm.
+// permName попадает в метаданные ошибки (например "MANAGE_GUILD").
+func (s *GuildService) requirePermission(ctx context.Context, guildID, userID string, perm models.GuildPermission, permName string) (*models.GuildMember, error) {
+	member, err := s.guilds.FindMember(ctx, guildID, userID)
+	if err != nil {
+		if errors.Is(err, errors.ErrMemberNotFound) {
+			return nil, errors.ErrForbidden.WithMeta("guild_id", guildID).WithMsg("You are not a member of this guild")
+		}
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, "GuildService.requirePermission")
+	}
+	if !member.EffectivePermissions.Can(perm) {
+		return nil, errors.PermissionError(permName, guildID)
+	}
+	return member, nil
+}
+
+// publishGuildEvent рассылает событие во все каналы гильдии,
+// чтобы его получили все подключённые участники.
+func (s *GuildService) publishGuildEvent(ctx context.Context, guildID string, event *pb.ChatEvent) {
+	channels, err := s.channels.ListByGuild(ctx, guildID)
+	if err != nil {
+		return // событие не критично, клиент подтянет состояние при следующем запросе
+	}
+	for i := range channels {
+		s.hub.Publish(channels[i].ID.String(), event)
+	}
+}
+
 func (s *GuildService) CreateGuild(ctx context.Context, ownerID, name, description string) (*models.Guild, error) {
 	const op = "GuildService.CreateGuild"
 
@@ -68,16 +105,23 @@ func (s *GuildService) CreateGuild(ctx context.Context, ownerID, name, descripti
 	// Выбираем случайный цвет для отображения на клиенте
 	color := guildColors[rand.Intn(len(guildColors))]
 
+	// ID #general известен заранее, чтобы сразу сделать его системным каналом
+	generalID, err := uuid.NewV7()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrInternal, op)
+	}
+
 	guild := &models.Guild{
-		BaseEntity:  models.BaseEntity{RealmID: realmID},
-		Name:        name,
-		Description: description,
-		OwnerID:     ownerUUID,
-		Color:       color,
-		InviteCode:  nil,
+		BaseEntity:      models.BaseEntity{RealmID: realmID},
+		Name:            name,
+		Description:     description,
+		OwnerID:         ownerUUID,
+		Color:           color,
+		InviteCode:      nil,
+		SystemChannelID: &generalID,
 	}
 
-	err := s.tm.Do(ctx, func(txCtx context.Context) error {
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
 		if err := s.guilds.Create(txCtx, guild); err != nil {
 			return errors.AsAppError(err).WithOp(op).WithMsg("Failed to save guild")
 		}
@@ -95,8 +139,8 @@ func (s *GuildService) CreateGuild(ctx context.Context, ownerID, name, descripti
 
 		// Создать дефолтный канал #general
 		ch := &models.Channel{
-			BaseEntity: models.BaseEntity{RealmID: realmID},
-			GuildID:    guild.ID,
+			BaseEntity: models.BaseEntity{ID: generalID, RealmID: realmID},
+			GuildID:    &guild.ID,
 			Name:       "general",
 			Type:       models.ChannelTypeText,
 		}
@@ -118,6 +162,173 @@ func (s *GuildService) GetGuild(ctx context.Context, guildID, callerID string) (
 	return s.guilds.FindByID(ctx, guildID)
 }
 
+// GuildUpdate — изменяемые поля гильдии. nil означает "не менять".
+// Для ссылок на каналы пустая строка сбрасывает значение.
+type GuildUpdate struct {
+	Name        *string
+	Description *string
+	IconURL     *string
+	BannerURL   *string
+	SplashURL   *string
+	Color       *string
+
+	SystemChannelID *string
+	RulesChannelID  *string
+	AFKChannelID    *string
+	AFKTimeout      *int
+}
+
+// Допустимые значения AFK таймаута (секунды)
+var afkTimeouts = map[int]struct{}{60: {}, 300: {}, 900: {}, 1800: {}, 3600: {}}
+
+// UpdateGuild применяет частичное обновление гильдии.
+// version — версия, которую видел клиент (0 = текущая). Если гильдию успели
+// изменить, возвращается errors.ErrVersionConflict.
+func (s *GuildService) UpdateGuild(ctx context.Context, guildID, callerID string, version uint, upd GuildUpdate) (*models.Guild, error) {
+	const op = "GuildService.UpdateGuild"
+
+	guild, err := s.guilds.FindByID(ctx, guildID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if _, err := s.requirePermission(ctx, guildID, callerID, models.PermManageGuild, "MANAGE_GUILD"); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	if version == 0 {
+		version = guild.Version
+	}
+	if version != guild.Version {
+		return nil, errors.ErrVersionConflict.WithOp(op).
+			WithMeta("current_version", guild.Version).
+			WithRemedy("Someone else has just changed these settings. Reload the guild and try again.")
+	}
+
+	fields, err := s.guildUpdateFields(ctx, guild, upd)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if len(fields) == 0 {
+		return guild, nil
+	}
+	callerUUID := uuid.MustParse(callerID)
+	fields["updated_by"] = callerUUID
+
+	if err := s.guilds.UpdateVersioned(ctx, guildID, version, fields); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	updated, err := s.guilds.FindByID(ctx, guildID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	s.publishGuildEvent(ctx, guildID, &pb.ChatEvent{
+		Payload: &pb.ChatEvent_GuildUpdated{GuildUpdated: GuildToProto(updated, 0)},
+	})
+
+	return updated, nil
+}
+
+// guildUpdateFields валидирует GuildUpdate и собирает map для репозитория.
+func (s *GuildService) guildUpdateFields(ctx context.Context, guild *models.Guild, upd GuildUpdate) (map[string]any, error) {
+	fields := make(map[string]any)
+
+	if upd.Name != nil {
+		if err := validator.ValidateGuildName(*upd.Name); err != nil {
+			return nil, err
+		}
+		fields["name"] = *upd.Name
+	}
+	if upd.Description != nil {
+		if err := validator.ValidateGuildDescription(*upd.Description); err != nil {
+			return nil, err
+		}
+		fields["description"] = *upd.Description
+	}
+	for _, media := range []struct {
+		column string
+		value  *string
+	}{
+		{"icon_url", upd.IconURL},
+		{"banner_url", upd.BannerURL},
+		{"splash_url", upd.SplashURL},
+	} {
+		if media.value == nil {
+			continue
+		}
+		if err := validator.ValidateMediaURL(media.column, *media.value); err != nil {
+			return nil, err
+		}
+		fields[media.column] = *media.value
+	}
+	if upd.Color != nil {
+		if err := validator.ValidateHexColor("color", *upd.Color); err != nil {
+			return nil, err
+		}
+		fields["color"] = *upd.Color
+	}
+
+	textTypes := []models.ChannelType{models.ChannelTypeText, models.ChannelTypeAnnouncement}
+	for _, ref := range []struct {
+		column string
+		value  *string
+		types  []models.ChannelType
+	}{
+		{"system_channel_id", upd.SystemChannelID, textTypes},
+		{"rules_channel_id", upd.RulesChannelID, textTypes},
+		{"afk_channel_id", upd.AFKChannelID, []models.ChannelType{models.ChannelTypeVoice}},
+	} {
+		if ref.value == nil {
+			continue
+		}
+		channelID, err := s.resolveGuildChannel(ctx, guild.ID, ref.column, *ref.value, ref.types)
+		if err != nil {
+			return nil, err
+		}
+		fields[ref.column] = channelID
+	}
+
+	if upd.AFKTimeout != nil {
+		if _, ok := afkTimeouts[*upd.AFKTimeout]; !ok {
+			return nil, errors.ValidationError("afk_timeout", "Must be one of 60, 300, 900, 1800, 3600 seconds")
+		}
+		fields["afk_timeout"] = *upd.AFKTimeout
+	}
+
+	return fields, nil
+}
+
+// resolveGuildChannel проверяет, что канал принадлежит гильдии и имеет подходящий тип.
+// Пустая строка означает сброс ссылки (nil).
+func (s *GuildService) resolveGuildChannel(ctx context.Context, guildID uuid.UUID, field, raw string, types []models.ChannelType) (*uuid.UUID, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	channelID, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, errors.ValidationError(field, "Must be a valid channel ID")
+	}
+
+	ch, err := s.channels.FindByID(ctx, raw)
+	if err != nil {
+		if errors.Is(err, errors.ErrChannelNotFound) {
+			return nil, errors.ValidationError(field, "Channel not found")
+		}
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, "GuildService.resolveGuildChannel")
+	}
+	if ch.GuildID == nil || *ch.GuildID != guildID {
+		return nil, errors.ValidationError(field, "Channel does not belong to this guild")
+	}
+	for _, t := range types {
+		if ch.Type == t {
+			return &channelID, nil
+		}
+	}
+	return nil, errors.ValidationError(field, "Channel type is not allowed here").
+		WithMeta("channel_type", ch.Type)
+}
+
 func (s *GuildService) ListMyGuilds(ctx context.Context, userID string) ([]models.Guild, error) {
 	return s.guilds.ListByMember(ctx, userID)
 }
@@ -172,9 +383,10 @@ func (s *GuildService) JoinByInvite(ctx context.Context, code, userID string) (*
 
 	err = s.tm.Do(ctx, func(txCtx context.Context) error {
 		if err := s.guilds.AddMember(txCtx, &models.GuildMember{
-			RealmID: inv.RealmID,
-			GuildID: inv.GuildID,
-			UserID:  uuid.MustParse(userID),
+			RealmID:              inv.RealmID,
+			GuildID:              inv.GuildID,
+			UserID:               uuid.MustParse(userID),
+			EffectivePermissions: models.DefaultGuildPermissions,
 		}); err != nil {
 			return errors.AsAppError(err).WithOp(op)
 		}
@@ -185,7 +397,15 @@ func (s *GuildService) JoinByInvite(ctx context.Context, code, userID string) (*
 		return nil, err
 	}
 
-	return s.guilds.FindByID(ctx, inv.GuildID.String())
+	guild, err := s.guilds.FindByID(ctx, inv.GuildID.String())
+	if err != nil {
+		return nil, err
+	}
+	s.system.PostToGuild(ctx, guild, models.SystemPayload{
+		Type:    models.SystemMessageMemberJoin,
+		ActorID: userID,
+	})
+	return guild, nil
 }
 
 func (s *GuildService) LeaveGuild(ctx context.Context, guildID, userID string) error {
@@ -200,7 +420,40 @@ func (s *GuildService) LeaveGuild(ctx context.Context, guildID, userID string) e
 			WithRemedy("Transfer ownership or delete the guild instead.")
 	}
 
-	return s.guilds.RemoveMember(ctx, guildID, userID)
+	if err := s.guilds.RemoveMember(ctx, guildID, userID); err != nil {
+		return err
+	}
+	s.system.PostToGuild(ctx, guild, models.SystemPayload{
+		Type:    models.SystemMessageMemberLeave,
+		ActorID: userID,
+	})
+	return nil
+}
+
+// KickMember исключает участника из гильдии. Требует KICK_MEMBERS.
+func (s *GuildService) KickMember(ctx context.Context, guildID, callerID, targetID string) error {
+	const op = "GuildService.KickMember"
+
+	if callerID == targetID {
+		return errors.ValidationError("user_id", "Use LeaveGuild to leave the guild yourself").WithOp(op)
+	}
+	if _, err := s.requireModerator(ctx, guildID, callerID, targetID, models.PermKickMembers, "KICK_MEMBERS"); err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+
+	guild, err := s.guilds.FindByID(ctx, guildID)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	if err := s.guilds.RemoveMember(ctx, guildID, targetID); err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	s.system.PostToGuild(ctx, guild, models.SystemPayload{
+		Type:     models.SystemMessageMemberKick,
+		ActorID:  callerID,
+		TargetID: targetID,
+	})
+	return nil
 }
 
 func (s *GuildService) CreateChannel(ctx context.Context, guildID, callerID, name string, chType models.ChannelType) (*models.Channel, error) {
@@ -216,7 +469,7 @@ func (s *GuildService) CreateChannel(ctx context.Context, guildID, callerID, nam
 
 	ch := &models.Channel{
 		BaseEntity: models.BaseEntity{RealmID: guild.RealmID},
-		GuildID:    guild.ID,
+		GuildID:    &guild.ID,
 		Name:       name,
 		Type:       chType,
 	}
@@ -231,10 +484,20 @@ func (s *GuildService) DeleteChannel(ctx context.Context, channelID, callerID st
 	if err != nil {
 		return err
 	}
-	if _, err := s.getOwnedGuild(ctx, ch.GuildID.String(), callerID); err != nil {
+	if ch.IsDM() {
+		return errors.ErrChannelNotFound
+	}
+	if _, err := s.getOwnedGuild(ctx, ch.GuildIDString(), callerID); err != nil {
 		return err
 	}
-	return s.channels.Delete(ctx, channelID)
+	return s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.channels.Delete(txCtx, channelID); err != nil {
+			return err
+		}
+		// Ветки — отдельные каналы: без этого они остались бы доступны по ID
+		_, err := s.channels.DeleteThreads(txCtx, channelID)
+		return err
+	})
 }
 
 func (s *GuildService) ListChannels(ctx context.Context, guildID, callerID string) ([]models.Channel, error) {
@@ -260,3 +523,194 @@ func (s *GuildService) ListMembers(ctx context.Context, guildID, callerID string
 
 	return members, nil
 }
+
+// MemberUpdate — изменяемые поля профиля участника в гильдии.
+// nil означает "не менять", пустая строка — сбросить к глобальному профилю.
+type MemberUpdate struct {
+	Nickname  *string
+	AvatarURL *string
+}
+
+// UpdateMyMember меняет ник/аватар вызывающего в гильдии.
+func (s *GuildService) UpdateMyMember(ctx context.Context, guildID, callerID string, upd MemberUpdate) (*models.GuildMember, error) {
+	const op = "GuildService.UpdateMyMember"
+
+	if _, err := s.requirePermission(ctx, guildID, callerID, models.PermChangeNickname, "CHANGE_NICKNAME"); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	return s.applyMemberUpdate(ctx, guildID, callerID, upd, op)
+}
+
+// UpdateMember — модераторская правка профиля другого участника.
+func (s *GuildService) UpdateMember(ctx context.Context, guildID, callerID, targetID string, upd MemberUpdate) (*models.GuildMember, error) {
+	const op = "GuildService.UpdateMember"
+
+	if _, err := s.requireModerator(ctx, guildID, callerID, targetID, models.PermManageNicknames, "MANAGE_NICKNAMES"); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	return s.applyMemberUpdate(ctx, guildID, targetID, upd, op)
+}
+
+// maxTimeout — максимальная длительность тайм-аута.
+const maxTimeout = 28 * 24 * time.Hour
+
+// TimeoutMember ставит (или снимает, если until == nil/в прошлом) тайм-аут участнику.
+// Тайм-аут истекает сам: проверки сравнивают TimeoutUntil с текущим временем.
+func (s *GuildService) TimeoutMember(ctx context.Context, guildID, callerID, targetID string, until *time.Time) (*models.GuildMember, error) {
+	const op = "GuildService.TimeoutMember"
+
+	target, err := s.requireModerator(ctx, guildID, callerID, targetID, models.PermModerateMembers, "MODERATE_MEMBERS")
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if target.EffectivePermissions.IsAdmin() {
+		return nil, errors.ErrForbidden.WithOp(op).WithMsg("Administrators cannot be timed out")
+	}
+
+	now := time.Now()
+	if until != nil && !until.After(now) {
+		until = nil // время в прошлом — снимаем тайм-аут
+	}
+	if until != nil && until.Sub(now) > maxTimeout {
+		return nil, errors.ValidationError("until", "Timeout cannot be longer than 28 days").WithOp(op)
+	}
+
+	return s.updateMemberFields(ctx, guildID, targetID, map[string]any{"timeout_until": until}, op)
+}
+
+// SetMemberVoiceState меняет серверные mute/deafen участника.
+func (s *GuildService) SetMemberVoiceState(ctx context.Context, guildID, callerID, targetID string, muted, deafened *bool) (*models.GuildMember, error) {
+	const op = "GuildService.SetMemberVoiceState"
+
+	if _, err := s.requireModerator(ctx, guildID, callerID, targetID, models.PermMuteMembers, "MUTE_MEMBERS"); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	fields := make(map[string]any)
+	if muted != nil {
+		fields["is_muted"] = *muted
+	}
+	if deafened != nil {
+		fields["is_deafened"] = *deafened
+	}
+	return s.updateMemberFields(ctx, guildID, targetID, fields, op)
+}
+
+// requireModerator проверяет право perm у вызывающего и возвращает участника-цель.
+// Владельца гильдии может трогать только сам владелец.
+func (s *GuildService) requireModerator(ctx context.Context, guildID, callerID, targetID string, perm models.GuildPermission, permName string) (*models.GuildMember, error) {
+	guild, err := s.guilds.FindByID(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requirePermission(ctx, guildID, callerID, perm, permName); err != nil {
+		return nil, err
+	}
+	targetUUID, err := uuid.Parse(targetID)
+	if err != nil {
+		return nil, errors.ValidationError("user_id", "Must be a valid user ID")
+	}
+	if guild.IsOwner(targetUUID) && !guild.IsOwner(uuid.MustParse(callerID)) {
+		return nil, errors.ErrCannotEditOwner
+	}
+
+	target, err := s.guilds.FindMember(ctx, guildID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+func (s *GuildService) applyMemberUpdate(ctx context.Context, guildID, targetID string, upd MemberUpdate, op string) (*models.GuildMember, error) {
+	fields := make(map[string]any)
+	if upd.Nickname != nil {
+		if err := validator.ValidateNickname(*upd.Nickname); err != nil {
+			return nil, err.WithOp(op)
+		}
+		fields["nickname"] = *upd.Nickname
+	}
+	if upd.AvatarURL != nil {
+		if err := validator.ValidateMediaURL("avatar_url", *upd.AvatarURL); err != nil {
+			return nil, err.WithOp(op)
+		}
+		fields["avatar_url"] = *upd.AvatarURL
+	}
+	return s.updateMemberFields(ctx, guildID, targetID, fields, op)
+}
+
+// updateMemberFields сохраняет изменения участника, перечитывает его вместе с профилем
+// и оповещает гильдию событием member_updated.
+func (s *GuildService) updateMemberFields(ctx context.Context, guildID, targetID string, fields map[string]any, op string) (*models.GuildMember, error) {
+	if len(fields) > 0 {
+		if err := s.guilds.UpdateMember(ctx, guildID, targetID, fields); err != nil {
+			return nil, errors.AsAppError(err).WithOp(op)
+		}
+	}
+
+	members, err := s.guilds.ListMembersByUserIDs(ctx, guildID, []string{targetID})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if len(members) == 0 {
+		return nil, errors.ErrMemberNotFound.WithOp(op)
+	}
+	member := &members[0]
+	member.IsOnline = s.hub.IsOnline(targetID)
+
+	if len(fields) > 0 {
+		s.publishGuildEvent(ctx, guildID, &pb.ChatEvent{
+			Payload: &pb.ChatEvent_MemberUpdated{MemberUpdated: MemberToProto(member)},
+		})
+	}
+	return member, nil
+}
+
+// GuildToProto конвертирует models.Guild в proto.
+func GuildToProto(g *models.Guild, memberCount int32) *pb.Guild {
+	guild := &pb.Guild{
+		Id:          g.ID.String(),
+		Name:        g.Name,
+		Description: g.Description,
+		IconUrl:     g.IconURL,
+		BannerUrl:   g.BannerURL,
+		SplashUrl:   g.SplashURL,
+		Color:       g.Color,
+		OwnerId:     g.OwnerID.String(),
+		MemberCount: memberCount,
+		CreatedAt:   timestamppb.New(g.CreatedAt),
+		AfkTimeout:  int32(g.AFKTimeout),
+		Version:     uint32(g.Version),
+	}
+	if g.SystemChannelID != nil {
+		guild.SystemChannelId = g.SystemChannelID.String()
+	}
+	if g.RulesChannelID != nil {
+		guild.RulesChannelId = g.RulesChannelID.String()
+	}
+	if g.AFKChannelID != nil {
+		guild.AfkChannelId = g.AFKChannelID.String()
+	}
+	return guild
+}
+
+// MemberToProto конвертирует models.GuildMember (с загруженным User) в proto.
+func MemberToProto(m *models.GuildMember) *pb.Member {
+	now := time.Now()
+	member := &pb.Member{
+		GuildId:        m.GuildID.String(),
+		UserId:         m.UserID.String(),
+		Username:       m.User.Username,
+		AvatarUrl:      m.User.AvatarURL,
+		Nickname:       m.Nickname,
+		GuildAvatarUrl: m.AvatarURL,
+		IsOnline:       m.IsOnline,
+		JoinedAt:       timestamppb.New(m.JoinedAt),
+		IsMuted:        m.IsMuted,
+		IsDeafened:     m.IsDeafened,
+		CanSpeak:       m.CanSpeak(now),
+	}
+	if m.IsTimedOut(now) {
+		member.TimeoutUntil = timestamppb.New(*m.TimeoutUntil)
+	}
+	return member
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+func TestGuildService_UpdateGuild(t *testing.T) {
+	e := newTestEnv(t)
+	owner, member := e.newUser(t, "owner"), e.newUser(t, "member")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, member)
+	other, otherGeneral := e.newGuild(t, owner, "Other")
+	guildID := guild.ID.String()
+	str := func(s string) *string { return &s }
+
+	t.Run("publishes guild_updated", func(t *testing.T) {
+		events, unsubscribe := e.hub.Subscribe(general, member)
+		defer unsubscribe()
+
+		updated, err := e.guilds.UpdateGuild(e.ctx(owner), guildID, owner, guild.Version, GuildUpdate{Name: str("LAN Party"), Color: str("#FF8800")})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if updated.Name != "LAN Party" || updated.Version != guild.Version+1 {
+			t.Errorf("unexpected guild: name=%q version=%d", updated.Name, updated.Version)
+		}
+		select {
+		case ev := <-events:
+			if got := ev.GetGuildUpdated(); got.GetName() != "LAN Party" || got.GetId() != guildID {
+				t.Errorf("expected guild_updated for %s, got %v", guildID, ev)
+			}
+		default:
+			t.Error("expected guild_updated in the guild channels")
+		}
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		_, err := e.guilds.UpdateGuild(e.ctx(owner), guildID, owner, guild.Version, GuildUpdate{Name: str("Stale")})
+		if !hasCode(err, errors.CodeConflict) {
+			t.Fatalf("expected version conflict, got %v", err)
+		}
+		if got := errors.AsAppError(err).Meta["current_version"]; got != guild.Version+1 {
+			t.Errorf("expected current_version %d, got %v", guild.Version+1, got)
+		}
+	})
+
+	t.Run("channel of another guild", func(t *testing.T) {
+		voice, err := e.guilds.CreateChannel(e.ctx(owner), other.ID.String(), owner, "voice", models.ChannelTypeVoice)
+		if err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+		for name, upd := range map[string]GuildUpdate{
+			"system": {SystemChannelID: str(otherGeneral)},
+			"afk":    {AFKChannelID: str(voice.ID.String())},
+		} {
+			if _, err := e.guilds.UpdateGuild(e.ctx(owner), guildID, owner, 0, upd); !hasCode(err, errors.CodeBadRequest) {
+				t.Errorf("%s: expected validation error, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("wrong channel type", func(t *testing.T) {
+		_, err := e.guilds.UpdateGuild(e.ctx(owner), guildID, owner, 0, GuildUpdate{AFKChannelID: str(general)})
+		if !hasCode(err, errors.CodeBadRequest) {
+			t.Errorf("expected validation error for a text AFK channel, got %v", err)
+		}
+	})
+
+	t.Run("without MANAGE_GUILD", func(t *testing.T) {
+		_, err := e.guilds.UpdateGuild(e.ctx(member), guildID, member, 0, GuildUpdate{Name: str("Mine")})
+		if !hasCode(err, errors.CodePermMissing) {
+			t.Errorf("expected %s, got %v", errors.CodePermMissing, err)
+		}
+	})
+}
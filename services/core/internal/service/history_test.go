@@ -0,0 +1,57 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+func TestGetHistory_Cursors(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	_, general := e.newGuild(t, owner, "LAN")
+
+	var sent []*models.Message
+	for _, text := range []string{"one", "two", "three", "four", "five"} {
+		msg, err := e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: general, AuthorID: owner, Content: text})
+		if err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+		sent = append(sent, msg)
+	}
+	seqs := func(page *HistoryPage) []int64 {
+		out := make([]int64, len(page.Messages))
+		for i := range page.Messages {
+			out[i] = page.Messages[i].Seq
+		}
+		return out
+	}
+
+	t.Run("around the first message", func(t *testing.T) {
+		page, err := e.chat.GetHistory(e.ctx(owner), HistoryParams{
+			ChannelID: general, CallerID: owner, Limit: 4, AroundMessageID: sent[0].ID.String(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := seqs(page); !slices.Equal(got, []int64{1, 2}) || page.HasMore || !page.HasMoreAfter {
+			t.Errorf("expected [1 2] with more after, got %v more=%v after=%v", got, page.HasMore, page.HasMoreAfter)
+		}
+	})
+
+	t.Run("before a deleted message", func(t *testing.T) {
+		if err := e.chat.DeleteMessage(e.ctx(owner), sent[3].ID.String(), owner, ""); err != nil {
+			t.Fatalf("failed to delete message: %v", err)
+		}
+		page, err := e.chat.GetHistory(e.ctx(owner), HistoryParams{
+			ChannelID: general, CallerID: owner, Limit: 10, BeforeMessageID: sent[3].ID.String(),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := seqs(page); !slices.Equal(got, []int64{1, 2, 3}) {
+			t.Errorf("expected [1 2 3], got %v", got)
+		}
+	})
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// interactionTimeout — сколько нажавший ждёт ответа бота.
+const interactionTimeout = 3 * time.Second
+
+type InteractionResponseType int
+
+const (
+	InteractionEphemeralReply InteractionResponseType = iota + 1 // Ответ видит только нажавший
+	InteractionUpdateMessage                                     // Новые текст и компоненты сообщения
+)
+
+// InteractionResponse — ответ бота на взаимодействие.
+type InteractionResponse struct {
+	InteractionID string
+	Token         string
+	Type          InteractionResponseType
+	Content       string
+	Markdown      bool               // Только для ephemeral-ответа
+	Components    []models.ActionRow // Для обновления: заменяют текущие целиком
+}
+
+// InteractionResult — что получает нажавший: ephemeral-сообщение от бота
+// или сообщение после обновления.
+type InteractionResult struct {
+	Type    InteractionResponseType
+	Message *models.Message
+}
+
+type interactionReply struct {
+	result *InteractionResult
+	err    error
+}
+
+// pendingInteraction — взаимодействие, ждущее ответа бота.
+type pendingInteraction struct {
+	ownerID string
+	token   string
+	userID  string          // Кто нажал
+	message *models.Message // Состояние на момент нажатия
+	channel *models.Channel
+	reply   chan interactionReply // Буфер 1: бот не блокируется, если ждать уже некому
+}
+
+// interactionBroker хранит ожидающие ответа взаимодействия. Как и хаб,
+// живёт в памяти узла: ответ должен прийти на тот же узел за секунды.
+type interactionBroker struct {
+	mu      sync.Mutex
+	pending map[string]*pendingInteraction
+}
+
+func newInteractionBroker() *interactionBroker {
+	return &interactionBroker{pending: make(map[string]*pendingInteraction)}
+}
+
+func (b *interactionBroker) add(id string, p *pendingInteraction) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[id] = p
+}
+
+// find возвращает взаимодействие, если оно ждёт ответа именно от этого бота.
+func (b *interactionBroker) find(id, ownerID, token string) *pendingInteraction {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.pending[id]
+	if p == nil || p.ownerID != ownerID || subtle.ConstantTimeCompare([]byte(p.token), []byte(token)) != 1 {
+		return nil
+	}
+	return p
+}
+
+// take забирает взаимодействие: ответить на него можно только один раз.
+func (b *interactionBroker) take(id string) *pendingInteraction {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	p := b.pending[id]
+	delete(b.pending, id)
+	return p
+}
+
+// InteractWithComponent передаёт нажатие компонента автору сообщения и ждёт
+// его ответа не дольше interactionTimeout.
+func (s *ChatService) InteractWithComponent(ctx context.Context, messageID, callerID, customID string, values []string) (*InteractionResult, error) {
+	const op = "ChatService.InteractWithComponent"
+
+	if _, err := uuid.Parse(messageID); err != nil {
+		return nil, errors.ValidationError("message_id", "Must be a valid message ID").WithOp(op)
+	}
+	msg, err := s.messages.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, msg.ChannelID.String(), callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if member.IsTimedOut(time.Now()) {
+		return nil, errors.MemberTimedOut(*member.TimeoutUntil).WithOp(op)
+	}
+
+	component := msg.FindComponent(customID)
+	if component == nil {
+		return nil, errors.ErrComponentNotFound.WithOp(op).WithMeta("custom_id", customID)
+	}
+	if component.Disabled {
+		return nil, errors.ValidationError("custom_id", "Component is disabled").WithOp(op)
+	}
+	if err := validateInteractionValues(component, values); err != nil {
+		return nil, err.WithOp(op)
+	}
+
+	ownerID := msg.AuthorID.String()
+	if !s.hub.HasSubscribers(hub.UserTopic(ownerID)) {
+		return nil, errors.ErrAppUnavailable.WithOp(op)
+	}
+
+	interactionID, err := uuid.NewV7()
+	if err != nil {
+		return nil, errors.ErrInternal.WithOp(op)
+	}
+	token, err := newInteractionToken()
+	if err != nil {
+		return nil, errors.ErrInternal.WithOp(op)
+	}
+
+	p := &pendingInteraction{
+		ownerID: ownerID,
+		token:   token,
+		userID:  callerID,
+		message: msg,
+		channel: ch,
+		reply:   make(chan interactionReply, 1),
+	}
+	s.interactions.add(interactionID.String(), p)
+	// Не ответил вовремя — ответ уже не примем
+	defer s.interactions.take(interactionID.String())
+
+	expiresAt := time.Now().Add(interactionTimeout)
+	s.hub.PublishUser(ownerID, &pb.ChatEvent{
+		Payload: &pb.ChatEvent_InteractionCreated{
+			InteractionCreated: &pb.InteractionCreated{
+				InteractionId: interactionID.String(),
+				Token:         token,
+				MessageId:     messageID,
+				ChannelId:     msg.ChannelID.String(),
+				GuildId:       ch.GuildIDString(),
+				UserId:        callerID,
+				CustomId:      customID,
+				Values:        values,
+				ExpiresAt:     timestamppb.New(expiresAt),
+			},
+		},
+	})
+
+	timer := time.NewTimer(interactionTimeout)
+	defer timer.Stop()
+	select {
+	case r := <-p.reply:
+		if r.err != nil {
+			return nil, errors.AsAppError(r.err).WithOp(op)
+		}
+		return r.result, nil
+	case <-timer.C:
+		return nil, errors.ErrInteractionTimeout.WithOp(op)
+	case <-ctx.Done():
+		return nil, errors.ErrInteractionTimeout.WithOp(op)
+	}
+}
+
+// RespondToInteraction принимает ответ бота. Некорректный ответ возвращается
+// боту ошибкой и не тратит взаимодействие: до дедлайна можно ответить снова.
+func (s *ChatService) RespondToInteraction(ctx context.Context, callerID string, resp InteractionResponse) error {
+	const op = "ChatService.RespondToInteraction"
+
+	p := s.interactions.find(resp.InteractionID, callerID, resp.Token)
+	if p == nil {
+		return errors.ErrInteractionNotFound.WithOp(op)
+	}
+
+	contentType := models.MessageContentTypeText
+	if resp.Markdown {
+		contentType = models.MessageContentTypeMarkdown
+	}
+	switch resp.Type {
+	case InteractionEphemeralReply:
+		if err := validateContent(resp.Content, contentType); err != nil {
+			return err.WithOp(op)
+		}
+		if len(resp.Components) > 0 {
+			return errors.ValidationError("components", "Ephemeral replies have no components").WithOp(op)
+		}
+	case InteractionUpdateMessage:
+		if err := validateContent(resp.Content, p.message.ContentType); err != nil {
+			return err.WithOp(op)
+		}
+		if err := validateComponents(resp.Components); err != nil {
+			return err.WithOp(op)
+		}
+	default:
+		return errors.ValidationError("type", "Unknown interaction response type").WithOp(op)
+	}
+
+	if s.interactions.take(resp.InteractionID) == nil {
+		return errors.ErrInteractionNotFound.WithOp(op) // Дедлайн истёк, пока проверяли
+	}
+
+	if resp.Type == InteractionEphemeralReply {
+		reply, err := newEphemeralMessage(p.channel, p.message.AuthorID, resp.Content, contentType)
+		if err != nil {
+			p.reply <- interactionReply{err: errors.ErrInternal}
+			return errors.ErrInternal.WithOp(op)
+		}
+		if authorProfile, err := s.users.GetProfile(ctx, callerID); err == nil {
+			reply.Author = *authorProfile
+		}
+		// Остальные устройства нажавшего увидят ответ в канале
+		s.publishEphemeral(reply, p.userID)
+		p.reply <- interactionReply{result: &InteractionResult{Type: InteractionEphemeralReply, Message: reply}}
+		return nil
+	}
+
+	msg := p.message
+	err := s.messages.UpdateComponents(ctx, msg.ID.String(), msg.EditVersion, resp.Content, resp.Components)
+	if err != nil {
+		appErr := errors.AsAppError(err).WithOp(op)
+		p.reply <- interactionReply{err: appErr}
+		return appErr
+	}
+	msg.Content = resp.Content
+	msg.Components = resp.Components
+	msg.EditVersion++
+
+	page := []models.Message{*msg}
+	s.attachAuthorMembers(ctx, p.channel.GuildIDString(), page)
+	s.attachReferences(ctx, page)
+	msg = &page[0]
+	if authorProfile, err := s.users.GetProfile(ctx, callerID); err == nil {
+		msg.Author = *authorProfile
+	}
+
+	s.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessageUpdated{
+			MessageUpdated: MessageToProto(msg),
+		},
+	})
+	if p.channel.Type == models.ChannelTypeAnnouncement {
+		s.updateInteractionCrossposts(ctx, msg)
+	}
+	p.reply <- interactionReply{result: &InteractionResult{Type: InteractionUpdateMessage, Message: msg}}
+	return nil
+}
+
+func newInteractionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+// nextInteraction ждёт событие о нажатии в потоке бота.
+func nextInteraction(t *testing.T, events <-chan *pb.ChatEvent) *pb.InteractionCreated {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case ev := <-events:
+			if ic := ev.GetInteractionCreated(); ic != nil {
+				return ic
+			}
+		case <-timeout:
+			t.Fatal("no interaction event")
+			return nil
+		}
+	}
+}
+
+func TestInteractions(t *testing.T) {
+	e := newTestEnv(t)
+	bot, alice := e.newUser(t, "bot"), e.newUser(t, "alice")
+	guild, general := e.newGuild(t, bot, "LAN")
+	e.join(t, guild, alice)
+
+	buttons := []models.ActionRow{{Components: []models.Component{
+		{Type: models.ComponentTypeButton, CustomID: "yes", Label: "Yes", Style: models.ButtonStylePrimary},
+	}}}
+	msg, err := e.chat.SendMessage(e.ctx(bot), SendMessageParams{ChannelID: general, AuthorID: bot, Content: "vote", Components: buttons})
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+
+	t.Run("bot offline", func(t *testing.T) {
+		_, err := e.chat.InteractWithComponent(e.ctx(alice), msg.ID.String(), alice, "yes", nil)
+		if !hasCode(err, errors.CodeAppUnavailable) {
+			t.Errorf("expected ErrAppUnavailable, got %v", err)
+		}
+	})
+
+	events, unsubscribe := e.hub.Subscribe(hub.UserTopic(bot), bot)
+	defer unsubscribe()
+
+	t.Run("wrong token and second answer are rejected", func(t *testing.T) {
+		type outcome struct {
+			res *InteractionResult
+			err error
+		}
+		done := make(chan outcome, 1)
+		go func() {
+			res, err := e.chat.InteractWithComponent(e.ctx(alice), msg.ID.String(), alice, "yes", nil)
+			done <- outcome{res, err}
+		}()
+		ic := nextInteraction(t, events)
+
+		resp := InteractionResponse{
+			InteractionID: ic.InteractionId,
+			Token:         "not-the-token",
+			Type:          InteractionUpdateMessage,
+			Content:       "closed",
+			Components:    buttons,
+		}
+		if err := e.chat.RespondToInteraction(e.ctx(bot), bot, resp); !hasCode(err, errors.CodeInteractionNotFound) {
+			t.Errorf("expected ErrInteractionNotFound for a wrong token, got %v", err)
+		}
+		resp.Token = ic.Token
+		if err := e.chat.RespondToInteraction(e.ctx(alice), alice, resp); !hasCode(err, errors.CodeInteractionNotFound) {
+			t.Errorf("expected ErrInteractionNotFound for another user, got %v", err)
+		}
+		if err := e.chat.RespondToInteraction(e.ctx(bot), bot, resp); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := e.chat.RespondToInteraction(e.ctx(bot), bot, resp); !hasCode(err, errors.CodeInteractionNotFound) {
+			t.Errorf("expected ErrInteractionNotFound for a second answer, got %v", err)
+		}
+
+		out := <-done
+		if out.err != nil {
+			t.Fatalf("unexpected interaction error: %v", out.err)
+		}
+		if out.res.Type != InteractionUpdateMessage || out.res.Message.Content != "closed" {
+			t.Errorf("unexpected result: %+v", out.res)
+		}
+	})
+
+	t.Run("late answer after expiry is rejected", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(e.ctx(alice), 50*time.Millisecond)
+		defer cancel()
+		_, err := e.chat.InteractWithComponent(ctx, msg.ID.String(), alice, "yes", nil)
+		if !hasCode(err, errors.CodeInteractionTimeout) {
+			t.Fatalf("expected ErrInteractionTimeout, got %v", err)
+		}
+
+		ic := nextInteraction(t, events)
+		err = e.chat.RespondToInteraction(e.ctx(bot), bot, InteractionResponse{
+			InteractionID: ic.InteractionId,
+			Token:         ic.Token,
+			Type:          InteractionEphemeralReply,
+			Content:       "too late",
+		})
+		if !hasCode(err, errors.CodeInteractionNotFound) {
+			t.Errorf("expected ErrInteractionNotFound after expiry, got %v", err)
+		}
+	})
+}
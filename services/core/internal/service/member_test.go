@@ -0,0 +1,164 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+func TestGuildService_TimeoutMember(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	member := e.newUser(t, "member")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, member)
+	guildID := guild.ID.String()
+
+	msg, err := e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: general, AuthorID: owner, Content: "hello"})
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+
+	t.Run("longer than 28 days", func(t *testing.T) {
+		until := time.Now().Add(maxTimeout + time.Hour)
+		_, err := e.guilds.TimeoutMember(e.ctx(owner), guildID, owner, member, &until)
+		if !hasCode(err, errors.CodeBadRequest) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("timed out member is silenced", func(t *testing.T) {
+		until := time.Now().Add(time.Hour)
+		m, err := e.guilds.TimeoutMember(e.ctx(owner), guildID, owner, member, &until)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !m.IsTimedOut(time.Now()) || m.CanSpeak(time.Now()) {
+			t.Errorf("expected member to be timed out and unable to speak, got until=%v", m.TimeoutUntil)
+		}
+		if pb := MemberToProto(m); pb.TimeoutUntil == nil || pb.CanSpeak {
+			t.Errorf("expected timeout in proto, got until=%v can_speak=%v", pb.TimeoutUntil, pb.CanSpeak)
+		}
+
+		_, err = e.chat.SendMessage(e.ctx(member), SendMessageParams{ChannelID: general, AuthorID: member, Content: "hi"})
+		if !hasCode(err, errors.CodeMemberTimedOut) {
+			t.Errorf("SendMessage: expected %s, got %v", errors.CodeMemberTimedOut, err)
+		}
+		err = e.chat.AddReaction(e.ctx(member), msg.ID.String(), member, "👍")
+		if !hasCode(err, errors.CodeMemberTimedOut) {
+			t.Errorf("AddReaction: expected %s, got %v", errors.CodeMemberTimedOut, err)
+		}
+	})
+
+	t.Run("past time clears the timeout", func(t *testing.T) {
+		past := time.Now().Add(-time.Minute)
+		m, err := e.guilds.TimeoutMember(e.ctx(owner), guildID, owner, member, &past)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if m.TimeoutUntil != nil || !m.CanSpeak(time.Now()) {
+			t.Errorf("expected timeout to be cleared, got until=%v", m.TimeoutUntil)
+		}
+
+		if _, err := e.chat.SendMessage(e.ctx(member), SendMessageParams{ChannelID: general, AuthorID: member, Content: "back"}); err != nil {
+			t.Errorf("SendMessage after timeout: %v", err)
+		}
+		if err := e.chat.AddReaction(e.ctx(member), msg.ID.String(), member, "👍"); err != nil {
+			t.Errorf("AddReaction after timeout: %v", err)
+		}
+	})
+}
+
+func TestGuildService_SetMemberVoiceState(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	member := e.newUser(t, "member")
+	guild, _ := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, member)
+
+	muted := true
+	m, err := e.guilds.SetMemberVoiceState(e.ctx(owner), guild.ID.String(), owner, member, &muted, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pb := MemberToProto(m); !pb.IsMuted || pb.CanSpeak {
+		t.Errorf("expected muted member without can_speak, got muted=%v can_speak=%v", pb.IsMuted, pb.CanSpeak)
+	}
+
+	_, err = e.guilds.SetMemberVoiceState(e.ctx(member), guild.ID.String(), member, owner, &muted, nil)
+	if !hasCode(err, errors.CodePermMissing) {
+		t.Errorf("expected permission error, got %v", err)
+	}
+}
+
+func TestGuildService_UpdateMember(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	member := e.newUser(t, "member")
+	other := e.newUser(t, "other")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, member)
+	e.join(t, guild, other)
+	guildID := guild.ID.String()
+	nick := func(s string) MemberUpdate { return MemberUpdate{Nickname: &s} }
+
+	t.Run("own nickname", func(t *testing.T) {
+		m, err := e.guilds.UpdateMyMember(e.ctx(member), guildID, member, nick("Fox"))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if m.Nickname != "Fox" {
+			t.Errorf("expected nickname Fox, got %q", m.Nickname)
+		}
+	})
+
+	t.Run("nickname in messages", func(t *testing.T) {
+		msg, err := e.chat.SendMessage(e.ctx(member), SendMessageParams{ChannelID: general, AuthorID: member, Content: "hi"})
+		if err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+		if got := MessageToProto(msg); got.AuthorDisplayName != "Fox" || got.AuthorUsername != "member" {
+			t.Errorf("sent message: expected Fox/member, got %q/%q", got.AuthorDisplayName, got.AuthorUsername)
+		}
+
+		page, err := e.chat.GetHistory(e.ctx(owner), HistoryParams{ChannelID: general, CallerID: owner, Limit: 10})
+		if err != nil {
+			t.Fatalf("failed to load history: %v", err)
+		}
+		last := page.Messages[len(page.Messages)-1]
+		if got := MessageToProto(&last); got.AuthorDisplayName != "Fox" {
+			t.Errorf("history: expected Fox, got %q", got.AuthorDisplayName)
+		}
+	})
+
+	t.Run("own nickname without CHANGE_NICKNAME", func(t *testing.T) {
+		err := e.db.Model(&models.GuildMember{}).Where("guild_id = ? AND user_id = ?", guildID, other).
+			Update("effective_permissions", models.DefaultGuildPermissions&^models.PermChangeNickname).Error
+		if err != nil {
+			t.Fatalf("failed to revoke permission: %v", err)
+		}
+		_, err = e.guilds.UpdateMyMember(e.ctx(other), guildID, other, nick("Wolf"))
+		if !hasCode(err, errors.CodePermMissing) {
+			t.Errorf("expected %s, got %v", errors.CodePermMissing, err)
+		}
+	})
+
+	t.Run("someone else without MANAGE_NICKNAMES", func(t *testing.T) {
+		_, err := e.guilds.UpdateMember(e.ctx(member), guildID, member, other, nick("Wolf"))
+		if !hasCode(err, errors.CodePermMissing) {
+			t.Errorf("expected %s, got %v", errors.CodePermMissing, err)
+		}
+	})
+
+	t.Run("moderator resets a nickname", func(t *testing.T) {
+		m, err := e.guilds.UpdateMember(e.ctx(owner), guildID, owner, member, nick(""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if m.Nickname != "" {
+			t.Errorf("expected nickname to be reset, got %q", m.Nickname)
+		}
+	})
+}
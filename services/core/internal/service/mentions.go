@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/markup"
+	"github.com/google/uuid"
+)
+
+// maxMentionsPerMessage — сколько разных пользователей и ролей можно упомянуть в одном сообщении.
+const maxMentionsPerMessage = 50
+
+// resolveMentions разбирает упоминания в тексте и проверяет их:
+//   - пользователи вне гильдии молча отбрасываются;
+//   - неупоминаемые роли без MENTION_EVERYONE остаются обычным текстом;
+//   - @everyone/@here без MENTION_EVERYONE — ошибка.
+//
+// Markdown-текст разбирается тем же парсером, что и для отображения.
+// Возвращает сохраняемые упоминания (nil — нет) и адресатов без автора.
+func (s *ChatService) resolveMentions(ctx context.Context, ch *models.Channel, author *models.GuildMember, content string, contentType models.MessageContentType, op string) (*models.MessageMentions, []uuid.UUID, error) {
+	parsed := markup.ParseMentions(content)
+	if contentType == models.MessageContentTypeMarkdown {
+		parsed = markup.MentionsOf(markup.Parse(content))
+	}
+	if parsed.IsEmpty() {
+		return nil, nil, nil
+	}
+	if len(parsed.Users)+len(parsed.Roles) > maxMentionsPerMessage {
+		return nil, nil, errors.LimitReached("mentions_per_message", maxMentionsPerMessage).WithOp(op)
+	}
+
+	if ch.IsDM() {
+		return s.resolveDMMentions(ctx, ch, parsed, op)
+	}
+
+	guildID := ch.GuildIDString()
+	canMentionEveryone := author.EffectivePermissions.Can(models.PermMentionEveryone)
+	if (parsed.Everyone || parsed.Here) && !canMentionEveryone {
+		return nil, nil, errors.PermissionError("MENTION_EVERYONE", guildID).WithOp(op)
+	}
+
+	mentions := &models.MessageMentions{Everyone: parsed.Everyone, Here: parsed.Here}
+	recipients := make(map[uuid.UUID]struct{})
+
+	if len(parsed.Users) > 0 {
+		userIDs := make([]string, len(parsed.Users))
+		for i, id := range parsed.Users {
+			userIDs[i] = id.String()
+		}
+		members, err := s.guilds.ListMembersByUserIDs(ctx, guildID, userIDs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		inGuild := make(map[uuid.UUID]struct{}, len(members))
+		for i := range members {
+			inGuild[members[i].UserID] = struct{}{}
+		}
+		for _, id := range parsed.Users {
+			if _, ok := inGuild[id]; ok {
+				mentions.UserIDs = append(mentions.UserIDs, id)
+				recipients[id] = struct{}{}
+			}
+		}
+	}
+
+	if len(parsed.Roles) > 0 {
+		roles, err := s.guilds.ListRolesByIDs(ctx, guildID, parsed.Roles)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		for i := range roles {
+			if roles[i].IsMentionable || canMentionEveryone {
+				mentions.RoleIDs = append(mentions.RoleIDs, roles[i].ID)
+			}
+		}
+		ids, err := s.guilds.ListMemberIDsByRoles(ctx, guildID, mentions.RoleIDs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		for _, id := range ids {
+			recipients[id] = struct{}{}
+		}
+	}
+
+	if parsed.Everyone || parsed.Here {
+		ids, err := s.guilds.ListMemberIDs(ctx, guildID)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		for _, id := range ids {
+			// @here — только тем, кто сейчас онлайн
+			if parsed.Everyone || s.hub.IsOnline(id.String()) {
+				recipients[id] = struct{}{}
+			}
+		}
+	}
+
+	delete(recipients, author.UserID)
+	out := make([]uuid.UUID, 0, len(recipients))
+	for id := range recipients {
+		out = append(out, id)
+	}
+
+	if len(mentions.UserIDs) == 0 && len(mentions.RoleIDs) == 0 && !mentions.Everyone && !mentions.Here {
+		return nil, out, nil
+	}
+	return mentions, out, nil
+}
+
+// resolveDMMentions — упоминания в личном канале: только его участники, без ролей
+// и @everyone. Во входящие упоминания не попадают, поэтому адресатов нет.
+func (s *ChatService) resolveDMMentions(ctx context.Context, ch *models.Channel, parsed markup.Mentions, op string) (*models.MessageMentions, []uuid.UUID, error) {
+	if parsed.Everyone || parsed.Here {
+		return nil, nil, errors.PermissionError("MENTION_EVERYONE", "").WithOp(op)
+	}
+	if len(parsed.Users) == 0 {
+		return nil, nil, nil
+	}
+	recipients, err := s.channels.ListRecipientIDs(ctx, ch.ID.String())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	mentions := &models.MessageMentions{}
+	for _, id := range parsed.Users {
+		if slices.Contains(recipients, id) {
+			mentions.UserIDs = append(mentions.UserIDs, id)
+		}
+	}
+	if len(mentions.UserIDs) == 0 {
+		return nil, nil, nil
+	}
+	return mentions, nil, nil
+}
+
+// mentionRows строит записи входящих упоминаний для уже сохранённого сообщения.
+func mentionRows(msg *models.Message, guildID uuid.UUID, recipients []uuid.UUID) []models.MessageMention {
+	rows := make([]models.MessageMention, len(recipients))
+	for i, userID := range recipients {
+		rows[i] = models.MessageMention{
+			RealmID:   msg.RealmID,
+			MessageID: msg.ID,
+			UserID:    userID,
+			GuildID:   guildID,
+			ChannelID: msg.ChannelID,
+			Seq:       msg.Seq,
+			CreatedAt: msg.CreatedAt,
+		}
+	}
+	return rows
+}
+
+// ListRecentMentions — входящие упоминания пользователя по всем его гильдиям
+// (или по одной, если задан guildID), новые сверху.
+func (s *ChatService) ListRecentMentions(ctx context.Context, callerID, guildID string, limit int, before *time.Time) ([]models.Message, error) {
+	const op = "ChatService.ListRecentMentions"
+
+	if limit > 100 {
+		return nil, errors.LimitReached("mentions_per_request", 100).WithOp(op)
+	}
+	if guildID != "" {
+		if _, err := uuid.Parse(guildID); err != nil {
+			return nil, errors.ValidationError("guild_id", "Must be a valid guild ID").WithOp(op)
+		}
+	}
+
+	guildIDs := []string{guildID}
+	if guildID == "" {
+		guilds, err := s.guilds.ListByMember(ctx, callerID)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		guildIDs = guildIDs[:0]
+		for i := range guilds {
+			guildIDs = append(guildIDs, guilds[i].ID.String())
+		}
+	}
+	var hidden []uuid.UUID
+	for _, id := range guildIDs {
+		ids, err := s.hiddenChannelIDs(ctx, id, callerID, op)
+		if err != nil {
+			return nil, err
+		}
+		hidden = append(hidden, ids...)
+	}
+
+	msgs, err := s.messages.ListMentions(ctx, repository.MentionFilter{
+		UserID:           callerID,
+		GuildID:          guildID,
+		Before:           before,
+		Limit:            limit,
+		HiddenChannelIDs: hidden,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	s.attachReferences(ctx, msgs)
+	s.attachReactions(ctx, callerID, msgs)
+	s.markBlockedAuthors(ctx, callerID, msgs)
+	return msgs, nil
+}
+
+// hiddenChannelIDs возвращает каналы гильдии (без веток), которые
+// переопределения прав скрывают от участника. Не участнику скрывать нечего:
+// чужие гильдии отсекает сам запрос.
+func (s *ChatService) hiddenChannelIDs(ctx context.Context, guildID, userID, op string) ([]uuid.UUID, error) {
+	member, err := s.guilds.FindMember(ctx, guildID, userID)
+	if err != nil {
+		if errors.Is(err, errors.ErrMemberNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if member.EffectivePermissions.IsAdmin() {
+		return nil, nil
+	}
+
+	channels, err := s.channels.ListByGuild(ctx, guildID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	ids := make([]uuid.UUID, len(channels))
+	for i := range channels {
+		ids[i] = channels[i].ID
+	}
+	roleIDs, err := s.guilds.ListMemberRoleIDs(ctx, guildID, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	overwrites, err := s.channels.ListOverwrites(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	var hidden []uuid.UUID
+	for _, id := range ids {
+		perms := models.ChannelPermissions(member.EffectivePermissions, id, member.UserID, roleIDs, overwrites)
+		if !perms.Has(models.PermViewChannels) {
+			hidden = append(hidden, id)
+		}
+	}
+	return hidden, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func TestMentions_EditAndHiddenChannels(t *testing.T) {
+	e := newTestEnv(t)
+	owner, alice, bob := e.newUser(t, "owner"), e.newUser(t, "alice"), e.newUser(t, "bob")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, alice)
+	e.join(t, guild, bob)
+
+	inbox := func(userID string) []models.Message {
+		t.Helper()
+		msgs, err := e.chat.ListRecentMentions(e.ctx(userID), userID, "", 25, nil)
+		if err != nil {
+			t.Fatalf("failed to list mentions: %v", err)
+		}
+		return msgs
+	}
+
+	msg, err := e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: general, AuthorID: owner, Content: "hi <@" + alice + ">"})
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	if got := inbox(alice); len(got) != 1 || got[0].ID != msg.ID {
+		t.Fatalf("expected alice to be mentioned, got %d messages", len(got))
+	}
+
+	t.Run("edit re-resolves mentions", func(t *testing.T) {
+		edited, err := e.chat.EditMessage(e.ctx(owner), msg.ID.String(), owner, "hi <@"+bob+">")
+		if err != nil {
+			t.Fatalf("failed to edit message: %v", err)
+		}
+		if edited.Mentions == nil || len(edited.Mentions.UserIDs) != 1 || edited.Mentions.UserIDs[0].String() != bob {
+			t.Errorf("expected bob in edited mentions, got %+v", edited.Mentions)
+		}
+		if got := inbox(alice); len(got) != 0 {
+			t.Errorf("expected alice's mention to be gone, got %d", len(got))
+		}
+		if got := inbox(bob); len(got) != 1 || got[0].Mentions == nil {
+			t.Errorf("expected bob to be mentioned once, got %d", len(got))
+		}
+
+		if _, err := e.chat.EditMessage(e.ctx(owner), msg.ID.String(), owner, "hi all"); err != nil {
+			t.Fatalf("failed to edit message: %v", err)
+		}
+		if got := inbox(bob); len(got) != 0 {
+			t.Errorf("expected no mentions after the edit, got %d", len(got))
+		}
+	})
+
+	t.Run("hidden channels are skipped", func(t *testing.T) {
+		secret, err := e.guilds.CreateChannel(e.ctx(owner), guild.ID.String(), owner, "secret", models.ChannelTypeText)
+		if err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+		deny := &models.ChannelPermissionOverwrite{
+			RealmID: e.realmID, ChannelID: secret.ID,
+			TargetType: models.TargetTypeUser, TargetID: uuid.MustParse(alice),
+			Deny: models.PermViewChannels,
+		}
+		if err := e.db.Create(deny).Error; err != nil {
+			t.Fatalf("failed to create overwrite: %v", err)
+		}
+		for _, channelID := range []string{secret.ID.String(), general} {
+			_, err := e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: channelID, AuthorID: owner, Content: "<@" + alice + "> <@" + bob + ">"})
+			if err != nil {
+				t.Fatalf("failed to send message: %v", err)
+			}
+		}
+
+		got := inbox(alice)
+		if len(got) != 1 || got[0].ChannelID.String() != general {
+			t.Errorf("expected only the general mention for alice, got %d", len(got))
+		}
+		byGuild, err := e.chat.ListRecentMentions(e.ctx(alice), alice, guild.ID.String(), 25, nil)
+		if err != nil {
+			t.Fatalf("failed to list mentions: %v", err)
+		}
+		if len(byGuild) != 1 {
+			t.Errorf("expected one mention in the guild, got %d", len(byGuild))
+		}
+		if got := inbox(bob); len(got) != 2 {
+			t.Errorf("expected bob to see both mentions, got %d", len(got))
+		}
+	})
+}
+
+func TestMentions_MarkdownUsesParser(t *testing.T) {
+	e := newTestEnv(t)
+	owner, alice := e.newUser(t, "owner"), e.newUser(t, "alice")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, alice)
+
+	// Без MENTION_EVERYONE: экранированный @everyone — просто текст
+	msg, err := e.chat.SendMessage(e.ctx(alice), SendMessageParams{
+		ChannelID: general, AuthorID: alice, Markdown: true,
+		Content: `\@everyone see mail@here.org and ` + "`<@" + owner + ">`",
+	})
+	if err != nil {
+		t.Fatalf("expected escaped mentions to be plain text, got %v", err)
+	}
+	if msg.Mentions != nil {
+		t.Errorf("expected no mentions, got %+v", msg.Mentions)
+	}
+
+	_, err = e.chat.SendMessage(e.ctx(alice), SendMessageParams{
+		ChannelID: general, AuthorID: alice, Markdown: true, Content: "**@everyone**",
+	})
+	if err == nil {
+		t.Error("expected @everyone inside formatting to need MENTION_EVERYONE")
+	}
+}
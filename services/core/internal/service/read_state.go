@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// Ack отмечает канал прочитанным до seq (0 — до последнего сообщения).
+// Возвращает итоговую позицию: назад она не двигается.
+func (s *ChatService) Ack(ctx context.Context, channelID, callerID string, seq int64) (int64, error) {
+	const op = "ChatService.Ack"
+
+	if seq < 0 {
+		return 0, errors.ValidationError("seq", "Must not be negative").WithOp(op)
+	}
+	ch, member, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return 0, err
+	}
+	// Дальше последнего сообщения читать нечего
+	if lastSeq := ch.NextSeq - 1; seq == 0 || seq > lastSeq {
+		seq = lastSeq
+	}
+
+	state := &models.ReadState{
+		RealmID:     ch.RealmID,
+		UserID:      member.UserID,
+		ChannelID:   ch.ID,
+		LastReadSeq: seq,
+		UpdatedAt:   time.Now(),
+	}
+	if err := s.reads.Ack(ctx, state); err != nil {
+		return 0, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	current, err := s.reads.Find(ctx, callerID, channelID)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	s.publishReadState(current)
+	return current.LastReadSeq, nil
+}
+
+// markRead двигает позицию чтения без проверок доступа (своё сообщение).
+// Ошибка не критична: отправка уже прошла.
+func (s *ChatService) markRead(ctx context.Context, realmID, channelID, userID uuid.UUID, seq int64) {
+	state := &models.ReadState{
+		RealmID:     realmID,
+		UserID:      userID,
+		ChannelID:   channelID,
+		LastReadSeq: seq,
+		UpdatedAt:   time.Now(),
+	}
+	if err := s.reads.Ack(ctx, state); err != nil {
+		logger.FromContext(ctx).Warn("failed to update read state", "channel_id", channelID, "error", err)
+		return
+	}
+	s.publishReadState(state)
+}
+
+// publishReadState синхронизирует позицию чтения между устройствами пользователя.
+func (s *ChatService) publishReadState(state *models.ReadState) {
+	s.hub.PublishUser(state.UserID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_ReadStateUpdated{
+			ReadStateUpdated: &pb.ReadStateUpdated{
+				ChannelId:   state.ChannelID.String(),
+				LastReadSeq: state.LastReadSeq,
+			},
+		},
+	})
+}
+
+// GuildUnread — сумма непрочитанного по каналам гильдии.
+type GuildUnread struct {
+	GuildID      uuid.UUID
+	UnreadCount  int64
+	MentionCount int64
+}
+
+// UnreadSummary — непрочитанное пользователя по каналам и гильдиям.
+type UnreadSummary struct {
+	Channels []models.ChannelUnread
+	Guilds   []GuildUnread
+}
+
+// GetUnreadSummary возвращает каналы с непрочитанным и суммы по гильдиям.
+func (s *ChatService) GetUnreadSummary(ctx context.Context, callerID string) (*UnreadSummary, error) {
+	const op = "ChatService.GetUnreadSummary"
+
+	channels, err := s.reads.ListUnread(ctx, callerID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if channels, err = s.visibleUnread(ctx, callerID, channels, op); err != nil {
+		return nil, err
+	}
+
+	summary := &UnreadSummary{Channels: channels}
+	byGuild := make(map[uuid.UUID]int)
+	for _, ch := range channels {
+		if ch.GuildID == uuid.Nil {
+			continue // Личные каналы вне гильдий
+		}
+		i, ok := byGuild[ch.GuildID]
+		if !ok {
+			i = len(summary.Guilds)
+			byGuild[ch.GuildID] = i
+			summary.Guilds = append(summary.Guilds, GuildUnread{GuildID: ch.GuildID})
+		}
+		summary.Guilds[i].UnreadCount += ch.UnreadCount
+		summary.Guilds[i].MentionCount += ch.MentionCount
+	}
+	return summary, nil
+}
+
+// visibleUnread отбрасывает каналы гильдий, скрытые от пользователя
+// переопределениями прав. Ветки видны, пока виден их родительский канал.
+func (s *ChatService) visibleUnread(ctx context.Context, userID string, channels []models.ChannelUnread, op string) ([]models.ChannelUnread, error) {
+	byGuild := make(map[uuid.UUID][]uuid.UUID)
+	for i := range channels {
+		if guildID := channels[i].GuildID; guildID != uuid.Nil {
+			byGuild[guildID] = append(byGuild[guildID], channels[i].ViewChannelID())
+		}
+	}
+
+	hidden := make(map[uuid.UUID]bool)
+	for guildID, ids := range byGuild {
+		member, err := s.guilds.FindMember(ctx, guildID.String(), userID)
+		if err != nil {
+			if !errors.Is(err, errors.ErrMemberNotFound) {
+				return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+			}
+			// Участник ветки, покинувший гильдию, её каналов не видит
+			for _, id := range ids {
+				hidden[id] = true
+			}
+			continue
+		}
+		if member.EffectivePermissions.IsAdmin() {
+			continue
+		}
+
+		roleIDs, err := s.guilds.ListMemberRoleIDs(ctx, guildID.String(), userID)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		overwrites, err := s.channels.ListOverwrites(ctx, ids)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+		}
+		for _, id := range ids {
+			perms := models.ChannelPermissions(member.EffectivePermissions, id, member.UserID, roleIDs, overwrites)
+			if !perms.Has(models.PermViewChannels) {
+				hidden[id] = true
+			}
+		}
+	}
+
+	visible := channels[:0]
+	for i := range channels {
+		if !hidden[channels[i].ViewChannelID()] {
+			visible = append(visible, channels[i])
+		}
+	}
+	return visible, nil
+}
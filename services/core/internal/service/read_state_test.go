@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func TestGetUnreadSummary_SkipsSystemAndHiddenChannels(t *testing.T) {
+	e := newTestEnv(t)
+	owner, member := e.newUser(t, "owner"), e.newUser(t, "member")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, member) // Системное «вступил» в general непрочитанным не считается
+
+	secret, err := e.guilds.CreateChannel(e.ctx(owner), guild.ID.String(), owner, "secret", models.ChannelTypeText)
+	if err != nil {
+		t.Fatalf("failed to create channel: %v", err)
+	}
+	deny := &models.ChannelPermissionOverwrite{
+		RealmID: e.realmID, ChannelID: secret.ID,
+		TargetType: models.TargetTypeUser, TargetID: uuid.MustParse(member),
+		Deny: models.PermViewChannels,
+	}
+	if err := e.db.Create(deny).Error; err != nil {
+		t.Fatalf("failed to create overwrite: %v", err)
+	}
+	for _, channelID := range []string{general, secret.ID.String()} {
+		if _, err := e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: channelID, AuthorID: owner, Content: "hi"}); err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+	}
+
+	summary, err := e.chat.GetUnreadSummary(e.ctx(member), member)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summary.Channels) != 1 || summary.Channels[0].ChannelID.String() != general {
+		t.Fatalf("expected only general, got %+v", summary.Channels)
+	}
+	if summary.Channels[0].UnreadCount != 1 {
+		t.Errorf("expected 1 unread message, got %d", summary.Channels[0].UnreadCount)
+	}
+	if len(summary.Guilds) != 1 || summary.Guilds[0].UnreadCount != 1 {
+		t.Errorf("expected guild total 1, got %+v", summary.Guilds)
+	}
+
+	// Владелец — администратор: переопределения его не касаются, но свои
+	// сообщения он уже прочитал
+	summary, err = e.chat.GetUnreadSummary(e.ctx(owner), owner)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summary.Channels) != 0 {
+		t.Errorf("expected nothing unread for the owner, got %+v", summary.Channels)
+	}
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxFriends — сколько друзей и исходящих заявок может быть у пользователя.
+const maxFriends = 1000
+
+// RelationshipService управляет друзьями, заявками в друзья и блокировками.
+// Каждое изменение рассылается обеим сторонам в SubscribeUserEvents:
+// каждой — её собственная запись или relationship_removed.
+type RelationshipService struct {
+	relations repository.RelationshipRepository
+	users     repository.UserRepository
+	tm        database.TransactionManager
+	hub       *hub.Hub
+}
+
+func NewRelationshipService(
+	relations repository.RelationshipRepository,
+	users repository.UserRepository,
+	tm database.TransactionManager,
+	hub *hub.Hub,
+) *RelationshipService {
+	return &RelationshipService{relations: relations, users: users, tm: tm, hub: hub}
+}
+
+// pair загружает обоих участников отношения; с самим собой отношений не бывает.
+func (s *RelationshipService) pair(ctx context.Context, callerID, userID, op string) (*models.User, *models.User, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, nil, errors.ValidationError("user_id", "Must be a valid user ID").WithOp(op)
+	}
+	if userID == callerID {
+		return nil, nil, errors.ValidationError("user_id", "Cannot target yourself").WithOp(op)
+	}
+	target, err := s.users.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, errors.AsAppError(err).WithOp(op).WithMeta("user_id", userID)
+	}
+	caller, err := s.users.FindByID(ctx, callerID)
+	if err != nil {
+		return nil, nil, errors.AsAppError(err).WithOp(op)
+	}
+	return caller, target, nil
+}
+
+// find возвращает запись userID о targetID или nil, если её нет.
+func (s *RelationshipService) find(ctx context.Context, userID, targetID string) (*models.Relationship, error) {
+	rel, err := s.relations.Find(ctx, userID, targetID)
+	if errors.Is(err, errors.ErrNotFound) {
+		return nil, nil
+	}
+	return rel, err
+}
+
+// checkFriendLimit проверяет, что у пользователя есть место для ещё одного друга.
+func (s *RelationshipService) checkFriendLimit(ctx context.Context, userID, op string) error {
+	n, err := s.relations.CountByType(ctx, userID, models.RelationshipFriend, models.RelationshipOutgoing)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if n >= maxFriends {
+		return errors.LimitReached("friends", maxFriends).WithOp(op)
+	}
+	return nil
+}
+
+// ListRelationships возвращает отношения вызывающего; у друзей заполнен IsOnline.
+func (s *RelationshipService) ListRelationships(ctx context.Context, callerID string) ([]models.Relationship, error) {
+	const op = "RelationshipService.ListRelationships"
+
+	rels, err := s.relations.List(ctx, callerID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	for i := range rels {
+		if rels[i].Type == models.RelationshipFriend {
+			rels[i].IsOnline = s.hub.IsOnline(rels[i].TargetID.String())
+		}
+	}
+	return rels, nil
+}
+
+// SendFriendRequest отправляет заявку userID. Встречная заявка принимается,
+// повторная отправка возвращает уже существующую.
+func (s *RelationshipService) SendFriendRequest(ctx context.Context, callerID, userID string) (*models.Relationship, error) {
+	const op = "RelationshipService.SendFriendRequest"
+
+	caller, target, err := s.pair(ctx, callerID, userID, op)
+	if err != nil {
+		return nil, err
+	}
+	mine, err := s.find(ctx, callerID, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if mine != nil {
+		switch mine.Type {
+		case models.RelationshipFriend:
+			return nil, errors.ErrConflict.WithOp(op).WithMsg("You are already friends with this user")
+		case models.RelationshipOutgoing:
+			mine.Target = *target
+			return mine, nil
+		case models.RelationshipIncoming:
+			return s.accept(ctx, caller, target, op)
+		case models.RelationshipBlocked:
+			return nil, errors.ValidationError("user_id", "Unblock this user first").WithOp(op)
+		}
+	}
+
+	theirs, err := s.find(ctx, userID, callerID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if theirs != nil && theirs.Type == models.RelationshipBlocked {
+		return nil, errors.ErrFriendRequestDenied.WithOp(op)
+	}
+	if err := s.checkFriendLimit(ctx, callerID, op); err != nil {
+		return nil, err
+	}
+
+	outgoing, incoming := s.newPair(ctx, caller, target, models.RelationshipOutgoing, models.RelationshipIncoming)
+	if err := s.relations.Put(ctx, outgoing, incoming); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	s.publishUpdated(outgoing)
+	s.publishUpdated(incoming)
+	return outgoing, nil
+}
+
+// AcceptFriendRequest принимает входящую заявку от userID.
+func (s *RelationshipService) AcceptFriendRequest(ctx context.Context, callerID, userID string) (*models.Relationship, error) {
+	const op = "RelationshipService.AcceptFriendRequest"
+
+	caller, target, err := s.pair(ctx, callerID, userID, op)
+	if err != nil {
+		return nil, err
+	}
+	mine, err := s.find(ctx, callerID, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if mine == nil || mine.Type != models.RelationshipIncoming {
+		return nil, errors.ErrNotFound.WithOp(op).WithMsg("Friend request not found")
+	}
+	return s.accept(ctx, caller, target, op)
+}
+
+// accept превращает заявку target → caller в дружбу.
+func (s *RelationshipService) accept(ctx context.Context, caller, target *models.User, op string) (*models.Relationship, error) {
+	if err := s.checkFriendLimit(ctx, caller.ID.String(), op); err != nil {
+		return nil, err
+	}
+	mine, theirs := s.newPair(ctx, caller, target, models.RelationshipFriend, models.RelationshipFriend)
+	if err := s.relations.Put(ctx, mine, theirs); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	mine.IsOnline = s.hub.IsOnline(target.ID.String())
+	theirs.IsOnline = s.hub.IsOnline(caller.ID.String())
+	s.publishUpdated(mine)
+	s.publishUpdated(theirs)
+	return mine, nil
+}
+
+// DeclineFriendRequest отклоняет входящую заявку от userID.
+func (s *RelationshipService) DeclineFriendRequest(ctx context.Context, callerID, userID string) error {
+	return s.dropPair(ctx, callerID, userID, models.RelationshipIncoming, "RelationshipService.DeclineFriendRequest")
+}
+
+// CancelFriendRequest отзывает исходящую заявку к userID.
+func (s *RelationshipService) CancelFriendRequest(ctx context.Context, callerID, userID string) error {
+	return s.dropPair(ctx, callerID, userID, models.RelationshipOutgoing, "RelationshipService.CancelFriendRequest")
+}
+
+// RemoveFriend удаляет userID из друзей у обоих.
+func (s *RelationshipService) RemoveFriend(ctx context.Context, callerID, userID string) error {
+	return s.dropPair(ctx, callerID, userID, models.RelationshipFriend, "RelationshipService.RemoveFriend")
+}
+
+// dropPair удаляет зеркальную пару записей, если запись вызывающего имеет тип expect.
+func (s *RelationshipService) dropPair(ctx context.Context, callerID, userID string, expect models.RelationshipType, op string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return errors.ValidationError("user_id", "Must be a valid user ID").WithOp(op)
+	}
+	mine, err := s.find(ctx, callerID, userID)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if mine == nil || mine.Type != expect {
+		return errors.ErrNotFound.WithOp(op).WithMeta("user_id", userID)
+	}
+
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.relations.Delete(txCtx, callerID, userID); err != nil {
+			return err
+		}
+		return s.relations.Delete(txCtx, userID, callerID)
+	})
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	s.publishRemoved(callerID, userID)
+	s.publishRemoved(userID, callerID)
+	return nil
+}
+
+// BlockUser блокирует userID: дружба и заявки между ними снимаются, а
+// заблокированный видит только их исчезновение, но не саму блокировку.
+func (s *RelationshipService) BlockUser(ctx context.Context, callerID, userID string) (*models.Relationship, error) {
+	const op = "RelationshipService.BlockUser"
+
+	caller, target, err := s.pair(ctx, callerID, userID, op)
+	if err != nil {
+		return nil, err
+	}
+	theirs, err := s.find(ctx, userID, callerID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	// Встречная блокировка остаётся: каждый снимает свою сам
+	dropTheirs := theirs != nil && theirs.Type != models.RelationshipBlocked
+
+	block, _ := s.newPair(ctx, caller, target, models.RelationshipBlocked, "")
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.relations.Put(txCtx, block); err != nil {
+			return err
+		}
+		if dropTheirs {
+			return s.relations.Delete(txCtx, userID, callerID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	s.publishUpdated(block)
+	if dropTheirs {
+		s.publishRemoved(userID, callerID)
+	}
+	return block, nil
+}
+
+// UnblockUser снимает блокировку userID. Прежняя дружба не восстанавливается.
+func (s *RelationshipService) UnblockUser(ctx context.Context, callerID, userID string) error {
+	const op = "RelationshipService.UnblockUser"
+
+	if _, err := uuid.Parse(userID); err != nil {
+		return errors.ValidationError("user_id", "Must be a valid user ID").WithOp(op)
+	}
+	mine, err := s.find(ctx, callerID, userID)
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if mine == nil || mine.Type != models.RelationshipBlocked {
+		return errors.ErrNotFound.WithOp(op).WithMeta("user_id", userID)
+	}
+	if err := s.relations.Delete(ctx, callerID, userID); err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	s.publishRemoved(callerID, userID)
+	return nil
+}
+
+// newPair собирает запись caller о target с типом mine и зеркальную запись
+// с типом theirs (пустой theirs — зеркальная запись не нужна, вернётся nil).
+func (s *RelationshipService) newPair(ctx context.Context, caller, target *models.User, mine, theirs models.RelationshipType) (*models.Relationship, *models.Relationship) {
+	realmID, now := middleware.MustRealmID(ctx), time.Now()
+	a := &models.Relationship{RealmID: realmID, UserID: caller.ID, TargetID: target.ID, Type: mine, CreatedAt: now, Target: *target}
+	if theirs == "" {
+		return a, nil
+	}
+	b := &models.Relationship{RealmID: realmID, UserID: target.ID, TargetID: caller.ID, Type: theirs, CreatedAt: now, Target: *caller}
+	return a, b
+}
+
+func (s *RelationshipService) publishUpdated(rel *models.Relationship) {
+	s.hub.PublishUser(rel.UserID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_RelationshipUpdated{RelationshipUpdated: RelationshipToProto(rel)},
+	})
+}
+
+func (s *RelationshipService) publishRemoved(userID, targetID string) {
+	s.hub.PublishUser(userID, &pb.ChatEvent{
+		Payload: &pb.ChatEvent_RelationshipRemoved{RelationshipRemoved: &pb.RelationshipRemoved{UserId: targetID}},
+	})
+}
+
+// RelationshipToProto конвертирует отношение (с загруженным Target) в proto.
+func RelationshipToProto(rel *models.Relationship) *pb.Relationship {
+	return &pb.Relationship{
+		User: &pb.User{
+			Id:        rel.TargetID.String(),
+			Username:  rel.Target.Username,
+			AvatarUrl: rel.Target.AvatarURL,
+			IsOnline:  rel.IsOnline,
+		},
+		Type:  relationshipTypeToProto(rel.Type),
+		Since: timestamppb.New(rel.CreatedAt),
+	}
+}
+
+func relationshipTypeToProto(t models.RelationshipType) pb.RelationshipType {
+	switch t {
+	case models.RelationshipFriend:
+		return pb.RelationshipType_RELATIONSHIP_TYPE_FRIEND
+	case models.RelationshipIncoming:
+		return pb.RelationshipType_RELATIONSHIP_TYPE_INCOMING_REQUEST
+	case models.RelationshipOutgoing:
+		return pb.RelationshipType_RELATIONSHIP_TYPE_OUTGOING_REQUEST
+	case models.RelationshipBlocked:
+		return pb.RelationshipType_RELATIONSHIP_TYPE_BLOCKED
+	default:
+		return pb.RelationshipType_RELATIONSHIP_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+// relationTypes возвращает записи userID о других пользователях по их ID.
+func (e *testEnv) relationTypes(t *testing.T, userID string) map[string]models.RelationshipType {
+	t.Helper()
+	rels, err := e.rels.ListRelationships(e.ctx(userID), userID)
+	if err != nil {
+		t.Fatalf("failed to list relationships: %v", err)
+	}
+	out := make(map[string]models.RelationshipType, len(rels))
+	for _, rel := range rels {
+		out[rel.TargetID.String()] = rel.Type
+	}
+	return out
+}
+
+func TestRelationshipService(t *testing.T) {
+	e := newTestEnv(t)
+	alice, bob, carol := e.newUser(t, "alice"), e.newUser(t, "bob"), e.newUser(t, "carol")
+
+	t.Run("request and accept", func(t *testing.T) {
+		rel, err := e.rels.SendFriendRequest(e.ctx(alice), alice, bob)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rel.Type != models.RelationshipOutgoing || e.relationTypes(t, bob)[alice] != models.RelationshipIncoming {
+			t.Fatalf("expected an outgoing/incoming pair, got %s", rel.Type)
+		}
+		if _, err := e.rels.AcceptFriendRequest(e.ctx(alice), alice, bob); !hasCode(err, errors.CodeNotFound) {
+			t.Errorf("expected the sender not to accept their own request, got %v", err)
+		}
+		if _, err := e.rels.AcceptFriendRequest(e.ctx(bob), bob, alice); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if e.relationTypes(t, alice)[bob] != models.RelationshipFriend || e.relationTypes(t, bob)[alice] != models.RelationshipFriend {
+			t.Error("expected both sides to be friends")
+		}
+	})
+
+	t.Run("crossing requests become friends", func(t *testing.T) {
+		if _, err := e.rels.SendFriendRequest(e.ctx(carol), carol, bob); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		rel, err := e.rels.SendFriendRequest(e.ctx(bob), bob, carol)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rel.Type != models.RelationshipFriend {
+			t.Errorf("expected the counter request to accept, got %s", rel.Type)
+		}
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		if _, err := e.rels.SendFriendRequest(e.ctx(carol), carol, alice); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := e.rels.DeclineFriendRequest(e.ctx(alice), alice, carol); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := e.relationTypes(t, alice)[carol]; ok {
+			t.Error("expected alice's record to be gone")
+		}
+		if _, ok := e.relationTypes(t, carol)[alice]; ok {
+			t.Error("expected carol's record to be gone")
+		}
+		if err := e.rels.DeclineFriendRequest(e.ctx(alice), alice, carol); !hasCode(err, errors.CodeNotFound) {
+			t.Errorf("expected a second decline to fail, got %v", err)
+		}
+	})
+
+	t.Run("block", func(t *testing.T) {
+		dm, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob}, "")
+		if err != nil {
+			t.Fatalf("failed to open dm: %v", err)
+		}
+		group, err := e.dm.OpenDM(e.ctx(alice), alice, []string{bob, carol}, "")
+		if err != nil {
+			t.Fatalf("failed to open group: %v", err)
+		}
+
+		rel, err := e.rels.BlockUser(e.ctx(alice), alice, bob)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rel.Type != models.RelationshipBlocked {
+			t.Errorf("expected a block, got %s", rel.Type)
+		}
+		if _, ok := e.relationTypes(t, bob)[alice]; ok {
+			t.Error("expected the friendship to be gone on bob's side")
+		}
+		if _, err := e.rels.SendFriendRequest(e.ctx(bob), bob, alice); !hasCode(err, errors.CodeFriendRequestDenied) {
+			t.Errorf("expected FRIEND_REQUEST_NOT_ALLOWED, got %v", err)
+		}
+
+		send := func(channelID string) error {
+			_, err := e.chat.SendMessage(e.ctx(bob), SendMessageParams{ChannelID: channelID, AuthorID: bob, Content: "hey"})
+			return err
+		}
+		if err := send(dm.ID.String()); !hasCode(err, errors.CodeDMNotAllowed) {
+			t.Errorf("expected DM_NOT_ALLOWED in the dm, got %v", err)
+		}
+		// В group_dm блокировка не мешает писать, но alice видит пометку
+		if err := send(group.ID.String()); err != nil {
+			t.Fatalf("expected bob to write in the group, got %v", err)
+		}
+		page, err := e.chat.GetHistory(e.ctx(alice), HistoryParams{ChannelID: group.ID.String(), CallerID: alice})
+		if err != nil {
+			t.Fatalf("failed to load history: %v", err)
+		}
+		if len(page.Messages) != 1 || !page.Messages[0].AuthorBlocked {
+			t.Errorf("expected bob's message to be marked as blocked for alice")
+		}
+
+		if err := e.rels.UnblockUser(e.ctx(alice), alice, bob); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := e.relationTypes(t, alice)[bob]; ok {
+			t.Error("expected unblocking not to restore the friendship")
+		}
+		if err := send(dm.ID.String()); err != nil {
+			t.Errorf("expected bob to write in the dm after unblock, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// countingMessages считает запросы превью цитат.
+type countingMessages struct {
+	repository.MessageRepository
+	refLookups int
+}
+
+func (r *countingMessages) FindByIDsWithDeleted(ctx context.Context, ids []uuid.UUID) ([]models.Message, error) {
+	r.refLookups++
+	return r.MessageRepository.FindByIDsWithDeleted(ctx, ids)
+}
+
+func TestSendMessage_Replies(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	guild, general := e.newGuild(t, owner, "LAN")
+	send := func(t *testing.T, channelID, content, replyTo string) (*models.Message, error) {
+		t.Helper()
+		return e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: channelID, AuthorID: owner, Content: content, ReplyToID: replyTo})
+	}
+
+	original, err := send(t, general, "question", "")
+	if err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+
+	t.Run("preview", func(t *testing.T) {
+		reply, err := send(t, general, "answer", original.ID.String())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ref := MessageToProto(reply).ReferencedMessage
+		if ref == nil || ref.MessageId != original.ID.String() || ref.Content != "question" || ref.AuthorUsername != "owner" || ref.Deleted {
+			t.Errorf("unexpected reference: %+v", ref)
+		}
+	})
+
+	t.Run("message in another channel", func(t *testing.T) {
+		other, err := e.guilds.CreateChannel(e.ctx(owner), guild.ID.String(), owner, "other", models.ChannelTypeText)
+		if err != nil {
+			t.Fatalf("failed to create channel: %v", err)
+		}
+		if _, err := send(t, other.ID.String(), "answer", original.ID.String()); !hasCode(err, errors.CodeBadRequest) {
+			t.Errorf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("unknown message", func(t *testing.T) {
+		if _, err := send(t, general, "answer", uuid.NewString()); !hasCode(err, errors.CodeMessageNotFound) {
+			t.Errorf("expected %s, got %v", errors.CodeMessageNotFound, err)
+		}
+	})
+}
+
+func TestGetHistory_References(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	_, general := e.newGuild(t, owner, "LAN")
+	send := func(content string, replyTo *models.Message) *models.Message {
+		t.Helper()
+		p := SendMessageParams{ChannelID: general, AuthorID: owner, Content: content}
+		if replyTo != nil {
+			p.ReplyToID = replyTo.ID.String()
+		}
+		msg, err := e.chat.SendMessage(e.ctx(owner), p)
+		if err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+		return msg
+	}
+
+	long := send(strings.Repeat("я", referencePreviewLength+20), nil)
+	removed := send("oops", nil)
+	send("re: long", long)
+	send("re: removed", removed)
+	send("re: long again", long)
+	if err := e.chat.DeleteMessage(e.ctx(owner), removed.ID.String(), owner, ""); err != nil {
+		t.Fatalf("failed to delete message: %v", err)
+	}
+
+	counter := &countingMessages{MessageRepository: e.chat.messages}
+	e.chat.messages = counter
+	page, err := e.chat.GetHistory(e.ctx(owner), HistoryParams{ChannelID: general, CallerID: owner, Limit: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if counter.refLookups != 1 {
+		t.Errorf("expected references to load in one query, got %d", counter.refLookups)
+	}
+
+	replies := 0
+	for i := range page.Messages {
+		m := &page.Messages[i]
+		if m.ReplyToID == nil {
+			continue
+		}
+		replies++
+		ref := MessageToProto(m).ReferencedMessage
+		switch *m.ReplyToID {
+		case long.ID:
+			want := strings.Repeat("я", referencePreviewLength) + "…"
+			if ref.Deleted || ref.Content != want {
+				t.Errorf("%q: expected a truncated preview, got %+v", m.Content, ref)
+			}
+		case removed.ID:
+			if !ref.Deleted || ref.Content != "" || ref.AuthorId != "" {
+				t.Errorf("%q: expected a deleted reference without content, got %+v", m.Content, ref)
+			}
+		}
+	}
+	if replies != 3 {
+		t.Errorf("expected 3 replies in history, got %d", replies)
+	}
+}
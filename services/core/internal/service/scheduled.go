@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxScheduleAhead       = 30 * 24 * time.Hour
+	maxScheduledPerChannel = 100
+
+	// Планировщик: за проход берёт scheduleBatch сообщений в аренду на
+	// scheduleLease. Не отправленное за scheduleMaxDelay после SendAt
+	// (узел лежал, сбои) уже неактуально и помечается неудавшимся.
+	scheduleBatch       = 20
+	scheduleLease       = time.Minute
+	scheduleMaxAttempts = 5
+	scheduleRetryDelay  = 30 * time.Second
+	scheduleMaxDelay    = time.Hour
+)
+
+// ScheduleMessageParams — сообщение, которое нужно отправить в SendAt.
+type ScheduleMessageParams struct {
+	ChannelID string
+	AuthorID  string
+	Content   string
+	Markdown  bool
+	SendAt    time.Time
+}
+
+// ScheduleMessage ставит сообщение в очередь. Сейчас проверяются только доступ
+// к каналу и SEND_MESSAGES (PUBLISH_MESSAGES в канале объявлений); при отправке
+// SendMessage проверит всё заново.
+func (s *ChatService) ScheduleMessage(ctx context.Context, p ScheduleMessageParams) (*models.ScheduledMessage, error) {
+	const op = "ChatService.ScheduleMessage"
+
+	contentType := models.MessageContentTypeText
+	if p.Markdown {
+		contentType = models.MessageContentTypeMarkdown
+	}
+	if err := validateContent(p.Content, contentType); err != nil {
+		return nil, err.WithOp(op)
+	}
+	now := time.Now()
+	if !p.SendAt.After(now) {
+		return nil, errors.ValidationError("send_at", "Must be in the future").WithOp(op)
+	}
+	if p.SendAt.After(now.Add(maxScheduleAhead)) {
+		return nil, errors.ValidationError("send_at", "Must be within 30 days").WithOp(op)
+	}
+
+	ch, member, err := s.getAccessibleChannel(ctx, p.ChannelID, p.AuthorID, op)
+	if err != nil {
+		return nil, err
+	}
+	if !ch.IsTextBased() {
+		return nil, errors.ValidationError("channel_id", "This channel does not support text messages").WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermSendMessages) {
+		return nil, errors.PermissionError("SEND_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+	if ch.Type == models.ChannelTypeAnnouncement && !member.EffectivePermissions.Can(models.PermPublishMessages) {
+		return nil, errors.PermissionError("PUBLISH_MESSAGES", ch.GuildIDString()).WithOp(op)
+	}
+
+	pending, err := s.scheduled.CountPending(ctx, p.ChannelID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if pending >= maxScheduledPerChannel {
+		return nil, errors.LimitReached("scheduled_messages_per_channel", maxScheduledPerChannel).WithOp(op)
+	}
+
+	sm := &models.ScheduledMessage{
+		BaseEntity:    models.BaseEntity{RealmID: middleware.MustRealmID(ctx)},
+		ChannelID:     ch.ID,
+		AuthorID:      member.UserID,
+		Content:       p.Content,
+		ContentType:   contentType,
+		SendAt:        p.SendAt,
+		Status:        models.ScheduledMessagePending,
+		NextAttemptAt: p.SendAt,
+	}
+	if err := s.scheduled.Create(ctx, sm); err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return sm, nil
+}
+
+// ListScheduledMessages возвращает неотправленные сообщения канала: свои,
+// а модератору с MANAGE_MESSAGES — всех авторов.
+func (s *ChatService) ListScheduledMessages(ctx context.Context, channelID, callerID string) ([]models.ScheduledMessage, error) {
+	const op = "ChatService.ListScheduledMessages"
+
+	_, member, err := s.getAccessibleChannel(ctx, channelID, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	authorID := callerID
+	if member.EffectivePermissions.Can(models.PermManageMessages) {
+		authorID = ""
+	}
+	list, err := s.scheduled.ListUnsent(ctx, channelID, authorID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return list, nil
+}
+
+// CancelScheduledMessage отменяет ожидающее сообщение или убирает неудавшееся.
+// Сообщение, которое планировщик уже отправляет, отменить нельзя.
+func (s *ChatService) CancelScheduledMessage(ctx context.Context, id, callerID string) error {
+	const op = "ChatService.CancelScheduledMessage"
+
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.ValidationError("scheduled_message_id", "Must be a valid ID").WithOp(op)
+	}
+	sm, err := s.scheduled.FindByID(ctx, id)
+	if err != nil {
+		return errors.AsAppError(err).WithOp(op)
+	}
+	if sm.Status == models.ScheduledMessageSent {
+		return errors.ErrNotFound.WithOp(op)
+	}
+	if sm.AuthorID.String() != callerID {
+		ch, member, err := s.getAccessibleChannel(ctx, sm.ChannelID.String(), callerID, op)
+		if err != nil {
+			return err
+		}
+		if !member.EffectivePermissions.Can(models.PermManageMessages) {
+			return errors.PermissionError("MANAGE_MESSAGES", ch.GuildIDString()).WithOp(op)
+		}
+	}
+
+	deleted, err := s.scheduled.DeleteUnlocked(ctx, id, time.Now())
+	if err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	if !deleted {
+		return errors.ErrConflict.WithOp(op).WithMsg("The message is being sent right now")
+	}
+	return nil
+}
+
+// RunScheduler отправляет наступившие запланированные сообщения раз в every,
+// пока не отменён ctx. Очередь хранится в БД, поэтому переживает перезапуск,
+// а несколько экземпляров core делят её через аренду записей.
+func (s *ChatService) RunScheduler(ctx context.Context, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		s.dispatchScheduled(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ChatService) dispatchScheduled(ctx context.Context) {
+	now := time.Now()
+	due, err := s.scheduled.ClaimDue(ctx, now, now.Add(scheduleLease), scheduleBatch)
+	if err != nil && ctx.Err() == nil {
+		logger.FromContext(ctx).Error("failed to claim scheduled messages", "error", err)
+	}
+	for i := range due {
+		s.deliverScheduled(ctx, &due[i])
+	}
+}
+
+// deliverScheduled отправляет сообщение от имени автора. Все попытки идут с
+// одним nonce: если прошлая попытка успела создать сообщение, SendMessage
+// вернёт его вместо дубликата.
+func (s *ChatService) deliverScheduled(ctx context.Context, sm *models.ScheduledMessage) {
+	log := logger.FromContext(ctx).With("scheduled_message_id", sm.ID, "channel_id", sm.ChannelID)
+	ctx = logger.WithContext(ctx, log)
+	ctx = context.WithValue(ctx, middleware.ContextKeyRealmID, sm.RealmID.String())
+	ctx = context.WithValue(ctx, middleware.ContextKeyUserID, sm.AuthorID.String())
+
+	// Окно дедупликации nonce ограничено — после долгого простоя ищем сами
+	if sm.Attempts > 1 {
+		msg, err := s.messages.FindByNonce(ctx, sm.AuthorID.String(), sm.ChannelID.String(), sm.Nonce(), sm.CreatedAt)
+		if err == nil {
+			s.finishScheduled(ctx, sm, models.ScheduledMessageSent, &msg.ID, "")
+			return
+		}
+	}
+
+	if time.Since(sm.SendAt) > scheduleMaxDelay {
+		s.finishScheduled(ctx, sm, models.ScheduledMessageFailed, nil, "The scheduled time has passed too long ago")
+		return
+	}
+
+	msg, err := s.SendMessage(ctx, SendMessageParams{
+		ChannelID: sm.ChannelID.String(),
+		AuthorID:  sm.AuthorID.String(),
+		Content:   sm.Content,
+		Markdown:  sm.ContentType == models.MessageContentTypeMarkdown,
+		Nonce:     sm.Nonce(),
+	})
+	if err == nil {
+		s.finishScheduled(ctx, sm, models.ScheduledMessageSent, &msg.ID, "")
+		return
+	}
+
+	appErr := errors.AsAppError(err)
+	if isTransient(appErr) && sm.Attempts < scheduleMaxAttempts {
+		log.Warn("scheduled message failed, will retry", "attempt", sm.Attempts, "error", err)
+		retryAt := time.Now().Add(time.Duration(sm.Attempts) * scheduleRetryDelay)
+		if err := s.scheduled.Retry(ctx, sm.ID.String(), retryAt, appErr.PublicMsg); err != nil {
+			log.Error("failed to reschedule message", "error", err)
+		}
+		return
+	}
+	log.Info("scheduled message rejected", "error", err)
+	s.finishScheduled(ctx, sm, models.ScheduledMessageFailed, nil, appErr.PublicMsg)
+}
+
+func (s *ChatService) finishScheduled(ctx context.Context, sm *models.ScheduledMessage, status models.ScheduledMessageStatus, messageID *uuid.UUID, reason string) {
+	if err := s.scheduled.Finish(ctx, sm.ID.String(), status, messageID, reason); err != nil {
+		// Аренда истечёт, и следующая попытка найдёт сообщение по nonce
+		logger.FromContext(ctx).Error("failed to finish scheduled message", "status", status, "error", err)
+	}
+}
+
+// isTransient — сбой инфраструктуры, а не отказ по правам или валидации.
+func isTransient(err *errors.AppError) bool {
+	if err.Retryable {
+		return true
+	}
+	switch err.Status {
+	case codes.Internal, codes.Unavailable, codes.DeadlineExceeded, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// ScheduledMessageToProto конвертирует запланированное сообщение в proto.
+func ScheduledMessageToProto(sm *models.ScheduledMessage) *pb.ScheduledMessage {
+	out := &pb.ScheduledMessage{
+		Id:        sm.ID.String(),
+		ChannelId: sm.ChannelID.String(),
+		AuthorId:  sm.AuthorID.String(),
+		Content:   sm.Content,
+		Markdown:  sm.ContentType == models.MessageContentTypeMarkdown,
+		SendAt:    timestamppb.New(sm.SendAt),
+		Status:    pb.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_PENDING,
+		CreatedAt: timestamppb.New(sm.CreatedAt),
+	}
+	if sm.Status == models.ScheduledMessageFailed {
+		out.Status = pb.ScheduledMessageStatus_SCHEDULED_MESSAGE_STATUS_FAILED
+		out.Error = sm.LastError
+	}
+	return out
+}
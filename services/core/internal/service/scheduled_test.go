@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+func TestIsTransient(t *testing.T) {
+	cases := []struct {
+		name string
+		err  *errors.AppError
+		want bool
+	}{
+		{"internal", errors.ErrInternal, true},
+		{"database", errors.ErrDBQueryFailed, true},
+		{"deadline", errors.ErrInteractionTimeout, true},
+		{"marked retryable", errors.ErrConflict.WithRetry(), true},
+		{"validation", errors.ValidationError("content", "Too long"), false},
+		{"permission", errors.PermissionError("SEND_MESSAGES", ""), false},
+		{"not found", errors.ErrChannelNotFound, false},
+	}
+	for _, tc := range cases {
+		if got := isTransient(tc.err); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDeliverScheduled(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.newUser(t, "owner")
+	_, general := e.newGuild(t, owner, "LAN")
+	channelID := uuid.MustParse(general)
+
+	// schedule кладёт в очередь сообщение, уже прошедшее attempts попыток.
+	schedule := func(t *testing.T, authorID, content string, sendAt time.Time, attempts int) *models.ScheduledMessage {
+		t.Helper()
+		sm := &models.ScheduledMessage{
+			BaseEntity:    models.BaseEntity{RealmID: e.realmID},
+			ChannelID:     channelID,
+			AuthorID:      uuid.MustParse(authorID),
+			Content:       content,
+			ContentType:   models.MessageContentTypeText,
+			SendAt:        sendAt,
+			Status:        models.ScheduledMessagePending,
+			NextAttemptAt: sendAt,
+			Attempts:      attempts,
+		}
+		if err := e.repos.Scheduled.Create(context.Background(), sm); err != nil {
+			t.Fatalf("failed to schedule message: %v", err)
+		}
+		return sm
+	}
+	// dispatch прогоняет один проход планировщика и перечитывает sm.
+	dispatch := func(t *testing.T, sm *models.ScheduledMessage) *models.ScheduledMessage {
+		t.Helper()
+		quiet := slog.New(slog.NewTextHandler(io.Discard, nil))
+		e.chat.dispatchScheduled(logger.WithContext(context.Background(), quiet))
+		var got models.ScheduledMessage
+		if err := e.db.First(&got, "id = ?", sm.ID).Error; err != nil {
+			t.Fatalf("failed to reload scheduled message: %v", err)
+		}
+		return &got
+	}
+
+	t.Run("due message is sent", func(t *testing.T) {
+		sm := dispatch(t, schedule(t, owner, "on time", time.Now().Add(-time.Minute), 0))
+		if sm.Status != models.ScheduledMessageSent || sm.MessageID == nil || sm.Attempts != 1 {
+			t.Fatalf("expected sent on the first attempt, got %s attempts=%d", sm.Status, sm.Attempts)
+		}
+		history := e.history(t, general)
+		if last := history[len(history)-1]; last.ID != *sm.MessageID || last.Content != "on time" {
+			t.Errorf("expected the message in history, got %+v", last)
+		}
+	})
+
+	t.Run("earlier attempt is found by nonce", func(t *testing.T) {
+		// Прошлая попытка создала сообщение, но не успела отметить отправку,
+		// а потом узел лежал дольше scheduleMaxDelay
+		sm := schedule(t, owner, "crashed", time.Now().Add(-2*scheduleMaxDelay), 1)
+		sent, err := e.chat.SendMessage(e.ctx(owner), SendMessageParams{ChannelID: general, AuthorID: owner, Content: "crashed", Nonce: sm.Nonce()})
+		if err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+		before := len(e.history(t, general))
+
+		got := dispatch(t, sm)
+		if got.Status != models.ScheduledMessageSent || got.MessageID == nil || *got.MessageID != sent.ID {
+			t.Errorf("expected the earlier message %s, got %s %v", sent.ID, got.Status, got.MessageID)
+		}
+		if after := len(e.history(t, general)); after != before {
+			t.Errorf("expected no duplicate, history grew from %d to %d", before, after)
+		}
+	})
+
+	t.Run("too late is failed", func(t *testing.T) {
+		before := len(e.history(t, general))
+		got := dispatch(t, schedule(t, owner, "stale", time.Now().Add(-2*scheduleMaxDelay), 0))
+		if got.Status != models.ScheduledMessageFailed || got.LastError == "" {
+			t.Errorf("expected failed with a reason, got %s %q", got.Status, got.LastError)
+		}
+		if after := len(e.history(t, general)); after != before {
+			t.Errorf("expected nothing sent, history grew from %d to %d", before, after)
+		}
+	})
+
+	t.Run("rejection is not retried", func(t *testing.T) {
+		stranger := e.newUser(t, "stranger")
+		got := dispatch(t, schedule(t, stranger, "let me in", time.Now().Add(-time.Minute), 0))
+		if got.Status != models.ScheduledMessageFailed || got.Attempts != 1 {
+			t.Errorf("expected failed on the first attempt, got %s attempts=%d", got.Status, got.Attempts)
+		}
+	})
+}
+
+func TestCancelScheduledMessage(t *testing.T) {
+	e := newTestEnv(t)
+	owner, alice, bob := e.newUser(t, "owner"), e.newUser(t, "alice"), e.newUser(t, "bob")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, alice)
+	e.join(t, guild, bob)
+
+	schedule := func(t *testing.T) string {
+		t.Helper()
+		sm, err := e.chat.ScheduleMessage(e.ctx(alice), ScheduleMessageParams{
+			ChannelID: general, AuthorID: alice, Content: "later", SendAt: time.Now().Add(time.Hour),
+		})
+		if err != nil {
+			t.Fatalf("failed to schedule message: %v", err)
+		}
+		return sm.ID.String()
+	}
+
+	t.Run("someone else without MANAGE_MESSAGES", func(t *testing.T) {
+		err := e.chat.CancelScheduledMessage(e.ctx(bob), schedule(t), bob)
+		if !hasCode(err, errors.CodePermMissing) {
+			t.Fatalf("expected %s, got %v", errors.CodePermMissing, err)
+		}
+		if got := errors.AsAppError(err).Meta["guild_id"]; got != guild.ID.String() {
+			t.Errorf("expected guild_id %s in the error, got %v", guild.ID, got)
+		}
+	})
+
+	t.Run("author and moderator", func(t *testing.T) {
+		if err := e.chat.CancelScheduledMessage(e.ctx(alice), schedule(t), alice); err != nil {
+			t.Errorf("author: unexpected error: %v", err)
+		}
+		if err := e.chat.CancelScheduledMessage(e.ctx(owner), schedule(t), owner); err != nil {
+			t.Errorf("moderator: unexpected error: %v", err)
+		}
+	})
+}
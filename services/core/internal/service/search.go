@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	maxSearchQueryLength = 256
+	maxSearchOffset      = 5000 // Дальше листать поиск бессмысленно — уточняйте запрос
+)
+
+// SearchParams — параметры поиска сообщений. Нужен GuildID или ChannelID.
+type SearchParams struct {
+	GuildID       string
+	ChannelID     string // Сужает поиск до канала (и его веток)
+	Query         string
+	AuthorID      string
+	After         *time.Time
+	Before        *time.Time
+	HasAttachment bool
+	HasLink       bool
+	Pinned        bool
+	MentionsMe    bool
+	Limit         int
+	Offset        int
+}
+
+// SearchMessages ищет сообщения в гильдии или канале. В выдачу попадают только
+// каналы, которые вызывающий может просматривать, удалённые сообщения исключены.
+func (s *ChatService) SearchMessages(ctx context.Context, callerID string, p SearchParams) ([]models.Message, bool, error) {
+	const op = "ChatService.SearchMessages"
+
+	if utf8.RuneCountInString(p.Query) > maxSearchQueryLength {
+		return nil, false, errors.ValidationError("query", "Must be at most 256 characters").WithOp(op)
+	}
+	if p.Query == "" && p.AuthorID == "" && p.After == nil && p.Before == nil &&
+		!p.HasAttachment && !p.HasLink && !p.Pinned && !p.MentionsMe {
+		return nil, false, errors.ValidationError("query", "Specify a query or at least one filter").WithOp(op)
+	}
+	if p.AuthorID != "" {
+		if _, err := uuid.Parse(p.AuthorID); err != nil {
+			return nil, false, errors.ValidationError("author_id", "Must be a valid user ID").WithOp(op)
+		}
+	}
+	if p.Limit <= 0 {
+		p.Limit = 25
+	}
+	if p.Limit > 100 {
+		return nil, false, errors.LimitReached("messages_per_search", 100).WithOp(op)
+	}
+	if p.Offset < 0 || p.Offset > maxSearchOffset {
+		return nil, false, errors.ValidationError("offset", "Must be between 0 and 5000").WithOp(op)
+	}
+
+	var scope *models.Channel
+	if p.ChannelID != "" {
+		ch, err := s.channels.FindByID(ctx, p.ChannelID)
+		if err != nil {
+			return nil, false, errors.AsAppError(err).WithOp(op)
+		}
+		if p.GuildID != "" && ch.GuildIDString() != p.GuildID {
+			return nil, false, errors.ValidationError("channel_id", "Channel does not belong to this guild").WithOp(op)
+		}
+		p.GuildID = ch.GuildIDString()
+		scope = ch
+	}
+
+	var channelIDs []uuid.UUID
+	switch {
+	case scope != nil && scope.IsDM():
+		// Личный канал: видят только участники, гильдии нет
+		if _, err := dmRecipient(ctx, s.channels, scope, callerID, op); err != nil {
+			return nil, false, err
+		}
+		channelIDs = []uuid.UUID{scope.ID}
+	case p.GuildID == "":
+		return nil, false, errors.ValidationError("guild_id", "Specify a guild or a channel").WithOp(op)
+	default:
+		visible, err := s.visibleChannelIDs(ctx, p.GuildID, callerID, op)
+		if err != nil {
+			return nil, false, err
+		}
+		channelIDs = visible
+		if scope != nil {
+			// Ветка видна, если виден её родитель
+			visibleID := scope.ID
+			if scope.ParentID != nil {
+				visibleID = *scope.ParentID
+			}
+			if !slices.Contains(channelIDs, visibleID) {
+				return nil, false, errors.PermissionError("VIEW_CHANNEL", p.GuildID).WithOp(op)
+			}
+			channelIDs = []uuid.UUID{scope.ID}
+		}
+	}
+
+	search := repository.MessageSearch{
+		Query:         p.Query,
+		ChannelIDs:    channelIDs,
+		AuthorID:      p.AuthorID,
+		After:         p.After,
+		Before:        p.Before,
+		HasAttachment: p.HasAttachment,
+		HasLink:       p.HasLink,
+		Pinned:        p.Pinned,
+		Limit:         p.Limit,
+		Offset:        p.Offset,
+	}
+	if p.MentionsMe {
+		search.MentionsUserID = callerID
+	}
+
+	msgs, err := s.messages.Search(ctx, search)
+	if err != nil {
+		return nil, false, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	hasMore := len(msgs) > p.Limit
+	if hasMore {
+		msgs = msgs[:p.Limit]
+	}
+
+	s.attachAuthorMembers(ctx, p.GuildID, msgs)
+	s.attachReferences(ctx, msgs)
+	s.attachReactions(ctx, callerID, msgs)
+	s.markBlockedAuthors(ctx, callerID, msgs)
+	return msgs, hasMore, nil
+}
+
+// visibleChannelIDs возвращает каналы гильдии (без веток), которые участник
+// может просматривать с учётом переопределений прав.
+func (s *ChatService) visibleChannelIDs(ctx context.Context, guildID, userID, op string) ([]uuid.UUID, error) {
+	member, err := s.guilds.FindMember(ctx, guildID, userID)
+	if err != nil {
+		if errors.Is(err, errors.ErrMemberNotFound) {
+			return nil, errors.PermissionError("VIEW_CHANNEL", guildID).WithOp(op)
+		}
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	channels, err := s.channels.ListByGuild(ctx, guildID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	ids := make([]uuid.UUID, 0, len(channels))
+	for i := range channels {
+		if channels[i].IsTextBased() || channels[i].CanHaveThreads() {
+			ids = append(ids, channels[i].ID)
+		}
+	}
+	if member.EffectivePermissions.IsAdmin() {
+		return ids, nil
+	}
+
+	roleIDs, err := s.guilds.ListMemberRoleIDs(ctx, guildID, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	overwrites, err := s.channels.ListOverwrites(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+
+	visible := ids[:0]
+	for _, id := range ids {
+		perms := models.ChannelPermissions(member.EffectivePermissions, id, member.UserID, roleIDs, overwrites)
+		if perms.Has(models.PermViewChannels) {
+			visible = append(visible, id)
+		}
+	}
+	return visible, nil
+}
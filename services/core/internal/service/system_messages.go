@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/google/uuid"
+)
+
+// SystemMessenger пишет системные сообщения (вход/выход участников, закрепы)
+// и рассылает их подписчикам канала как обычные message_created.
+type SystemMessenger struct {
+	messages repository.MessageRepository
+	hub      *hub.Hub
+}
+
+func NewSystemMessenger(messages repository.MessageRepository, hub *hub.Hub) *SystemMessenger {
+	return &SystemMessenger{messages: messages, hub: hub}
+}
+
+// PostToGuild пишет сообщение в системный канал гильдии.
+// Если канал не настроен — ничего не делает. Ошибки только логируются:
+// основное действие (вход, выход) уже выполнено и откатывать его нельзя.
+func (s *SystemMessenger) PostToGuild(ctx context.Context, guild *models.Guild, payload models.SystemPayload) {
+	if guild.SystemChannelID == nil {
+		return
+	}
+	s.Post(ctx, guild.RealmID, *guild.SystemChannelID, payload)
+}
+
+// Post пишет системное сообщение в конкретный канал. Автором считается ActorID.
+func (s *SystemMessenger) Post(ctx context.Context, realmID, channelID uuid.UUID, payload models.SystemPayload) {
+	log := logger.FromContext(ctx)
+
+	authorID, err := uuid.Parse(payload.ActorID)
+	if err != nil {
+		log.Warn("system message without valid actor", "type", payload.Type, "actor_id", payload.ActorID)
+		return
+	}
+
+	msg := &models.Message{
+		BaseEntity:  models.BaseEntity{RealmID: realmID},
+		ChannelID:   channelID,
+		AuthorID:    authorID,
+		ContentType: models.MessageContentTypeSystem,
+		Flags:       models.MessageFlagSystem,
+		System:      &payload,
+	}
+	if err := s.messages.Create(ctx, msg); err != nil {
+		log.Warn("failed to create system message", "type", payload.Type, "channel_id", channelID, "error", err)
+		return
+	}
+
+	s.hub.Publish(channelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessageCreated{
+			MessageCreated: MessageToProto(msg),
+		},
+	})
+}
+
+// systemPayloadToProto конвертирует данные системного сообщения в proto.
+func systemPayloadToProto(p *models.SystemPayload) *pb.SystemMessage {
+	return &pb.SystemMessage{
+		Type:     systemMessageTypes[p.Type],
+		ActorId:  p.ActorID,
+		TargetId: p.TargetID,
+		Params:   p.Params,
+	}
+}
+
+var systemMessageTypes = map[models.SystemMessageType]pb.SystemMessageType{
+	models.SystemMessageMemberJoin:  pb.SystemMessageType_SYSTEM_MESSAGE_TYPE_MEMBER_JOIN,
+	models.SystemMessageMemberLeave: pb.SystemMessageType_SYSTEM_MESSAGE_TYPE_MEMBER_LEAVE,
+	models.SystemMessageMemberKick:  pb.SystemMessageType_SYSTEM_MESSAGE_TYPE_MEMBER_KICK,
+	models.SystemMessageMessagePin:  pb.SystemMessageType_SYSTEM_MESSAGE_TYPE_MESSAGE_PIN,
+}
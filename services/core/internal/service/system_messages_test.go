@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+func TestSystemMessages_JoinAndKick(t *testing.T) {
+	env := newTestEnv(t)
+	owner := env.newUser(t, "owner")
+	player := env.newUser(t, "player")
+	guild, systemChannelID := env.newGuild(t, owner, "LAN Party")
+
+	env.join(t, guild, player)
+	if err := env.guilds.KickMember(env.ctx(owner), guild.ID.String(), owner, player); err != nil {
+		t.Fatalf("failed to kick: %v", err)
+	}
+
+	msgs := env.history(t, systemChannelID)
+	if len(msgs) != 2 {
+		t.Fatalf("expected join and kick messages, got %d", len(msgs))
+	}
+	want := []struct {
+		typ   models.SystemMessageType
+		actor string
+	}{
+		{models.SystemMessageMemberJoin, player},
+		{models.SystemMessageMemberKick, owner},
+	}
+	for i, msg := range msgs {
+		if !msg.Flags.Has(models.MessageFlagSystem) || msg.System == nil {
+			t.Fatalf("message %d is not a system message: %+v", i, msg)
+		}
+		if msg.System.Type != want[i].typ || msg.System.ActorID != want[i].actor {
+			t.Errorf("message %d: expected %s by %s, got %+v", i, want[i].typ, want[i].actor, msg.System)
+		}
+		if msg.Seq != int64(i+1) {
+			t.Errorf("message %d: expected seq %d, got %d", i, i+1, msg.Seq)
+		}
+	}
+	if msgs[1].System.TargetID != player {
+		t.Errorf("kick should target the player, got %q", msgs[1].System.TargetID)
+	}
+}
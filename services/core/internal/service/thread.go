@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/database"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ThreadService управляет ветками. Ветка — это Channel с Type == thread:
+// у неё свой Seq и история, сообщения в ней идут через ChatService.
+type ThreadService struct {
+	channels repository.ChannelRepository
+	messages repository.MessageRepository
+	guilds   repository.GuildRepository
+	tm       database.TransactionManager
+	hub      *hub.Hub
+}
+
+func NewThreadService(
+	channels repository.ChannelRepository,
+	messages repository.MessageRepository,
+	guilds repository.GuildRepository,
+	tm database.TransactionManager,
+	hub *hub.Hub,
+) *ThreadService {
+	return &ThreadService{channels: channels, messages: messages, guilds: guilds, tm: tm, hub: hub}
+}
+
+// guildMember загружает участника гильдии канала; не участник — нет доступа.
+func (s *ThreadService) guildMember(ctx context.Context, ch *models.Channel, userID, op string) (*models.GuildMember, error) {
+	if ch.IsDM() {
+		return nil, errors.ValidationError("channel_id", "Threads are not supported in direct messages").WithOp(op)
+	}
+	member, err := s.guilds.FindMember(ctx, ch.GuildIDString(), userID)
+	if err != nil {
+		if errors.Is(err, errors.ErrMemberNotFound) {
+			return nil, errors.PermissionError("VIEW_CHANNEL", ch.GuildIDString()).WithOp(op)
+		}
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return member, nil
+}
+
+// getThread загружает ветку; обычный канал с тем же ID считается ненайденным.
+func (s *ThreadService) getThread(ctx context.Context, threadID, op string) (*models.Channel, error) {
+	thread, err := s.channels.FindByID(ctx, threadID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if thread.Type != models.ChannelTypeThread {
+		return nil, errors.ErrChannelNotFound.WithOp(op).WithMsg("Thread not found")
+	}
+	return thread, nil
+}
+
+// StartThread создаёт ветку в канале. messageID опционален: без него ветку
+// можно создать только в канале типа thread_parent.
+func (s *ThreadService) StartThread(ctx context.Context, channelID, callerID, name, messageID string) (*models.Channel, error) {
+	const op = "ThreadService.StartThread"
+
+	if err := validator.ValidateChannelName(name); err != nil {
+		return nil, err.WithOp(op)
+	}
+
+	parent, err := s.channels.FindByID(ctx, channelID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if !parent.CanHaveThreads() {
+		return nil, errors.ValidationError("channel_id", "Threads are not supported in this channel").WithOp(op)
+	}
+	if messageID == "" && parent.Type != models.ChannelTypeThreadParent {
+		return nil, errors.ValidationError("message_id", "Threads in this channel must start from a message").WithOp(op)
+	}
+
+	member, err := s.guildMember(ctx, parent, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+	if member.IsTimedOut(time.Now()) {
+		return nil, errors.MemberTimedOut(*member.TimeoutUntil).WithOp(op)
+	}
+	if !member.EffectivePermissions.Can(models.PermSendMessages) {
+		return nil, errors.PermissionError("SEND_MESSAGES", parent.GuildIDString()).WithOp(op)
+	}
+
+	thread := &models.Channel{
+		BaseEntity:    models.BaseEntity{RealmID: parent.RealmID},
+		GuildID:       parent.GuildID,
+		Name:          strings.TrimSpace(name),
+		Type:          models.ChannelTypeThread,
+		ParentID:      &parent.ID,
+		ThreadOwnerID: &member.UserID,
+	}
+	if messageID != "" {
+		msg, err := s.messages.FindByID(ctx, messageID)
+		if err != nil {
+			return nil, errors.AsAppError(err).WithOp(op)
+		}
+		if msg.ChannelID != parent.ID {
+			return nil, errors.ValidationError("message_id", "Must reference a message in this channel").WithOp(op)
+		}
+		thread.StarterMessageID = &msg.ID
+	}
+
+	err = s.tm.Do(ctx, func(txCtx context.Context) error {
+		if err := s.channels.Create(txCtx, thread); err != nil {
+			return err
+		}
+		if thread.StarterMessageID != nil {
+			if err := s.messages.AttachThread(txCtx, messageID, thread.ID); err != nil {
+				return err
+			}
+		}
+		return s.channels.AddThreadMember(txCtx, &models.ThreadMember{
+			RealmID:  thread.RealmID,
+			ThreadID: thread.ID,
+			UserID:   member.UserID,
+		})
+	})
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+
+	s.hub.Publish(parent.ID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_ThreadCreated{ThreadCreated: ThreadToProto(thread)},
+	})
+	return thread, nil
+}
+
+// JoinThread добавляет вызывающего в участники ветки. Повторный вызов — не ошибка.
+func (s *ThreadService) JoinThread(ctx context.Context, threadID, callerID string) error {
+	const op = "ThreadService.JoinThread"
+
+	thread, err := s.getThread(ctx, threadID, op)
+	if err != nil {
+		return err
+	}
+	member, err := s.guildMember(ctx, thread, callerID, op)
+	if err != nil {
+		return err
+	}
+
+	err = s.channels.AddThreadMember(ctx, &models.ThreadMember{
+		RealmID:  thread.RealmID,
+		ThreadID: thread.ID,
+		UserID:   member.UserID,
+	})
+	if err != nil && !errors.Is(err, errors.ErrConflict) {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return nil
+}
+
+func (s *ThreadService) LeaveThread(ctx context.Context, threadID, callerID string) error {
+	const op = "ThreadService.LeaveThread"
+
+	if _, err := s.getThread(ctx, threadID, op); err != nil {
+		return err
+	}
+	if err := s.channels.RemoveThreadMember(ctx, threadID, callerID); err != nil {
+		return errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return nil
+}
+
+// ThreadUpdate — изменяемые поля ветки. nil — не менять.
+type ThreadUpdate struct {
+	Name     *string
+	Archived *bool
+	Locked   *bool
+}
+
+// UpdateThread переименовывает, архивирует или закрывает ветку.
+func (s *ThreadService) UpdateThread(ctx context.Context, threadID, callerID string, upd ThreadUpdate) (*models.Channel, error) {
+	const op = "ThreadService.UpdateThread"
+
+	thread, err := s.getThread(ctx, threadID, op)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.guildMember(ctx, thread, callerID, op)
+	if err != nil {
+		return nil, err
+	}
+
+	isModerator := member.EffectivePermissions.Can(models.PermManageThreads)
+	isOwner := thread.ThreadOwnerID != nil && *thread.ThreadOwnerID == member.UserID
+	// Закрытую ветку и сам замок трогают только модераторы
+	if !isModerator && (!isOwner || thread.IsLocked || upd.Locked != nil) {
+		return nil, errors.PermissionError("MANAGE_THREADS", thread.GuildIDString()).WithOp(op)
+	}
+
+	fields := make(map[string]any)
+	if upd.Name != nil {
+		if err := validator.ValidateChannelName(*upd.Name); err != nil {
+			return nil, err.WithOp(op)
+		}
+		fields["name"] = strings.TrimSpace(*upd.Name)
+	}
+	if upd.Archived != nil && *upd.Archived != thread.IsArchived {
+		fields["is_archived"] = *upd.Archived
+		if *upd.Archived {
+			fields["archived_at"] = time.Now()
+		} else {
+			fields["archived_at"] = nil
+		}
+	}
+	if upd.Locked != nil {
+		fields["is_locked"] = *upd.Locked
+	}
+	if len(fields) == 0 {
+		return thread, nil
+	}
+
+	if err := s.channels.Update(ctx, threadID, fields); err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	thread, err = s.getThread(ctx, threadID, op)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &pb.ChatEvent{
+		Payload: &pb.ChatEvent_ThreadUpdated{ThreadUpdated: ThreadToProto(thread)},
+	}
+	s.hub.Publish(thread.ParentID.String(), event)
+	s.hub.Publish(threadID, event)
+	return thread, nil
+}
+
+// ListActiveThreads возвращает неархивированные ветки канала.
+func (s *ThreadService) ListActiveThreads(ctx context.Context, channelID, callerID string) ([]models.Channel, error) {
+	const op = "ThreadService.ListActiveThreads"
+
+	parent, err := s.channels.FindByID(ctx, channelID)
+	if err != nil {
+		return nil, errors.AsAppError(err).WithOp(op)
+	}
+	if _, err := s.guildMember(ctx, parent, callerID, op); err != nil {
+		return nil, err
+	}
+
+	threads, err := s.channels.ListActiveThreads(ctx, channelID)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.ErrDBQueryFailed, op)
+	}
+	return threads, nil
+}
+
+// ThreadToProto конвертирует ветку в proto.
+func ThreadToProto(t *models.Channel) *pb.Thread {
+	thread := &pb.Thread{
+		Id:        t.ID.String(),
+		GuildId:   t.GuildIDString(),
+		Name:      t.Name,
+		Archived:  t.IsArchived,
+		Locked:    t.IsLocked,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+	if t.ParentID != nil {
+		thread.ParentId = t.ParentID.String()
+	}
+	if t.ThreadOwnerID != nil {
+		thread.OwnerId = t.ThreadOwnerID.String()
+	}
+	if t.StarterMessageID != nil {
+		thread.StarterMessageId = t.StarterMessageID.String()
+	}
+	if t.ArchivedAt != nil {
+		thread.ArchivedAt = timestamppb.New(*t.ArchivedAt)
+	}
+	return thread
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+)
+
+func TestSendTyping(t *testing.T) {
+	e := newTestEnv(t)
+	owner, alice, bob, stranger := e.newUser(t, "owner"), e.newUser(t, "alice"), e.newUser(t, "bob"), e.newUser(t, "stranger")
+	guild, general := e.newGuild(t, owner, "LAN")
+	e.join(t, guild, alice)
+	e.join(t, guild, bob)
+
+	t.Run("published to the channel except the typist", func(t *testing.T) {
+		own, unsubscribeOwn := e.hub.Subscribe(general, alice)
+		defer unsubscribeOwn()
+		others, unsubscribe := e.hub.Subscribe(general, owner)
+		defer unsubscribe()
+
+		if err := e.chat.SendTyping(e.ctx(alice), general, alice); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		select {
+		case ev := <-others:
+			typing := ev.GetTypingStarted()
+			if typing.GetUserId() != alice || typing.GetChannelId() != general || !typing.GetExpiresAt().AsTime().After(time.Now()) {
+				t.Errorf("unexpected typing event: %v", ev)
+			}
+		default:
+			t.Error("expected typing_started in the channel")
+		}
+		select {
+		case ev := <-own:
+			t.Errorf("expected no echo to the typist, got %v", ev)
+		default:
+		}
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		if err := e.chat.SendTyping(e.ctx(stranger), general, stranger); !hasCode(err, errors.CodePermMissing) {
+			t.Errorf("expected %s, got %v", errors.CodePermMissing, err)
+		}
+	})
+
+	t.Run("without SEND_MESSAGES", func(t *testing.T) {
+		err := e.db.Model(&models.GuildMember{}).Where("guild_id = ? AND user_id = ?", guild.ID, bob).
+			Update("effective_permissions", models.DefaultGuildPermissions&^models.PermSendMessages).Error
+		if err != nil {
+			t.Fatalf("failed to revoke permission: %v", err)
+		}
+		if err := e.chat.SendTyping(e.ctx(bob), general, bob); !hasCode(err, errors.CodePermMissing) {
+			t.Errorf("expected %s, got %v", errors.CodePermMissing, err)
+		}
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		until := time.Now().Add(time.Hour)
+		if _, err := e.guilds.TimeoutMember(e.ctx(owner), guild.ID.String(), owner, alice, &until); err != nil {
+			t.Fatalf("failed to time out: %v", err)
+		}
+		if err := e.chat.SendTyping(e.ctx(alice), general, alice); !hasCode(err, errors.CodeMemberTimedOut) {
+			t.Errorf("expected %s, got %v", errors.CodeMemberTimedOut, err)
+		}
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		var err error
+		for range typingBurst + 1 {
+			err = e.chat.SendTyping(e.ctx(owner), general, owner)
+		}
+		if !hasCode(err, errors.CodeRateLimited) {
+			t.Errorf("expected rate limit after %d events, got %v", typingBurst, err)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/logger"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/repository"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/unfurl"
+	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/markup"
+)
+
+const (
+	maxConcurrentUnfurls = 8                // Одновременно разворачиваемых сообщений
+	unfurlJobTimeout     = 30 * time.Second // На все ссылки одного сообщения
+)
+
+// Unfurler в фоне собирает превью ссылок из сообщений, сохраняет их
+// и рассылает message_updated. nil-Unfurler — превью выключены.
+type Unfurler struct {
+	fetcher  unfurl.Fetcher
+	messages repository.MessageRepository
+	hub      *hub.Hub
+	maxLinks int
+	sem      chan struct{}
+}
+
+func NewUnfurler(fetcher unfurl.Fetcher, messages repository.MessageRepository, hub *hub.Hub, maxLinks int) *Unfurler {
+	return &Unfurler{
+		fetcher:  fetcher,
+		messages: messages,
+		hub:      hub,
+		maxLinks: maxLinks,
+		sem:      make(chan struct{}, maxConcurrentUnfurls),
+	}
+}
+
+// Enqueue запускает разворачивание ссылок сообщения и сразу возвращает управление.
+// Если все слоты заняты, сообщение остаётся без превью: отправку это не тормозит.
+// copies — копии объявления в каналах-подписчиках: они получают те же превью
+// без повторных запросов по ссылкам.
+func (u *Unfurler) Enqueue(ctx context.Context, msg *models.Message, copies ...*models.Message) {
+	if u == nil || msg.ContentType == models.MessageContentTypeSystem {
+		return
+	}
+	links := markup.Links(markup.Parse(msg.Content), u.maxLinks)
+	// Ссылок не было и нет — трогать нечего; после правки старые превью нужно убрать
+	if len(links) == 0 && len(msg.Embeds) == 0 {
+		return
+	}
+
+	select {
+	case u.sem <- struct{}{}:
+	default:
+		logger.FromContext(ctx).Warn("unfurl queue is full, skipping", "message_id", msg.ID)
+		return
+	}
+
+	snapshot := *msg
+	copySnapshots := make([]models.Message, len(copies))
+	for i, c := range copies {
+		copySnapshots[i] = *c
+	}
+	// Запрос клиента уже завершён — живём своим контекстом, сохраняя логгер
+	jobCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), unfurlJobTimeout)
+	go func() {
+		defer func() { <-u.sem }()
+		defer cancel()
+		u.run(jobCtx, &snapshot, links, copySnapshots)
+	}()
+}
+
+func (u *Unfurler) run(ctx context.Context, msg *models.Message, links []string, copies []models.Message) {
+	log := logger.FromContext(ctx)
+
+	embeds := make([]models.Embed, 0, len(links))
+	for _, link := range links {
+		embed, err := u.fetcher.Fetch(ctx, link)
+		if err != nil {
+			log.Debug("failed to unfurl link", "message_id", msg.ID, "url", link, "error", err)
+			continue
+		}
+		if embed != nil {
+			embeds = append(embeds, *embed)
+		}
+	}
+	u.store(ctx, msg, embeds)
+	for i := range copies {
+		u.store(ctx, &copies[i], embeds)
+	}
+}
+
+// store сохраняет превью сообщения и рассылает message_updated в его канал.
+func (u *Unfurler) store(ctx context.Context, msg *models.Message, embeds []models.Embed) {
+	log := logger.FromContext(ctx)
+
+	if len(embeds) == 0 && len(msg.Embeds) == 0 {
+		return
+	}
+
+	// Сообщение могли поправить или удалить, пока мы ходили по ссылкам
+	stored, err := u.messages.SetEmbeds(ctx, msg.ID.String(), msg.EditVersion, embeds)
+	if err != nil {
+		log.Warn("failed to store embeds", "message_id", msg.ID, "error", err)
+		return
+	}
+	if !stored {
+		return
+	}
+
+	msg.Embeds = embeds
+	u.hub.Publish(msg.ChannelID.String(), &pb.ChatEvent{
+		Payload: &pb.ChatEvent_MessageUpdated{
+			MessageUpdated: MessageToProto(msg),
+		},
+	})
+}
@@ -40,6 +40,7 @@ func (s *UserService) GetProfile(ctx context.Context, userID string) (*models.Us
 			ID:        u.ID.String(),
 			Username:  u.Username,
 			AvatarURL: u.AvatarURL,
+			DMPolicy:  string(u.DMPolicy),
 		}, nil
 	})
 
@@ -58,17 +59,25 @@ func (s *UserService) GetProfile(ctx context.Context, userID string) (*models.Us
 		},
 		Username:  dto.Username,
 		AvatarURL: dto.AvatarURL,
+		DMPolicy:  models.DMPolicy(dto.DMPolicy),
 		// Поля, которых нет в кэше, оставляем пустыми или заполняем дефолтами
 		// IsOnline: calculated elsewhere
 	}, nil
 }
 
-func (s *UserService) UpdateProfile(ctx context.Context, userID string, nickname, bio, avatar *string) (*models.User, error) {
+func (s *UserService) UpdateProfile(ctx context.Context, userID string, nickname, bio, avatar *string, dmPolicy *models.DMPolicy) (*models.User, error) {
 	const op = "UserService.UpdateProfile"
 
 	if nickname != nil && (len(*nickname) < 3 || len(*nickname) > 32) {
 		return nil, errors.ValidationError("nickname", "Length must be 3-32").WithOp(op)
 	}
+	if dmPolicy != nil {
+		switch *dmPolicy {
+		case models.DMPolicyEveryone, models.DMPolicyGuildMembers, models.DMPolicyNobody:
+		default:
+			return nil, errors.ValidationError("dm_policy", "Unknown policy").WithOp(op)
+		}
+	}
 
 	fields := make(map[string]any)
 	// Обновляем только то, что пришло (не nil)
@@ -82,6 +91,9 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID string, nickname
 	if avatar != nil {
 		fields["avatar_url"] = *avatar
 	}
+	if dmPolicy != nil {
+		fields["dm_policy"] = *dmPolicy
+	}
 
 	// Если есть изменения — пишем в БД
 	if len(fields) > 0 {
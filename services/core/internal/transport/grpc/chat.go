@@ -2,12 +2,18 @@ package grpc_transport
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/hub"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
 	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
 	util "github.com/KitsuLAN/KitsuLAN/services/core/pkg/utill"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ChatServer struct {
@@ -21,23 +27,357 @@ func NewChatServer(svc *service.ChatService) *ChatServer {
 
 func (s *ChatServer) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (*pb.SendMessageResponse, error) {
 	callerID := middleware.MustUserID(ctx)
-	msg, err := s.svc.SendMessage(ctx, req.ChannelId, callerID, req.Content)
+	msg, err := s.svc.SendMessage(ctx, service.SendMessageParams{
+		ChannelID: req.ChannelId,
+		AuthorID:  callerID,
+		Content:   req.Content,
+		ReplyToID: req.ReplyToMessageId,
+		Markdown:  req.Markdown,
+		Nonce:     req.Nonce,
+
+		Components: service.ComponentsFromProto(req.Components),
+	})
 	if err != nil {
 		return nil, domainerr.ToGRPC(err)
 	}
 	return &pb.SendMessageResponse{Message: service.MessageToProto(msg)}, nil
 }
 
+func (s *ChatServer) EditMessage(ctx context.Context, req *pb.EditMessageRequest) (*pb.EditMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	msg, err := s.svc.EditMessage(ctx, req.MessageId, callerID, req.Content)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.EditMessageResponse{Message: service.MessageToProto(msg)}, nil
+}
+
+func (s *ChatServer) ListMessageEdits(ctx context.Context, req *pb.ListMessageEditsRequest) (*pb.ListMessageEditsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	edits, err := s.svc.ListMessageEdits(ctx, req.MessageId, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListMessageEditsResponse{Edits: util.Map(edits, messageEditToProto)}, nil
+}
+
+func (s *ChatServer) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.DeleteMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.DeleteMessageResponse{}, domainerr.ToGRPC(s.svc.DeleteMessage(ctx, req.MessageId, callerID, req.Reason))
+}
+
+func (s *ChatServer) BulkDeleteMessages(ctx context.Context, req *pb.BulkDeleteMessagesRequest) (*pb.BulkDeleteMessagesResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	filter := service.BulkDelete{
+		MessageIDs: req.MessageIds,
+		AuthorID:   req.AuthorId,
+		LastN:      int(req.LastN),
+		Reason:     req.Reason,
+	}
+	if req.After != nil {
+		after := req.After.AsTime()
+		filter.After = &after
+	}
+	if req.Before != nil {
+		before := req.Before.AsTime()
+		filter.Before = &before
+	}
+
+	deleted, err := s.svc.BulkDeleteMessages(ctx, req.ChannelId, callerID, filter)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	ids := make([]string, len(deleted))
+	for i, id := range deleted {
+		ids[i] = id.String()
+	}
+	return &pb.BulkDeleteMessagesResponse{DeletedMessageIds: ids}, nil
+}
+
+func (s *ChatServer) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.AddReactionResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.AddReactionResponse{}, domainerr.ToGRPC(s.svc.AddReaction(ctx, req.MessageId, callerID, req.Emoji))
+}
+
+func (s *ChatServer) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.RemoveReactionResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.RemoveReactionResponse{}, domainerr.ToGRPC(s.svc.RemoveReaction(ctx, req.MessageId, callerID, req.UserId, req.Emoji))
+}
+
+func (s *ChatServer) RemoveAllReactions(ctx context.Context, req *pb.RemoveAllReactionsRequest) (*pb.RemoveAllReactionsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.RemoveAllReactionsResponse{}, domainerr.ToGRPC(s.svc.RemoveAllReactions(ctx, req.MessageId, callerID, req.Emoji))
+}
+
+func (s *ChatServer) ListReactors(ctx context.Context, req *pb.ListReactorsRequest) (*pb.ListReactorsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	users, err := s.svc.ListReactors(ctx, req.MessageId, callerID, req.Emoji, int(req.Limit), req.AfterUserId)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListReactorsResponse{Users: util.Map(users, userToProto)}, nil
+}
+
+func (s *ChatServer) PinMessage(ctx context.Context, req *pb.PinMessageRequest) (*pb.PinMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.PinMessageResponse{}, domainerr.ToGRPC(s.svc.PinMessage(ctx, req.MessageId, callerID))
+}
+
+func (s *ChatServer) UnpinMessage(ctx context.Context, req *pb.UnpinMessageRequest) (*pb.UnpinMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.UnpinMessageResponse{}, domainerr.ToGRPC(s.svc.UnpinMessage(ctx, req.MessageId, callerID))
+}
+
+func (s *ChatServer) ListPinnedMessages(ctx context.Context, req *pb.ListPinnedMessagesRequest) (*pb.ListPinnedMessagesResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	msgs, err := s.svc.ListPinnedMessages(ctx, req.ChannelId, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListPinnedMessagesResponse{Messages: util.Map(msgs, service.MessageToProto)}, nil
+}
+
+func (s *ChatServer) SendTyping(ctx context.Context, req *pb.SendTypingRequest) (*pb.SendTypingResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.SendTypingResponse{}, domainerr.ToGRPC(s.svc.SendTyping(ctx, req.ChannelId, callerID))
+}
+
+func (s *ChatServer) Ack(ctx context.Context, req *pb.AckRequest) (*pb.AckResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	lastReadSeq, err := s.svc.Ack(ctx, req.ChannelId, callerID, req.Seq)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.AckResponse{LastReadSeq: lastReadSeq}, nil
+}
+
+func (s *ChatServer) GetUnreadSummary(ctx context.Context, _ *pb.GetUnreadSummaryRequest) (*pb.GetUnreadSummaryResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	summary, err := s.svc.GetUnreadSummary(ctx, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+
+	return &pb.GetUnreadSummaryResponse{
+		Channels: util.Map(summary.Channels, func(c *models.ChannelUnread) *pb.ChannelUnread {
+			unread := &pb.ChannelUnread{
+				ChannelId:    c.ChannelID.String(),
+				LastReadSeq:  c.LastReadSeq,
+				LastSeq:      c.LastSeq,
+				UnreadCount:  c.UnreadCount,
+				MentionCount: c.MentionCount,
+			}
+			if c.GuildID != uuid.Nil {
+				unread.GuildId = c.GuildID.String()
+			}
+			return unread
+		}),
+		Guilds: util.Map(summary.Guilds, func(g *service.GuildUnread) *pb.GuildUnread {
+			return &pb.GuildUnread{
+				GuildId:      g.GuildID.String(),
+				UnreadCount:  g.UnreadCount,
+				MentionCount: g.MentionCount,
+			}
+		}),
+	}, nil
+}
+
+func (s *ChatServer) ListRecentMentions(ctx context.Context, req *pb.ListRecentMentionsRequest) (*pb.ListRecentMentionsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	var before *time.Time
+	if req.Before != nil {
+		t := req.Before.AsTime()
+		before = &t
+	}
+	msgs, err := s.svc.ListRecentMentions(ctx, callerID, req.GuildId, int(req.Limit), before)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListRecentMentionsResponse{Messages: util.Map(msgs, service.MessageToProto)}, nil
+}
+
+func (s *ChatServer) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	params := service.SearchParams{
+		GuildID:       req.GuildId,
+		ChannelID:     req.ChannelId,
+		Query:         req.Query,
+		AuthorID:      req.AuthorId,
+		HasAttachment: req.HasAttachment,
+		HasLink:       req.HasLink,
+		Pinned:        req.Pinned,
+		MentionsMe:    req.MentionsMe,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+	if req.After != nil {
+		t := req.After.AsTime()
+		params.After = &t
+	}
+	if req.Before != nil {
+		t := req.Before.AsTime()
+		params.Before = &t
+	}
+
+	msgs, hasMore, err := s.svc.SearchMessages(ctx, callerID, params)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.SearchMessagesResponse{
+		Messages: util.Map(msgs, service.MessageToProto),
+		HasMore:  hasMore,
+	}, nil
+}
+
+func (s *ChatServer) InteractWithComponent(ctx context.Context, req *pb.InteractWithComponentRequest) (*pb.InteractWithComponentResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	result, err := s.svc.InteractWithComponent(ctx, req.MessageId, callerID, req.CustomId, req.Values)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+
+	return &pb.InteractWithComponentResponse{
+		Type:    interactionResponseTypes[result.Type],
+		Message: service.MessageToProto(result.Message),
+	}, nil
+}
+
+func (s *ChatServer) RespondToInteraction(ctx context.Context, req *pb.RespondToInteractionRequest) (*pb.RespondToInteractionResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	var respType service.InteractionResponseType
+	for t, pt := range interactionResponseTypes {
+		if pt == req.Type {
+			respType = t
+		}
+	}
+	err := s.svc.RespondToInteraction(ctx, callerID, service.InteractionResponse{
+		InteractionID: req.InteractionId,
+		Token:         req.Token,
+		Type:          respType,
+		Content:       req.Content,
+		Markdown:      req.Markdown,
+		Components:    service.ComponentsFromProto(req.Components),
+	})
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.RespondToInteractionResponse{}, nil
+}
+
+var interactionResponseTypes = map[service.InteractionResponseType]pb.InteractionResponseType{
+	service.InteractionEphemeralReply: pb.InteractionResponseType_INTERACTION_RESPONSE_TYPE_EPHEMERAL_REPLY,
+	service.InteractionUpdateMessage:  pb.InteractionResponseType_INTERACTION_RESPONSE_TYPE_UPDATE_MESSAGE,
+}
+
+func (s *ChatServer) SendEphemeralMessage(ctx context.Context, req *pb.SendEphemeralMessageRequest) (*pb.SendEphemeralMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	msg, err := s.svc.SendEphemeral(ctx, service.SendEphemeralParams{
+		ChannelID:    req.ChannelId,
+		AuthorID:     callerID,
+		TargetUserID: req.UserId,
+		Content:      req.Content,
+		Markdown:     req.Markdown,
+	})
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.SendEphemeralMessageResponse{Message: service.MessageToProto(msg)}, nil
+}
+
+func (s *ChatServer) ScheduleMessage(ctx context.Context, req *pb.ScheduleMessageRequest) (*pb.ScheduleMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	sm, err := s.svc.ScheduleMessage(ctx, service.ScheduleMessageParams{
+		ChannelID: req.ChannelId,
+		AuthorID:  callerID,
+		Content:   req.Content,
+		Markdown:  req.Markdown,
+		SendAt:    req.SendAt.AsTime(),
+	})
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ScheduleMessageResponse{ScheduledMessage: service.ScheduledMessageToProto(sm)}, nil
+}
+
+func (s *ChatServer) ListScheduledMessages(ctx context.Context, req *pb.ListScheduledMessagesRequest) (*pb.ListScheduledMessagesResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	list, err := s.svc.ListScheduledMessages(ctx, req.ChannelId, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListScheduledMessagesResponse{ScheduledMessages: util.Map(list, service.ScheduledMessageToProto)}, nil
+}
+
+func (s *ChatServer) CancelScheduledMessage(ctx context.Context, req *pb.CancelScheduledMessageRequest) (*pb.CancelScheduledMessageResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.CancelScheduledMessageResponse{}, domainerr.ToGRPC(s.svc.CancelScheduledMessage(ctx, req.ScheduledMessageId, callerID))
+}
+
+func (s *ChatServer) FollowChannel(ctx context.Context, req *pb.FollowChannelRequest) (*pb.FollowChannelResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	follow, err := s.svc.FollowChannel(ctx, req.ChannelId, req.TargetChannelId, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.FollowChannelResponse{Follow: service.ChannelFollowToProto(follow)}, nil
+}
+
+func (s *ChatServer) UnfollowChannel(ctx context.Context, req *pb.UnfollowChannelRequest) (*pb.UnfollowChannelResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.UnfollowChannelResponse{}, domainerr.ToGRPC(s.svc.UnfollowChannel(ctx, req.ChannelId, req.TargetChannelId, callerID))
+}
+
+func (s *ChatServer) ListChannelFollows(ctx context.Context, req *pb.ListChannelFollowsRequest) (*pb.ListChannelFollowsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	following, followers, err := s.svc.ListChannelFollows(ctx, req.ChannelId, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListChannelFollowsResponse{
+		Following: util.Map(following, service.ChannelFollowToProto),
+		Followers: util.Map(followers, service.ChannelFollowToProto),
+	}, nil
+}
+
 func (s *ChatServer) GetHistory(ctx context.Context, req *pb.GetHistoryRequest) (*pb.GetHistoryResponse, error) {
 	callerID := middleware.MustUserID(ctx)
-	msgs, hasMore, err := s.svc.GetHistory(ctx, req.ChannelId, callerID, int(req.Limit), req.BeforeMessageId)
+	page, err := s.svc.GetHistory(ctx, service.HistoryParams{
+		ChannelID:       req.ChannelId,
+		CallerID:        callerID,
+		Limit:           int(req.Limit),
+		BeforeMessageID: req.BeforeMessageId,
+		BeforeSeq:       req.BeforeSeq,
+		AfterSeq:        req.AfterSeq,
+		AroundMessageID: req.AroundMessageId,
+	})
 	if err != nil {
 		return nil, domainerr.ToGRPC(err)
 	}
 
 	return &pb.GetHistoryResponse{
-		HasMore:  hasMore,
-		Messages: util.Map(msgs, service.MessageToProto),
+		HasMore:      page.HasMore,
+		HasMoreAfter: page.HasMoreAfter,
+		SyncSeq:      page.SyncSeq,
+		Messages:     util.Map(page.Messages, service.MessageToProto),
+	}, nil
+}
+
+func (s *ChatServer) SyncChannel(ctx context.Context, req *pb.SyncChannelRequest) (*pb.SyncChannelResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	res, err := s.svc.SyncChannel(ctx, req.ChannelId, callerID, req.SyncSeq, int(req.Limit))
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	deleted := make([]string, len(res.DeletedIDs))
+	for i, id := range res.DeletedIDs {
+		deleted[i] = id.String()
+	}
+
+	return &pb.SyncChannelResponse{
+		Messages:          util.Map(res.Messages, service.MessageToProto),
+		DeletedMessageIds: deleted,
+		SyncSeq:           res.SyncSeq,
+		HasMore:           res.HasMore,
 	}, nil
 }
 
@@ -49,7 +389,20 @@ func (s *ChatServer) SubscribeChannel(req *pb.SubscribeChannelRequest, stream pb
 
 	events, unsubscribe := s.svc.Hub().Subscribe(req.ChannelId, callerID)
 	defer unsubscribe()
+	return pumpEvents(stream, events)
+}
+
+// SubscribeUserEvents — личные события пользователя, общие для всех его устройств.
+func (s *ChatServer) SubscribeUserEvents(_ *pb.SubscribeUserEventsRequest, stream pb.ChatService_SubscribeUserEventsServer) error {
+	callerID := middleware.MustUserID(stream.Context())
+
+	events, unsubscribe := s.svc.Hub().Subscribe(hub.UserTopic(callerID), callerID)
+	defer unsubscribe()
+	return pumpEvents(stream, events)
+}
 
+// pumpEvents пересылает события хаба в стрим, пока клиент подключён.
+func pumpEvents(stream grpc.ServerStreamingServer[pb.ChatEvent], events <-chan *pb.ChatEvent) error {
 	for {
 		select {
 		case event, ok := <-events:
@@ -64,3 +417,14 @@ func (s *ChatServer) SubscribeChannel(req *pb.SubscribeChannelRequest, stream pb
 		}
 	}
 }
+
+func messageEditToProto(e *models.MessageEdit) *pb.MessageEdit {
+	return &pb.MessageEdit{
+		Id:          e.ID.String(),
+		MessageId:   e.MessageID.String(),
+		EditorId:    e.EditorID.String(),
+		Content:     e.Content,
+		EditVersion: uint32(e.EditVersion),
+		EditedAt:    timestamppb.New(e.CreatedAt),
+	}
+}
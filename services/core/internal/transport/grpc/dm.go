@@ -0,0 +1,61 @@
+package grpc_transport
+
+import (
+	"context"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	util "github.com/KitsuLAN/KitsuLAN/services/core/pkg/utill"
+)
+
+type DMServer struct {
+	pb.UnimplementedDMServiceServer
+	svc *service.DMService
+}
+
+func NewDMServer(svc *service.DMService) *DMServer {
+	return &DMServer{svc: svc}
+}
+
+func (s *DMServer) OpenDM(ctx context.Context, req *pb.OpenDMRequest) (*pb.OpenDMResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	ch, err := s.svc.OpenDM(ctx, callerID, req.UserIds, req.Name)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.OpenDMResponse{Channel: service.DMChannelToProto(ch)}, nil
+}
+
+func (s *DMServer) ListMyDMs(ctx context.Context, _ *pb.ListMyDMsRequest) (*pb.ListMyDMsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	channels, err := s.svc.ListMyDMs(ctx, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListMyDMsResponse{Channels: util.Map(channels, service.DMChannelToProto)}, nil
+}
+
+func (s *DMServer) AddDMRecipient(ctx context.Context, req *pb.AddDMRecipientRequest) (*pb.AddDMRecipientResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	ch, err := s.svc.AddRecipient(ctx, req.ChannelId, callerID, req.UserId)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.AddDMRecipientResponse{Channel: service.DMChannelToProto(ch)}, nil
+}
+
+func (s *DMServer) RemoveDMRecipient(ctx context.Context, req *pb.RemoveDMRecipientRequest) (*pb.RemoveDMRecipientResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.RemoveDMRecipientResponse{}, domainerr.ToGRPC(s.svc.RemoveRecipient(ctx, req.ChannelId, callerID, req.UserId))
+}
+
+func (s *DMServer) RenameGroupDM(ctx context.Context, req *pb.RenameGroupDMRequest) (*pb.RenameGroupDMResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	ch, err := s.svc.RenameGroupDM(ctx, req.ChannelId, callerID, req.Name)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.RenameGroupDMResponse{Channel: service.DMChannelToProto(ch)}, nil
+}
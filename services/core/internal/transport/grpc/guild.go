@@ -2,6 +2,7 @@ package grpc_transport
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
@@ -9,7 +10,7 @@ import (
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
 	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
 	util "github.com/KitsuLAN/KitsuLAN/services/core/pkg/utill"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type GuildServer struct {
@@ -27,7 +28,7 @@ func (s *GuildServer) CreateGuild(ctx context.Context, req *pb.CreateGuildReques
 	if err != nil {
 		return nil, domainerr.ToGRPC(err)
 	}
-	return &pb.CreateGuildResponse{Guild: guildToProto(guild, 1)}, nil
+	return &pb.CreateGuildResponse{Guild: service.GuildToProto(guild, 1)}, nil
 }
 
 func (s *GuildServer) GetGuild(ctx context.Context, req *pb.GetGuildRequest) (*pb.GetGuildResponse, error) {
@@ -36,7 +37,20 @@ func (s *GuildServer) GetGuild(ctx context.Context, req *pb.GetGuildRequest) (*p
 	if err != nil {
 		return nil, domainerr.ToGRPC(err)
 	}
-	return &pb.GetGuildResponse{Guild: guildToProto(guild, 0)}, nil
+	return &pb.GetGuildResponse{Guild: service.GuildToProto(guild, 0)}, nil
+}
+
+func (s *GuildServer) UpdateGuild(ctx context.Context, req *pb.UpdateGuildRequest) (*pb.UpdateGuildResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	upd, err := guildUpdateFromProto(req.Guild, req.UpdateMask)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	guild, err := s.svc.UpdateGuild(ctx, req.GuildId, callerID, uint(req.Version), upd)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.UpdateGuildResponse{Guild: service.GuildToProto(guild, 0)}, nil
 }
 
 func (s *GuildServer) ListMyGuilds(ctx context.Context, _ *pb.ListMyGuildsRequest) (*pb.ListMyGuildsResponse, error) {
@@ -46,7 +60,7 @@ func (s *GuildServer) ListMyGuilds(ctx context.Context, _ *pb.ListMyGuildsReques
 		return nil, domainerr.ToGRPC(err)
 	}
 	return &pb.ListMyGuildsResponse{
-		Guilds: util.Map(guilds, func(g *models.Guild) *pb.Guild { return guildToProto(g, 0) }),
+		Guilds: util.Map(guilds, func(g *models.Guild) *pb.Guild { return service.GuildToProto(g, 0) }),
 	}, nil
 }
 
@@ -73,7 +87,7 @@ func (s *GuildServer) JoinByInvite(ctx context.Context, req *pb.JoinByInviteRequ
 	if err != nil {
 		return nil, domainerr.ToGRPC(err)
 	}
-	return &pb.JoinByInviteResponse{Guild: guildToProto(guild, 0)}, nil
+	return &pb.JoinByInviteResponse{Guild: service.GuildToProto(guild, 0)}, nil
 }
 
 func (s *GuildServer) LeaveGuild(ctx context.Context, req *pb.LeaveGuildRequest) (*pb.LeaveGuildResponse, error) {
@@ -81,6 +95,11 @@ func (s *GuildServer) LeaveGuild(ctx context.Context, req *pb.LeaveGuildRequest)
 	return &pb.LeaveGuildResponse{}, domainerr.ToGRPC(s.svc.LeaveGuild(ctx, req.GuildId, callerID))
 }
 
+func (s *GuildServer) KickMember(ctx context.Context, req *pb.KickMemberRequest) (*pb.KickMemberResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.KickMemberResponse{}, domainerr.ToGRPC(s.svc.KickMember(ctx, req.GuildId, callerID, req.UserId))
+}
+
 func (s *GuildServer) CreateChannel(ctx context.Context, req *pb.CreateChannelRequest) (*pb.CreateChannelResponse, error) {
 	callerID := middleware.MustUserID(ctx)
 	chType := protoToChannelType(req.Type)
@@ -114,39 +133,102 @@ func (s *GuildServer) ListMembers(ctx context.Context, req *pb.ListMembersReques
 		return nil, domainerr.ToGRPC(err)
 	}
 
-	pbMembers := util.Map(members, func(m *models.GuildMember) *pb.Member {
-		return &pb.Member{
-			UserId:    m.UserID.String(),
-			Username:  m.User.Username,
-			AvatarUrl: m.User.AvatarURL,
-			Nickname:  m.Nickname,
-			IsOnline:  m.IsOnline,
-			JoinedAt:  timestamppb.New(m.JoinedAt),
-		}
+	return &pb.ListMembersResponse{Members: util.Map(members, service.MemberToProto)}, nil
+}
+
+func (s *GuildServer) UpdateMyMember(ctx context.Context, req *pb.UpdateMyMemberRequest) (*pb.UpdateMemberResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	member, err := s.svc.UpdateMyMember(ctx, req.GuildId, callerID, service.MemberUpdate{
+		Nickname:  req.Nickname,
+		AvatarURL: req.AvatarUrl,
+	})
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.UpdateMemberResponse{Member: service.MemberToProto(member)}, nil
+}
+
+func (s *GuildServer) UpdateMember(ctx context.Context, req *pb.UpdateMemberRequest) (*pb.UpdateMemberResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	member, err := s.svc.UpdateMember(ctx, req.GuildId, callerID, req.UserId, service.MemberUpdate{
+		Nickname:  req.Nickname,
+		AvatarURL: req.AvatarUrl,
 	})
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.UpdateMemberResponse{Member: service.MemberToProto(member)}, nil
+}
 
-	return &pb.ListMembersResponse{Members: pbMembers}, nil
+func (s *GuildServer) TimeoutMember(ctx context.Context, req *pb.TimeoutMemberRequest) (*pb.TimeoutMemberResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	var until *time.Time
+	if req.Until != nil {
+		t := req.Until.AsTime()
+		until = &t
+	}
+	member, err := s.svc.TimeoutMember(ctx, req.GuildId, callerID, req.UserId, until)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.TimeoutMemberResponse{Member: service.MemberToProto(member)}, nil
+}
+
+func (s *GuildServer) SetMemberVoiceState(ctx context.Context, req *pb.SetMemberVoiceStateRequest) (*pb.SetMemberVoiceStateResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	member, err := s.svc.SetMemberVoiceState(ctx, req.GuildId, callerID, req.UserId, req.Muted, req.Deafened)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.SetMemberVoiceStateResponse{Member: service.MemberToProto(member)}, nil
 }
 
 // --- converters ---
 
-func guildToProto(g *models.Guild, memberCount int32) *pb.Guild {
-	return &pb.Guild{
-		Id:          g.ID.String(),
-		Name:        g.Name,
-		Description: g.Description,
-		IconUrl:     g.IconURL,
-		Color:       g.Color,
-		OwnerId:     g.OwnerID.String(),
-		MemberCount: memberCount,
-		CreatedAt:   timestamppb.New(g.CreatedAt),
+// guildUpdateFromProto переводит update_mask в service.GuildUpdate.
+func guildUpdateFromProto(g *pb.Guild, mask *fieldmaskpb.FieldMask) (service.GuildUpdate, error) {
+	var upd service.GuildUpdate
+	if len(mask.GetPaths()) == 0 {
+		return upd, domainerr.ValidationError("update_mask", "At least one field path is required")
+	}
+	if g == nil {
+		g = &pb.Guild{}
+	}
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "name":
+			upd.Name = &g.Name
+		case "description":
+			upd.Description = &g.Description
+		case "icon_url":
+			upd.IconURL = &g.IconUrl
+		case "banner_url":
+			upd.BannerURL = &g.BannerUrl
+		case "splash_url":
+			upd.SplashURL = &g.SplashUrl
+		case "color":
+			upd.Color = &g.Color
+		case "system_channel_id":
+			upd.SystemChannelID = &g.SystemChannelId
+		case "rules_channel_id":
+			upd.RulesChannelID = &g.RulesChannelId
+		case "afk_channel_id":
+			upd.AFKChannelID = &g.AfkChannelId
+		case "afk_timeout":
+			timeout := int(g.AfkTimeout)
+			upd.AFKTimeout = &timeout
+		default:
+			return upd, domainerr.ValidationError("update_mask", "Unknown or read-only field: "+path)
+		}
 	}
+	return upd, nil
 }
 
 func channelToProto(ch *models.Channel) *pb.Channel {
 	return &pb.Channel{
 		Id:       ch.ID.String(),
-		GuildId:  ch.GuildID.String(),
+		GuildId:  ch.GuildIDString(),
 		Name:     ch.Name,
 		Type:     channelTypeToProto(ch.Type),
 		Position: int32(ch.Position),
@@ -154,15 +236,21 @@ func channelToProto(ch *models.Channel) *pb.Channel {
 }
 
 func channelTypeToProto(t models.ChannelType) pb.ChannelType {
-	if t == models.ChannelTypeVoice {
+	switch t {
+	case models.ChannelTypeVoice:
 		return pb.ChannelType_CHANNEL_TYPE_VOICE
+	case models.ChannelTypeAnnouncement:
+		return pb.ChannelType_CHANNEL_TYPE_ANNOUNCEMENT
 	}
 	return pb.ChannelType_CHANNEL_TYPE_TEXT
 }
 
 func protoToChannelType(t pb.ChannelType) models.ChannelType {
-	if t == pb.ChannelType_CHANNEL_TYPE_VOICE {
+	switch t {
+	case pb.ChannelType_CHANNEL_TYPE_VOICE:
 		return models.ChannelTypeVoice
+	case pb.ChannelType_CHANNEL_TYPE_ANNOUNCEMENT:
+		return models.ChannelTypeAnnouncement
 	}
 	return models.ChannelTypeText
 }
@@ -0,0 +1,43 @@
+package grpc_transport
+
+import (
+	"testing"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestGuildUpdateFromProto(t *testing.T) {
+	guild := &pb.Guild{Name: "LAN", AfkTimeout: 300}
+
+	t.Run("maps only masked fields", func(t *testing.T) {
+		upd, err := guildUpdateFromProto(guild, &fieldmaskpb.FieldMask{Paths: []string{"name", "afk_timeout", "system_channel_id"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if upd.Name == nil || *upd.Name != "LAN" || upd.AFKTimeout == nil || *upd.AFKTimeout != 300 {
+			t.Errorf("unexpected update: name=%v afk_timeout=%v", upd.Name, upd.AFKTimeout)
+		}
+		// Пустое значение в маске — сброс ссылки на канал
+		if upd.SystemChannelID == nil || *upd.SystemChannelID != "" {
+			t.Errorf("expected system_channel_id to be cleared, got %v", upd.SystemChannelID)
+		}
+		if upd.Description != nil || upd.IconURL != nil || upd.AFKChannelID != nil {
+			t.Error("expected fields outside the mask to stay nil")
+		}
+	})
+
+	for name, paths := range map[string][]string{
+		"empty mask":      nil,
+		"unknown path":    {"name", "nonsense"},
+		"read-only field": {"owner_id"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := guildUpdateFromProto(guild, &fieldmaskpb.FieldMask{Paths: paths})
+			if err == nil || domainerr.AsAppError(err).Code != domainerr.CodeBadRequest {
+				t.Errorf("expected validation error, got %v", err)
+			}
+		})
+	}
+}
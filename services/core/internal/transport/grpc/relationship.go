@@ -0,0 +1,76 @@
+package grpc_transport
+
+import (
+	"context"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	util "github.com/KitsuLAN/KitsuLAN/services/core/pkg/utill"
+)
+
+type RelationshipServer struct {
+	pb.UnimplementedRelationshipServiceServer
+	svc *service.RelationshipService
+}
+
+func NewRelationshipServer(svc *service.RelationshipService) *RelationshipServer {
+	return &RelationshipServer{svc: svc}
+}
+
+func (s *RelationshipServer) ListRelationships(ctx context.Context, _ *pb.ListRelationshipsRequest) (*pb.ListRelationshipsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	rels, err := s.svc.ListRelationships(ctx, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListRelationshipsResponse{Relationships: util.Map(rels, service.RelationshipToProto)}, nil
+}
+
+func (s *RelationshipServer) SendFriendRequest(ctx context.Context, req *pb.SendFriendRequestRequest) (*pb.SendFriendRequestResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	rel, err := s.svc.SendFriendRequest(ctx, callerID, req.UserId)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.SendFriendRequestResponse{Relationship: service.RelationshipToProto(rel)}, nil
+}
+
+func (s *RelationshipServer) AcceptFriendRequest(ctx context.Context, req *pb.AcceptFriendRequestRequest) (*pb.AcceptFriendRequestResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	rel, err := s.svc.AcceptFriendRequest(ctx, callerID, req.UserId)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.AcceptFriendRequestResponse{Relationship: service.RelationshipToProto(rel)}, nil
+}
+
+func (s *RelationshipServer) DeclineFriendRequest(ctx context.Context, req *pb.DeclineFriendRequestRequest) (*pb.DeclineFriendRequestResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.DeclineFriendRequestResponse{}, domainerr.ToGRPC(s.svc.DeclineFriendRequest(ctx, callerID, req.UserId))
+}
+
+func (s *RelationshipServer) CancelFriendRequest(ctx context.Context, req *pb.CancelFriendRequestRequest) (*pb.CancelFriendRequestResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.CancelFriendRequestResponse{}, domainerr.ToGRPC(s.svc.CancelFriendRequest(ctx, callerID, req.UserId))
+}
+
+func (s *RelationshipServer) RemoveFriend(ctx context.Context, req *pb.RemoveFriendRequest) (*pb.RemoveFriendResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.RemoveFriendResponse{}, domainerr.ToGRPC(s.svc.RemoveFriend(ctx, callerID, req.UserId))
+}
+
+func (s *RelationshipServer) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	rel, err := s.svc.BlockUser(ctx, callerID, req.UserId)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.BlockUserResponse{Relationship: service.RelationshipToProto(rel)}, nil
+}
+
+func (s *RelationshipServer) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.UnblockUserResponse{}, domainerr.ToGRPC(s.svc.UnblockUser(ctx, callerID, req.UserId))
+}
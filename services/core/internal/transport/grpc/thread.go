@@ -0,0 +1,61 @@
+package grpc_transport
+
+import (
+	"context"
+
+	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
+	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
+	util "github.com/KitsuLAN/KitsuLAN/services/core/pkg/utill"
+)
+
+type ThreadServer struct {
+	pb.UnimplementedThreadServiceServer
+	svc *service.ThreadService
+}
+
+func NewThreadServer(svc *service.ThreadService) *ThreadServer {
+	return &ThreadServer{svc: svc}
+}
+
+func (s *ThreadServer) StartThread(ctx context.Context, req *pb.StartThreadRequest) (*pb.StartThreadResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	thread, err := s.svc.StartThread(ctx, req.ChannelId, callerID, req.Name, req.MessageId)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.StartThreadResponse{Thread: service.ThreadToProto(thread)}, nil
+}
+
+func (s *ThreadServer) JoinThread(ctx context.Context, req *pb.JoinThreadRequest) (*pb.JoinThreadResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.JoinThreadResponse{}, domainerr.ToGRPC(s.svc.JoinThread(ctx, req.ThreadId, callerID))
+}
+
+func (s *ThreadServer) LeaveThread(ctx context.Context, req *pb.LeaveThreadRequest) (*pb.LeaveThreadResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	return &pb.LeaveThreadResponse{}, domainerr.ToGRPC(s.svc.LeaveThread(ctx, req.ThreadId, callerID))
+}
+
+func (s *ThreadServer) UpdateThread(ctx context.Context, req *pb.UpdateThreadRequest) (*pb.UpdateThreadResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	thread, err := s.svc.UpdateThread(ctx, req.ThreadId, callerID, service.ThreadUpdate{
+		Name:     req.Name,
+		Archived: req.Archived,
+		Locked:   req.Locked,
+	})
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.UpdateThreadResponse{Thread: service.ThreadToProto(thread)}, nil
+}
+
+func (s *ThreadServer) ListActiveThreads(ctx context.Context, req *pb.ListActiveThreadsRequest) (*pb.ListActiveThreadsResponse, error) {
+	callerID := middleware.MustUserID(ctx)
+	threads, err := s.svc.ListActiveThreads(ctx, req.ChannelId, callerID)
+	if err != nil {
+		return nil, domainerr.ToGRPC(err)
+	}
+	return &pb.ListActiveThreadsResponse{Threads: util.Map(threads, service.ThreadToProto)}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/KitsuLAN/KitsuLAN/services/core/gen/go/kitsulan/v1"
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/middleware"
 	"github.com/KitsuLAN/KitsuLAN/services/core/internal/service"
 	domainerr "github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
@@ -29,21 +30,34 @@ func (s *UserServer) GetProfile(ctx context.Context, req *pb.GetProfileRequest)
 		return nil, domainerr.ToGRPC(err)
 	}
 
-	return &pb.GetProfileResponse{
-		User: &pb.User{
-			Id:        user.ID.String(),
-			Username:  user.Username,
-			AvatarUrl: user.AvatarURL,
-			Bio:       user.Bio, // TODO: Поле может быть тяжёлым, реализовать lazyloading
-			IsOnline:  false,    // TODO: Реализовать Presence систему
-		},
-	}, nil
+	pbUser := &pb.User{
+		Id:        user.ID.String(),
+		Username:  user.Username,
+		AvatarUrl: user.AvatarURL,
+		Bio:       user.Bio, // TODO: Поле может быть тяжёлым, реализовать lazyloading
+		IsOnline:  false,    // TODO: Реализовать Presence систему
+	}
+	// Настройки приватности видны только самому пользователю
+	if targetID == middleware.MustUserID(ctx) {
+		pbUser.DmPolicy = dmPolicyToProto(user.DMPolicy)
+	}
+
+	return &pb.GetProfileResponse{User: pbUser}, nil
 }
 
 func (s *UserServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
 	callerID := middleware.MustUserID(ctx)
 
-	user, err := s.svc.UpdateProfile(ctx, callerID, req.Nickname, req.Bio, req.AvatarUrl)
+	var dmPolicy *models.DMPolicy
+	if req.DmPolicy != nil {
+		p, err := dmPolicyFromProto(req.GetDmPolicy())
+		if err != nil {
+			return nil, domainerr.ToGRPC(err)
+		}
+		dmPolicy = &p
+	}
+
+	user, err := s.svc.UpdateProfile(ctx, callerID, req.Nickname, req.Bio, req.AvatarUrl, dmPolicy)
 	if err != nil {
 		return nil, domainerr.ToGRPC(err)
 	}
@@ -54,6 +68,42 @@ func (s *UserServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileReq
 			Username:  user.Username,
 			AvatarUrl: user.AvatarURL,
 			Bio:       user.Bio,
+			DmPolicy:  dmPolicyToProto(user.DMPolicy),
 		},
 	}, nil
 }
+
+func dmPolicyToProto(p models.DMPolicy) pb.DMPolicy {
+	switch p {
+	case models.DMPolicyEveryone:
+		return pb.DMPolicy_DM_POLICY_EVERYONE
+	case models.DMPolicyGuildMembers:
+		return pb.DMPolicy_DM_POLICY_GUILD_MEMBERS
+	case models.DMPolicyNobody:
+		return pb.DMPolicy_DM_POLICY_NOBODY
+	default:
+		return pb.DMPolicy_DM_POLICY_UNSPECIFIED
+	}
+}
+
+func dmPolicyFromProto(p pb.DMPolicy) (models.DMPolicy, error) {
+	switch p {
+	case pb.DMPolicy_DM_POLICY_EVERYONE:
+		return models.DMPolicyEveryone, nil
+	case pb.DMPolicy_DM_POLICY_GUILD_MEMBERS:
+		return models.DMPolicyGuildMembers, nil
+	case pb.DMPolicy_DM_POLICY_NOBODY:
+		return models.DMPolicyNobody, nil
+	default:
+		return "", domainerr.ValidationError("dm_policy", "Unknown policy")
+	}
+}
+
+// userToProto — публичная карточка пользователя (без bio).
+func userToProto(u *models.User) *pb.User {
+	return &pb.User{
+		Id:        u.ID.String(),
+		Username:  u.Username,
+		AvatarUrl: u.AvatarURL,
+	}
+}
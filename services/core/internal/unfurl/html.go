@@ -0,0 +1,181 @@
+package unfurl
+
+import (
+	"context"
+	"encoding/json"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+const (
+	maxTitleLength       = 256
+	maxDescriptionLength = 1024
+)
+
+var (
+	headEndRegex = regexp.MustCompile(`(?i)</head\s*>`)
+	tagRegex     = regexp.MustCompile(`(?is)<(meta|link)\s[^>]*>`)
+	attrRegex    = regexp.MustCompile(`(?is)([a-z][a-z0-9:_-]*)\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+)`)
+	titleRegex   = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+// pageMeta — то, что удалось достать из <head> страницы.
+type pageMeta struct {
+	props     map[string]string // og:*, twitter:*, description
+	title     string
+	oEmbedURL string
+}
+
+// parseHTML разбирает метатеги регулярками: полноценный HTML-парсер для
+// <head> не нужен, а тело и так обрезано по MaxBytes.
+func parseHTML(body []byte) pageMeta {
+	doc := string(body)
+	if loc := headEndRegex.FindStringIndex(doc); loc != nil {
+		doc = doc[:loc[0]]
+	}
+
+	meta := pageMeta{props: make(map[string]string)}
+	if m := titleRegex.FindStringSubmatch(doc); m != nil {
+		meta.title = cleanText(html.UnescapeString(m[1]))
+	}
+
+	for _, tag := range tagRegex.FindAllStringSubmatch(doc, -1) {
+		attrs := make(map[string]string)
+		for _, a := range attrRegex.FindAllStringSubmatch(tag[0], -1) {
+			attrs[strings.ToLower(a[1])] = html.UnescapeString(strings.Trim(a[2], `"'`))
+		}
+
+		if strings.EqualFold(tag[1], "link") {
+			if strings.EqualFold(attrs["type"], "application/json+oembed") && attrs["href"] != "" {
+				meta.oEmbedURL = attrs["href"]
+			}
+			continue
+		}
+		key := strings.ToLower(attrs["property"])
+		if key == "" {
+			key = strings.ToLower(attrs["name"])
+		}
+		if key != "" && attrs["content"] != "" {
+			if _, seen := meta.props[key]; !seen { // Первое значение главнее
+				meta.props[key] = attrs["content"]
+			}
+		}
+	}
+	return meta
+}
+
+// embed собирает эмбед: OpenGraph, затем Twitter Card, затем <title>/description.
+func (m pageMeta) embed(rawURL, pageURL string) *models.Embed {
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := m.props[k]; v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	embed := &models.Embed{
+		URL:         rawURL,
+		Type:        models.EmbedTypeLink,
+		Title:       truncate(cleanText(first("og:title", "twitter:title")), maxTitleLength),
+		Description: truncate(cleanText(first("og:description", "twitter:description", "description")), maxDescriptionLength),
+		SiteName:    truncate(cleanText(first("og:site_name")), maxTitleLength),
+		ImageURL:    resolveURL(pageURL, first("og:image:secure_url", "og:image", "twitter:image")),
+	}
+	if embed.Title == "" {
+		embed.Title = truncate(m.title, maxTitleLength)
+	}
+	if embed.ImageURL != "" {
+		embed.ImageWidth, _ = strconv.Atoi(m.props["og:image:width"])
+		embed.ImageHeight, _ = strconv.Atoi(m.props["og:image:height"])
+	}
+	if strings.HasPrefix(m.props["og:type"], "video") {
+		embed.Type = models.EmbedTypeVideo
+	}
+	return embed
+}
+
+// oEmbed — поля ответа oEmbed, которые мы используем.
+type oEmbed struct {
+	Type            string `json:"type"`
+	Title           string `json:"title"`
+	AuthorName      string `json:"author_name"`
+	ProviderName    string `json:"provider_name"`
+	ThumbnailURL    string `json:"thumbnail_url"`
+	ThumbnailWidth  int    `json:"thumbnail_width"`
+	ThumbnailHeight int    `json:"thumbnail_height"`
+}
+
+// applyOEmbed дополняет эмбед данными oEmbed. Ошибки не критичны: OpenGraph уже есть.
+func (f *HTTPFetcher) applyOEmbed(ctx context.Context, embed *models.Embed, rawURL, pageURL string) {
+	u, err := url.Parse(resolveURL(pageURL, rawURL))
+	if err != nil || u.String() == "" || f.checkURL(u) != nil {
+		return
+	}
+	body, contentType, _, err := f.get(ctx, u.String(), "application/json")
+	if err != nil || !strings.Contains(contentType, "json") {
+		return
+	}
+	var oe oEmbed
+	if json.Unmarshal(body, &oe) != nil {
+		return
+	}
+
+	if embed.Title == "" {
+		embed.Title = truncate(cleanText(oe.Title), maxTitleLength)
+	}
+	if embed.SiteName == "" {
+		embed.SiteName = truncate(cleanText(oe.ProviderName), maxTitleLength)
+	}
+	if embed.AuthorName == "" {
+		embed.AuthorName = truncate(cleanText(oe.AuthorName), maxTitleLength)
+	}
+	if embed.ImageURL == "" {
+		if img := resolveURL(pageURL, oe.ThumbnailURL); img != "" {
+			embed.ImageURL, embed.ImageWidth, embed.ImageHeight = img, oe.ThumbnailWidth, oe.ThumbnailHeight
+		}
+	}
+	switch oe.Type {
+	case "video":
+		embed.Type = models.EmbedTypeVideo
+	case "rich":
+		embed.Type = models.EmbedTypeRich
+	}
+}
+
+// resolveURL делает ссылку абсолютной относительно страницы; не-http(s) отбрасывает.
+func resolveURL(base, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	u, err := b.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
+
+// cleanText схлопывает пробелы и переносы (значения атрибутов уже раскодированы).
+func cleanText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func truncate(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	r := []rune(s)
+	return string(r[:limit-1]) + "…"
+}
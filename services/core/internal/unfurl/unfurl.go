@@ -0,0 +1,217 @@
+// Package unfurl собирает превью ссылок (OpenGraph, oEmbed) для сообщений.
+//
+// Запросы уходят во внешний мир от имени сервера, поэтому Fetcher по
+// умолчанию не ходит во внутренние сети (SSRF), ограничен по времени и
+// размеру ответа и уважает списки разрешённых/запрещённых доменов.
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+// Fetcher достаёт превью одной ссылки. nil-эмбед без ошибки — у страницы нет метаданных.
+type Fetcher interface {
+	Fetch(ctx context.Context, rawURL string) (*models.Embed, error)
+}
+
+var (
+	ErrBlockedDomain  = errors.New("unfurl: domain is not allowed")
+	ErrBlockedAddress = errors.New("unfurl: address is not allowed")
+	ErrUnsupported    = errors.New("unfurl: unsupported content")
+)
+
+// Options — ограничения HTTPFetcher.
+type Options struct {
+	Timeout      time.Duration // На весь запрос, включая редиректы и oEmbed
+	MaxBytes     int64         // Сколько читаем из тела ответа
+	MaxRedirects int
+	UserAgent    string
+	// AllowDomains — если не пуст, ходим только на эти домены (и их поддомены)
+	AllowDomains []string
+	DenyDomains  []string
+	// AllowPrivateNetworks разрешает loopback/приватные адреса (для тестов и
+	// реалмов, где превью внутренних сервисов нужно осознанно)
+	AllowPrivateNetworks bool
+}
+
+// HTTPFetcher — Fetcher поверх net/http.
+type HTTPFetcher struct {
+	opts   Options
+	client *http.Client
+}
+
+func NewHTTPFetcher(opts Options) *HTTPFetcher {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 5 * time.Second
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = 1 << 20
+	}
+	if opts.MaxRedirects <= 0 {
+		opts.MaxRedirects = 3
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = "KitsuLAN-Unfurler/1.0"
+	}
+
+	f := &HTTPFetcher{opts: opts}
+	dialer := &net.Dialer{
+		Timeout: opts.Timeout,
+		// Проверяем уже разрешённый IP: так не обойти защиту DNS-ребиндингом
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return f.checkAddress(address)
+		},
+	}
+	f.client = &http.Client{
+		Timeout: opts.Timeout,
+		Transport: &http.Transport{
+			Proxy:                 nil, // Прокси обошёл бы проверку адреса
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   opts.Timeout,
+			ResponseHeaderTimeout: opts.Timeout,
+			MaxIdleConns:          16,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > opts.MaxRedirects {
+				return fmt.Errorf("unfurl: too many redirects")
+			}
+			return f.checkURL(req.URL)
+		},
+	}
+	return f
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string) (*models.Embed, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.checkURL(u); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, f.opts.Timeout)
+	defer cancel()
+
+	body, contentType, finalURL, err := f.get(ctx, u.String(), "text/html,application/xhtml+xml")
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(contentType, "image/") {
+		return &models.Embed{URL: rawURL, Type: models.EmbedTypeImage, ImageURL: finalURL}, nil
+	}
+	if !strings.HasPrefix(contentType, "text/html") && !strings.HasPrefix(contentType, "application/xhtml") {
+		return nil, ErrUnsupported
+	}
+
+	meta := parseHTML(body)
+	embed := meta.embed(rawURL, finalURL)
+	if meta.oEmbedURL != "" {
+		f.applyOEmbed(ctx, embed, meta.oEmbedURL, finalURL)
+	}
+	if embed.Title == "" && embed.Description == "" && embed.ImageURL == "" {
+		return nil, nil
+	}
+	return embed, nil
+}
+
+// get читает не больше MaxBytes тела. Возвращает тело, Content-Type и итоговый URL.
+func (f *HTTPFetcher) get(ctx context.Context, rawURL, accept string) ([]byte, string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", "", err
+	}
+	req.Header.Set("User-Agent", f.opts.UserAgent)
+	req.Header.Set("Accept", accept)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", "", fmt.Errorf("unfurl: unexpected status %d", resp.StatusCode)
+	}
+	contentType := strings.ToLower(resp.Header.Get("Content-Type"))
+	if strings.HasPrefix(contentType, "image/") {
+		return nil, contentType, resp.Request.URL.String(), nil // Тело картинки не нужно
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.opts.MaxBytes))
+	if err != nil {
+		return nil, "", "", err
+	}
+	return body, contentType, resp.Request.URL.String(), nil
+}
+
+// checkURL проверяет схему и списки доменов (адрес проверяется при соединении).
+func (f *HTTPFetcher) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrUnsupported
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "" {
+		return ErrBlockedDomain
+	}
+	if matchDomain(host, f.opts.DenyDomains) {
+		return ErrBlockedDomain
+	}
+	if len(f.opts.AllowDomains) > 0 && !matchDomain(host, f.opts.AllowDomains) {
+		return ErrBlockedDomain
+	}
+	return nil
+}
+
+// checkAddress запрещает соединения с внутренними адресами.
+func (f *HTTPFetcher) checkAddress(address string) error {
+	if f.opts.AllowPrivateNetworks {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrBlockedAddress
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	// 100.64.0.0/10 (CGNAT) и 0.0.0.0/8 не покрыты методами net.IP
+	if v4 := ip.To4(); v4 != nil {
+		if v4[0] == 0 || (v4[0] == 100 && v4[1]&0xc0 == 64) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchDomain — host совпадает с доменом из списка или является его поддоменом.
+func matchDomain(host string, domains []string) bool {
+	for _, d := range domains {
+		d = strings.ToLower(strings.Trim(strings.TrimSpace(d), "."))
+		if d != "" && (host == d || strings.HasSuffix(host, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
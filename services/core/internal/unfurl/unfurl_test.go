@@ -0,0 +1,141 @@
+package unfurl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KitsuLAN/KitsuLAN/services/core/internal/domain/models"
+)
+
+const page = `<!doctype html><html><head>
+<title>Fallback title</title>
+<meta property="og:title" content="LAN Party &amp; Chill">
+<meta property="og:description" content="Bring   your
+ own PC">
+<meta property="og:image" content="/cover.png">
+<meta property="og:image:width" content="1200">
+<link rel="alternate" type="application/json+oembed" href="/oembed?url=x">
+</head><body><meta property="og:title" content="ignored"></body></html>`
+
+func newStandIn(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, page)
+	})
+	mux.HandleFunc("/oembed", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"type":"video","author_name":"kitsu","provider_name":"KitsuTube"}`)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/page", http.StatusFound)
+	})
+	mux.HandleFunc("/huge", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, strings.Repeat(" ", 4096)+`<meta property="og:title" content="too far">`)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTPFetcher_Fetch(t *testing.T) {
+	srv := newStandIn(t)
+	f := NewHTTPFetcher(Options{AllowPrivateNetworks: true, MaxBytes: 2048, Timeout: 100 * time.Millisecond})
+	ctx := context.Background()
+
+	t.Run("OpenGraph with oEmbed", func(t *testing.T) {
+		embed, err := f.Fetch(ctx, srv.URL+"/redirect")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := &models.Embed{
+			URL:         srv.URL + "/redirect",
+			Type:        models.EmbedTypeVideo,
+			Title:       "LAN Party & Chill",
+			Description: "Bring your own PC",
+			SiteName:    "KitsuTube",
+			AuthorName:  "kitsu",
+			ImageURL:    srv.URL + "/cover.png",
+			ImageWidth:  1200,
+		}
+		if *embed != *want {
+			t.Errorf("got %+v\nwant %+v", embed, want)
+		}
+	})
+
+	t.Run("body is cut at MaxBytes", func(t *testing.T) {
+		embed, err := f.Fetch(ctx, srv.URL+"/huge")
+		if err != nil || embed != nil {
+			t.Errorf("expected no embed from truncated body, got %+v, %v", embed, err)
+		}
+	})
+
+	t.Run("slow servers time out", func(t *testing.T) {
+		if _, err := f.Fetch(ctx, srv.URL+"/slow"); err == nil {
+			t.Error("expected timeout error")
+		}
+	})
+
+	t.Run("images become image embeds", func(t *testing.T) {
+		embed, err := f.Fetch(ctx, srv.URL+"/image")
+		if err != nil || embed == nil || embed.Type != models.EmbedTypeImage {
+			t.Errorf("expected image embed, got %+v, %v", embed, err)
+		}
+	})
+}
+
+func TestHTTPFetcher_Guards(t *testing.T) {
+	srv := newStandIn(t)
+	ctx := context.Background()
+
+	t.Run("private addresses are blocked by default", func(t *testing.T) {
+		f := NewHTTPFetcher(Options{})
+		if _, err := f.Fetch(ctx, srv.URL+"/page"); !errors.Is(err, ErrBlockedAddress) {
+			t.Errorf("expected ErrBlockedAddress, got %v", err)
+		}
+	})
+
+	t.Run("deny list wins", func(t *testing.T) {
+		f := NewHTTPFetcher(Options{AllowPrivateNetworks: true, DenyDomains: []string{"127.0.0.1"}})
+		if _, err := f.Fetch(ctx, srv.URL+"/page"); !errors.Is(err, ErrBlockedDomain) {
+			t.Errorf("expected ErrBlockedDomain, got %v", err)
+		}
+	})
+
+	t.Run("allow list matches subdomains only", func(t *testing.T) {
+		f := NewHTTPFetcher(Options{AllowDomains: []string{"example.com"}})
+		for host, allowed := range map[string]bool{
+			"https://example.com/":        true,
+			"https://cdn.example.com/":    true,
+			"https://notexample.com/":     false,
+			"https://example.com.evil.io": false,
+		} {
+			u, _ := url.Parse(host)
+			if got := f.checkURL(u) == nil; got != allowed {
+				t.Errorf("%s: allowed=%v, want %v", host, got, allowed)
+			}
+		}
+	})
+
+	t.Run("non-http schemes", func(t *testing.T) {
+		f := NewHTTPFetcher(Options{})
+		if _, err := f.Fetch(ctx, "file:///etc/passwd"); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("expected ErrUnsupported, got %v", err)
+		}
+	})
+}
@@ -43,6 +43,7 @@ const (
 	CodeUserNotFound        ErrorCode = "USER_NOT_FOUND"
 	CodeExplicitContent     ErrorCode = "EXPLICIT_CONTENT_DETECTED"
 	CodeSuspiciousActivity  ErrorCode = "SUSPICIOUS_ACTIVITY_DETECTED"
+	CodeFriendRequestDenied ErrorCode = "FRIEND_REQUEST_NOT_ALLOWED"
 
 	// --- Guilds & Hierarchy ---
 
@@ -65,6 +66,7 @@ const (
 	CodeVoiceFull           ErrorCode = "VOICE_CHANNEL_FULL"
 	CodeCannotEditOwner     ErrorCode = "CANNOT_MODIFY_OWNER"
 	CodeOwnerCannotLeave    ErrorCode = "OWNER_CANNOT_LEAVE"
+	CodeMemberTimedOut      ErrorCode = "MEMBER_COMMUNICATION_DISABLED"
 
 	// --- Permissions ---
 
@@ -94,6 +96,15 @@ const (
 	CodeSlowmodeActive      ErrorCode = "SLOWMODE_RATE_LIMITED"
 	CodeReactionLimit       ErrorCode = "REACTION_LIMIT_REACHED"
 	CodeEditNotAllowed      ErrorCode = "MESSAGE_EDIT_NOT_ALLOWED"
+	CodeThreadArchived      ErrorCode = "THREAD_ARCHIVED"
+	CodeThreadLocked        ErrorCode = "THREAD_LOCKED"
+	CodeThreadExists        ErrorCode = "THREAD_ALREADY_EXISTS"
+	CodeComponentNotFound   ErrorCode = "COMPONENT_NOT_FOUND"
+	CodeInteractionNotFound ErrorCode = "INTERACTION_NOT_FOUND"
+	CodeInteractionTimeout  ErrorCode = "INTERACTION_TIMED_OUT"
+	CodeAppUnavailable      ErrorCode = "APPLICATION_UNAVAILABLE"
+	CodeRecipientOffline    ErrorCode = "RECIPIENT_NOT_CONNECTED"
+	CodeDMNotAllowed        ErrorCode = "DM_NOT_ALLOWED"
 
 	// --- Media & Files ---
 
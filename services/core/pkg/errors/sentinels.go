@@ -2,6 +2,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 )
@@ -19,6 +20,8 @@ var (
 	ErrRateLimit      = New(CodeRateLimited, "You are being rate-limited.", codes.ResourceExhausted)
 	ErrConflict       = New(CodeConflict, "A resource with the same identity already exists.", codes.AlreadyExists)
 	ErrNotImplemented = New(CodeNotImplemented, "This feature is not yet implemented.", codes.Unimplemented)
+	// ErrVersionConflict — Optimistic Locking: запись изменили между чтением и записью.
+	ErrVersionConflict = New(CodeConflict, "This resource was modified by someone else. Reload it and try again.", codes.Aborted)
 )
 
 // --- Auth & User ---
@@ -33,6 +36,8 @@ var (
 	ErrUsernameTaken      = New(CodeUsernameTaken, "This username is already taken.", codes.AlreadyExists)
 	ErrIpBanned           = New(CodeIpBanned, "Your IP address has been banned.", codes.PermissionDenied)
 	ErrCaptchaRequired    = New(CodeCaptchaRequired, "Captcha verification is required.", codes.PermissionDenied)
+	// ErrFriendRequestDenied не уточняет причину, чтобы не выдавать блокировку.
+	ErrFriendRequestDenied = New(CodeFriendRequestDenied, "This user is not accepting friend requests from you.", codes.PermissionDenied)
 )
 
 // --- Guilds, Channels, Roles ---
@@ -48,6 +53,7 @@ var (
 	ErrUserNotInVoice      = New(CodeUserNotInVoice, "You are not in a voice channel.", codes.FailedPrecondition)
 	ErrRolePositionTooHigh = New(CodeRolePositionTooHigh, "Cannot manage a role with a higher or equal position.", codes.PermissionDenied)
 	ErrOwnerCannotLeave    = New(CodeOwnerCannotLeave, "The owner cannot leave the guild.", codes.PermissionDenied)
+	ErrCannotEditOwner     = New(CodeCannotEditOwner, "The guild owner cannot be modified by other members.", codes.PermissionDenied)
 )
 
 // --- Messaging & Media ---
@@ -59,6 +65,17 @@ var (
 	ErrFileTooLarge    = New(CodeFileTooLarge, "The uploaded file is too large.", codes.InvalidArgument)
 	ErrMalwareDetected = New(CodeMalwareDetected, "A virus was detected in the uploaded file.", codes.InvalidArgument)
 	ErrStorageQuota    = New(CodeStorageQuota, "You have exceeded your storage quota.", codes.ResourceExhausted)
+	ErrThreadArchived  = New(CodeThreadArchived, "This thread is archived.", codes.FailedPrecondition)
+	ErrThreadLocked    = New(CodeThreadLocked, "This thread is locked.", codes.PermissionDenied)
+	ErrThreadExists    = New(CodeThreadExists, "A thread has already been started from this message.", codes.AlreadyExists)
+
+	ErrComponentNotFound   = New(CodeComponentNotFound, "This component no longer exists on the message.", codes.NotFound)
+	ErrInteractionNotFound = New(CodeInteractionNotFound, "Interaction not found or already answered.", codes.NotFound)
+	ErrInteractionTimeout  = New(CodeInteractionTimeout, "The application did not respond in time.", codes.DeadlineExceeded)
+	ErrAppUnavailable      = New(CodeAppUnavailable, "The application that owns this message is not connected.", codes.Unavailable)
+	ErrRecipientOffline    = New(CodeRecipientOffline, "The user is not viewing this channel right now.", codes.FailedPrecondition)
+
+	ErrDMNotAllowed = New(CodeDMNotAllowed, "This user does not accept direct messages from you.", codes.PermissionDenied)
 )
 
 // --- Federation & P2P (KitsuLAN specific) ---
@@ -97,6 +114,13 @@ func InviteError(code ErrorCode, inviteCode string) *AppError {
 	return New(code, msg, codes.NotFound).WithMeta("invite_code", inviteCode)
 }
 
+// MemberTimedOut — участник временно лишён возможности общаться в гильдии.
+func MemberTimedOut(until time.Time) *AppError {
+	return New(CodeMemberTimedOut, "You are timed out in this guild.", codes.PermissionDenied).
+		WithMeta("until", until.UTC().Format(time.RFC3339)).
+		WithRemedy("Wait until the timeout expires.")
+}
+
 // LimitReached — для любых лимитов (Guilds, Emojis, Channels).
 func LimitReached(resource string, limit int) *AppError {
 	code := ErrorCode(fmt.Sprintf("MAX_%s_REACHED", resource))
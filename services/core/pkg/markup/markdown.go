@@ -0,0 +1,428 @@
+package markup
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Поддерживаемое подмножество Markdown (как в чатах, не CommonMark):
+//
+//	**жирный**  *курсив*  _курсив_  ||спойлер||  `код`
+//	```lang
+//	блок кода
+//	```
+//	> цитата
+//	[текст](https://...)  https://...  <@USER_ID>  <@&ROLE_ID>  @everyone  @here
+//	<:name:EMOJI_ID>  <a:name:EMOJI_ID>
+//
+// Разбор никогда не падает: всё, что не распознано, остаётся текстом.
+
+// NodeType — вид узла AST.
+type NodeType string
+
+const (
+	NodeText        NodeType = "text"
+	NodeLineBreak   NodeType = "line_break"
+	NodeBold        NodeType = "bold"
+	NodeItalic      NodeType = "italic"
+	NodeSpoiler     NodeType = "spoiler"
+	NodeInlineCode  NodeType = "inline_code"
+	NodeCodeBlock   NodeType = "code_block"
+	NodeQuote       NodeType = "quote"
+	NodeLink        NodeType = "link"
+	NodeUserMention NodeType = "user_mention"
+	NodeRoleMention NodeType = "role_mention"
+	NodeEveryone    NodeType = "everyone"
+	NodeHere        NodeType = "here"
+	NodeCustomEmoji NodeType = "custom_emoji"
+)
+
+// Node — узел нормализованного AST. Заполнены только поля, относящиеся к Type.
+type Node struct {
+	Type     NodeType
+	Text     string // text, inline_code, code_block
+	Lang     string // code_block, может быть пустым
+	URL      string // link: только http(s)
+	ID       string // user_mention, role_mention, custom_emoji
+	Name     string // custom_emoji
+	Animated bool   // custom_emoji
+	Children []Node // bold, italic, spoiler, quote, link
+}
+
+const (
+	// maxDepth — глубже вложенное форматирование остаётся текстом
+	maxDepth   = 8
+	maxURLSize = 2048
+)
+
+var (
+	langRegex   = regexp.MustCompile(`^[A-Za-z0-9_+#.-]{1,32}$`)
+	angleRegex  = regexp.MustCompile(`^<(@&?)([0-9a-fA-F-]{36})>`)
+	emojiRegex  = regexp.MustCompile(`^<(a?):([A-Za-z0-9_]{2,32}):([0-9a-fA-F-]{36})>`)
+	inlineMarks = []struct {
+		mark string
+		typ  NodeType
+	}{
+		{"||", NodeSpoiler},
+		{"**", NodeBold},
+		{"*", NodeItalic},
+		{"_", NodeItalic},
+	}
+)
+
+// Parse разбирает текст в AST: блоки кода и цитаты — построчно, остальное — инлайн.
+// Блоки (code_block, quote) всегда начинаются с новой строки, поэтому переносы
+// вокруг них в AST не попадают.
+func Parse(src string) []Node {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	var para []string
+	var nodes []Node
+	flush := func() {
+		if len(para) > 0 {
+			nodes = append(nodes, (&inlineParser{}).parse(strings.Join(para, "\n"), 0)...)
+			para = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, "```"):
+			block, next, ok := parseCodeBlock(lines, i)
+			if !ok {
+				para = append(para, line)
+				continue
+			}
+			flush()
+			nodes = append(nodes, block)
+			i = next
+		case line == ">" || strings.HasPrefix(line, "> "):
+			var quoted []string
+			for ; i < len(lines) && (lines[i] == ">" || strings.HasPrefix(lines[i], "> ")); i++ {
+				quoted = append(quoted, strings.TrimPrefix(strings.TrimPrefix(lines[i], ">"), " "))
+			}
+			i--
+			flush()
+			nodes = append(nodes, Node{
+				Type:     NodeQuote,
+				Children: (&inlineParser{}).parse(strings.Join(quoted, "\n"), 1),
+			})
+		default:
+			para = append(para, line)
+		}
+	}
+	flush()
+	return nodes
+}
+
+// parseCodeBlock разбирает блок кода, начинающийся в lines[start].
+// Возвращает индекс строки с закрывающими ```. Незакрытый блок — не блок.
+func parseCodeBlock(lines []string, start int) (Node, int, bool) {
+	opening := lines[start][3:]
+
+	// Однострочный: ```код```
+	if end := strings.Index(opening, "```"); end >= 0 {
+		if strings.TrimSpace(opening[end+3:]) != "" || end == 0 {
+			return Node{}, 0, false
+		}
+		return Node{Type: NodeCodeBlock, Text: sanitizeText(opening[:end])}, start, true
+	}
+
+	for j := start + 1; j < len(lines); j++ {
+		if strings.TrimSpace(lines[j]) != "```" {
+			continue
+		}
+		body := lines[start+1 : j]
+		lang := strings.TrimSpace(opening)
+		if lang != "" && !langRegex.MatchString(lang) {
+			// Не похоже на язык — это первая строка кода
+			body = append([]string{opening}, body...)
+			lang = ""
+		}
+		return Node{Type: NodeCodeBlock, Lang: lang, Text: sanitizeText(strings.Join(body, "\n"))}, j, true
+	}
+	return Node{}, 0, false
+}
+
+type inlineParser struct {
+	inLink bool // Внутри текста ссылки ссылки не распознаются
+}
+
+func (p *inlineParser) parse(s string, depth int) []Node {
+	var nodes []Node
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, Node{Type: NodeText, Text: sanitizeText(text.String())})
+			text.Reset()
+		}
+	}
+	emit := func(n Node) {
+		flush()
+		nodes = append(nodes, n)
+	}
+
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		prevWord := i > 0 && isWordByte(s[i-1])
+
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && isEscapable(s[i+1]):
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '\n':
+			emit(Node{Type: NodeLineBreak})
+			i++
+			continue
+		case c == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end > 0 {
+				emit(Node{Type: NodeInlineCode, Text: sanitizeText(rest[1 : 1+end])})
+				i += end + 2
+				continue
+			}
+		case c == '<':
+			if n, size, ok := parseAngle(rest); ok {
+				emit(n)
+				i += size
+				continue
+			}
+		case c == '@' && !prevWord:
+			if n, size, ok := parseKeywordMention(rest); ok {
+				emit(n)
+				i += size
+				continue
+			}
+		case c == '[' && !p.inLink:
+			if n, size, ok := p.parseLink(rest, depth); ok {
+				emit(n)
+				i += size
+				continue
+			}
+		case c == 'h' && !p.inLink && !prevWord:
+			if n, size, ok := parseAutolink(rest); ok {
+				emit(n)
+				i += size
+				continue
+			}
+		}
+
+		if depth < maxDepth && !(s[i] == '_' && prevWord) {
+			if n, size, ok := p.parseDelimited(rest, depth); ok {
+				emit(n)
+				i += size
+				continue
+			}
+		}
+		text.WriteByte(s[i])
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// parseDelimited разбирает **жирный**, *курсив*, _курсив_ и ||спойлер||.
+func (p *inlineParser) parseDelimited(s string, depth int) (Node, int, bool) {
+	for _, m := range inlineMarks {
+		if !strings.HasPrefix(s, m.mark) {
+			continue
+		}
+		inner := s[len(m.mark):]
+		end := findClosing(inner, m.mark)
+		if end <= 0 {
+			continue
+		}
+		return Node{Type: m.typ, Children: p.parse(inner[:end], depth+1)}, end + 2*len(m.mark), true
+	}
+	return Node{}, 0, false
+}
+
+// findClosing ищет закрывающий маркер, пропуская экранирование и инлайн-код.
+// Одиночный * не закрывается половинкой **; _ не закрывается внутри слова.
+func findClosing(s, mark string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			continue
+		case '`':
+			if end := strings.IndexByte(s[i+1:], '`'); end >= 0 {
+				i += end + 1
+				continue
+			}
+		}
+		if !strings.HasPrefix(s[i:], mark) {
+			continue
+		}
+		if mark == "**" && strings.HasPrefix(s[i:], "***") {
+			return i + 1 // ***: сначала закрывается вложенный курсив
+		}
+		if len(mark) == 1 && i+1 < len(s) && s[i+1] == mark[0] {
+			i++
+			continue
+		}
+		if mark == "_" && i+1 < len(s) && isWordByte(s[i+1]) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// parseAngle разбирает <@user>, <@&role>, <:emoji:id> и <a:emoji:id>.
+func parseAngle(s string) (Node, int, bool) {
+	if m := angleRegex.FindStringSubmatch(s); m != nil {
+		id, err := uuid.Parse(m[2])
+		if err != nil {
+			return Node{}, 0, false
+		}
+		typ := NodeUserMention
+		if m[1] == "@&" {
+			typ = NodeRoleMention
+		}
+		return Node{Type: typ, ID: id.String()}, len(m[0]), true
+	}
+	if m := emojiRegex.FindStringSubmatch(s); m != nil {
+		id, err := uuid.Parse(m[3])
+		if err != nil {
+			return Node{}, 0, false
+		}
+		return Node{Type: NodeCustomEmoji, ID: id.String(), Name: m[2], Animated: m[1] == "a"}, len(m[0]), true
+	}
+	return Node{}, 0, false
+}
+
+func parseKeywordMention(s string) (Node, int, bool) {
+	for _, kw := range []struct {
+		word string
+		typ  NodeType
+	}{{"@everyone", NodeEveryone}, {"@here", NodeHere}} {
+		if strings.HasPrefix(s, kw.word) && (len(s) == len(kw.word) || !isWordByte(s[len(kw.word)])) {
+			return Node{Type: kw.typ}, len(kw.word), true
+		}
+	}
+	return Node{}, 0, false
+}
+
+// parseLink разбирает [текст](url). Ссылка с небезопасным URL остаётся текстом.
+func (p *inlineParser) parseLink(s string, depth int) (Node, int, bool) {
+	closeText := strings.Index(s, "](")
+	if closeText <= 1 || strings.ContainsAny(s[1:closeText], "[]\n") {
+		return Node{}, 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL <= 0 {
+		return Node{}, 0, false
+	}
+	u, ok := safeURL(s[closeText+2 : closeText+2+closeURL])
+	if !ok {
+		return Node{}, 0, false
+	}
+
+	inner := &inlineParser{inLink: true}
+	children := []Node{{Type: NodeText, Text: sanitizeText(s[1:closeText])}}
+	if depth < maxDepth {
+		children = inner.parse(s[1:closeText], depth+1)
+	}
+	return Node{Type: NodeLink, URL: u, Children: children}, closeText + 2 + closeURL + 1, true
+}
+
+// parseAutolink распознаёт голые http(s)-ссылки. Завершающая пунктуация не входит в ссылку.
+func parseAutolink(s string) (Node, int, bool) {
+	if !strings.HasPrefix(s, "http://") && !strings.HasPrefix(s, "https://") {
+		return Node{}, 0, false
+	}
+	end := strings.IndexFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == '<' })
+	if end < 0 {
+		end = len(s)
+	}
+	raw := strings.TrimRight(s[:end], ".,:;!?)\"'")
+	u, ok := safeURL(raw)
+	if !ok {
+		return Node{}, 0, false
+	}
+	return Node{Type: NodeLink, URL: u, Children: []Node{{Type: NodeText, Text: raw}}}, len(raw), true
+}
+
+// safeURL пропускает только абсолютные http(s)-ссылки (никаких javascript: и data:).
+func safeURL(raw string) (string, bool) {
+	if raw == "" || len(raw) > maxURLSize {
+		return "", false
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+	return u.String(), true
+}
+
+// sanitizeText убирает управляющие символы и переопределения направления текста.
+func sanitizeText(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t' || r == '\n':
+			return r
+		case unicode.IsControl(r), r >= '\u202A' && r <= '\u202E', r >= '\u2066' && r <= '\u2069':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 0x80 || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+func isEscapable(b byte) bool {
+	return strings.IndexByte("\\`*_|~[]()<>@#>-", b) >= 0
+}
+
+// TextLength — длина видимого текста в символах: разметка не считается,
+// упоминание и кастомный эмодзи считаются за один символ.
+func TextLength(nodes []Node) int {
+	n := 0
+	for i := range nodes {
+		switch nodes[i].Type {
+		case NodeText, NodeInlineCode, NodeCodeBlock:
+			n += utf8.RuneCountInString(nodes[i].Text)
+		case NodeLineBreak, NodeUserMention, NodeRoleMention, NodeEveryone, NodeHere, NodeCustomEmoji:
+			n++
+		default:
+			n += TextLength(nodes[i].Children)
+		}
+	}
+	return n
+}
+
+// Links возвращает URL ссылок в порядке появления, без повторов и не больше limit.
+// Ссылки под спойлером пропускаются: превью раскрыло бы их содержимое.
+func Links(nodes []Node, limit int) []string {
+	var out []string
+	seen := make(map[string]struct{})
+	var walk func(nodes []Node)
+	walk = func(nodes []Node) {
+		for i := range nodes {
+			if len(out) >= limit {
+				return
+			}
+			switch nodes[i].Type {
+			case NodeSpoiler:
+				continue
+			case NodeLink:
+				if _, ok := seen[nodes[i].URL]; !ok {
+					seen[nodes[i].URL] = struct{}{}
+					out = append(out, nodes[i].URL)
+				}
+			}
+			walk(nodes[i].Children)
+		}
+	}
+	walk(nodes)
+	return out
+}
@@ -0,0 +1,138 @@
+package markup
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func text(s string) Node { return Node{Type: NodeText, Text: s} }
+
+func TestParse(t *testing.T) {
+	const userID = "0190b1a2-3c4d-7e5f-8a9b-0c1d2e3f4a5b"
+
+	tests := []struct {
+		name string
+		src  string
+		want []Node
+	}{
+		{
+			name: "plain text",
+			src:  "hello",
+			want: []Node{text("hello")},
+		},
+		{
+			name: "bold, italic and spoiler nest",
+			src:  "**bold *both*** ||secret||",
+			want: []Node{
+				{Type: NodeBold, Children: []Node{text("bold "), {Type: NodeItalic, Children: []Node{text("both")}}}},
+				text(" "),
+				{Type: NodeSpoiler, Children: []Node{text("secret")}},
+			},
+		},
+		{
+			name: "underscores inside words stay text",
+			src:  "snake_case_name and _it_",
+			want: []Node{text("snake_case_name and "), {Type: NodeItalic, Children: []Node{text("it")}}},
+		},
+		{
+			name: "unclosed markers stay text",
+			src:  "2 * 3 = **6",
+			want: []Node{text("2 * 3 = **6")},
+		},
+		{
+			name: "escapes",
+			src:  `\*not italic\*`,
+			want: []Node{text("*not italic*")},
+		},
+		{
+			name: "inline code is literal",
+			src:  "run `**x** <@" + userID + ">`",
+			want: []Node{text("run "), {Type: NodeInlineCode, Text: "**x** <@" + userID + ">"}},
+		},
+		{
+			name: "code block with language",
+			src:  "look:\n```go\nfmt.Println(\"*\")\n```",
+			want: []Node{
+				text("look:"),
+				{Type: NodeCodeBlock, Lang: "go", Text: "fmt.Println(\"*\")"},
+			},
+		},
+		{
+			name: "quote block",
+			src:  "> quoted **line**\n> second\nafter",
+			want: []Node{
+				{Type: NodeQuote, Children: []Node{
+					text("quoted "), {Type: NodeBold, Children: []Node{text("line")}},
+					{Type: NodeLineBreak}, text("second"),
+				}},
+				text("after"),
+			},
+		},
+		{
+			name: "mentions and custom emoji",
+			src:  "<@" + userID + "> @here <a:party:" + userID + ">",
+			want: []Node{
+				{Type: NodeUserMention, ID: userID}, text(" "),
+				{Type: NodeHere}, text(" "),
+				{Type: NodeCustomEmoji, ID: userID, Name: "party", Animated: true},
+			},
+		},
+		{
+			name: "links and autolinks",
+			src:  "[**docs**](https://example.com/a) see https://kitsu.lan/x.",
+			want: []Node{
+				{Type: NodeLink, URL: "https://example.com/a", Children: []Node{{Type: NodeBold, Children: []Node{text("docs")}}}},
+				text(" see "),
+				{Type: NodeLink, URL: "https://kitsu.lan/x", Children: []Node{text("https://kitsu.lan/x")}},
+				text("."),
+			},
+		},
+		{
+			name: "unsafe link schemes stay text",
+			src:  "[click](javascript:alert(1))",
+			want: []Node{text("[click](javascript:alert(1))")},
+		},
+		{
+			name: "bidi overrides are stripped",
+			src:  "abc‮def",
+			want: []Node{text("abcdef")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Parse(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q)\n got: %+v\nwant: %+v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_DepthLimit(t *testing.T) {
+	src := strings.Repeat("||", 20) + "x" + strings.Repeat("||", 20)
+	depth := 0
+	for nodes := Parse(src); len(nodes) > 0 && nodes[0].Type == NodeSpoiler; nodes = nodes[0].Children {
+		depth++
+	}
+	if depth > maxDepth {
+		t.Errorf("nesting depth %d exceeds limit %d", depth, maxDepth)
+	}
+}
+
+func TestTextLength(t *testing.T) {
+	nodes := Parse("**привет** <@0190b1a2-3c4d-7e5f-8a9b-0c1d2e3f4a5b>\n```\ncode\n```")
+	// "привет" + " " + упоминание + "code" (перенос перед блоком не узел)
+	if got := TextLength(nodes); got != 12 {
+		t.Errorf("expected 12, got %d", got)
+	}
+}
+
+func TestLinks(t *testing.T) {
+	nodes := Parse("см. https://a.example и [тоже](https://a.example), ||https://secret.example||\n> **https://b.example** https://c.example")
+	got := Links(nodes, 2)
+	want := []string{"https://a.example", "https://b.example"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
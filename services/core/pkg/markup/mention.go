@@ -0,0 +1,126 @@
+// Package markup разбирает разметку текста сообщений.
+package markup
+
+import (
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Синтаксис упоминаний (как у клиентов):
+//
+//	<@USER_ID>   — пользователь
+//	<@&ROLE_ID>  — роль
+//	@everyone    — все участники гильдии
+//	@here        — участники онлайн
+//
+// @everyone и @here срабатывают только в начале текста или после символа,
+// который не входит в слово: «mail@everyone.org» — не упоминание.
+var (
+	mentionRegex = regexp.MustCompile(`<@(&?)([0-9a-fA-F-]{36})>|@(everyone|here)\b`)
+	// Внутри кода упоминания не срабатывают
+	codeRegex = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+// Mentions — упоминания, найденные в тексте, в порядке появления, без повторов.
+type Mentions struct {
+	Users    []uuid.UUID
+	Roles    []uuid.UUID
+	Everyone bool
+	Here     bool
+}
+
+// IsEmpty сообщает, что в тексте нет упоминаний.
+func (m Mentions) IsEmpty() bool {
+	return len(m.Users) == 0 && len(m.Roles) == 0 && !m.Everyone && !m.Here
+}
+
+// ParseMentions находит упоминания в тексте. Невалидные ID пропускаются.
+func ParseMentions(content string) Mentions {
+	var out Mentions
+	content = codeRegex.ReplaceAllString(content, "")
+
+	seen := make(map[uuid.UUID]struct{})
+	for _, loc := range mentionRegex.FindAllStringSubmatchIndex(content, -1) {
+		m := submatches(content, loc)
+		if m[3] != "" && afterWordChar(content, loc[0]) {
+			continue
+		}
+		switch {
+		case m[3] == "everyone":
+			out.Everyone = true
+		case m[3] == "here":
+			out.Here = true
+		default:
+			id, err := uuid.Parse(m[2])
+			if err != nil {
+				continue
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			if m[1] == "&" {
+				out.Roles = append(out.Roles, id)
+			} else {
+				out.Users = append(out.Users, id)
+			}
+		}
+	}
+	return out
+}
+
+// MentionsOf собирает упоминания из AST размеченного текста (см. Parse).
+// Экранирование (\@everyone) и код парсер уже учёл, поэтому для markdown
+// это точнее, чем ParseMentions по сырому тексту.
+func MentionsOf(nodes []Node) Mentions {
+	var out Mentions
+	seen := make(map[uuid.UUID]struct{})
+	var walk func(nodes []Node)
+	walk = func(nodes []Node) {
+		for i := range nodes {
+			switch nodes[i].Type {
+			case NodeEveryone:
+				out.Everyone = true
+			case NodeHere:
+				out.Here = true
+			case NodeUserMention, NodeRoleMention:
+				id, err := uuid.Parse(nodes[i].ID)
+				if err != nil {
+					continue
+				}
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				seen[id] = struct{}{}
+				if nodes[i].Type == NodeRoleMention {
+					out.Roles = append(out.Roles, id)
+				} else {
+					out.Users = append(out.Users, id)
+				}
+			}
+			walk(nodes[i].Children)
+		}
+	}
+	walk(nodes)
+	return out
+}
+
+// submatches превращает индексы из FindAllStringSubmatchIndex в строки групп.
+func submatches(s string, loc []int) []string {
+	out := make([]string, len(loc)/2)
+	for i := range out {
+		if loc[2*i] >= 0 {
+			out[i] = s[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return out
+}
+
+// afterWordChar сообщает, что перед позицией i стоит буква, цифра или «_».
+func afterWordChar(s string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
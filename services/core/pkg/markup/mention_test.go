@@ -0,0 +1,76 @@
+package markup
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseMentions(t *testing.T) {
+	user := uuid.MustParse("0190b1a2-3c4d-7e5f-8a9b-0c1d2e3f4a5b")
+	role := uuid.MustParse("0190b1a2-3c4d-7e5f-8a9b-0c1d2e3f4a5c")
+
+	t.Run("users, roles and everyone", func(t *testing.T) {
+		got := ParseMentions("hi <@" + user.String() + "> and <@&" + role.String() + ">, @everyone")
+		if len(got.Users) != 1 || got.Users[0] != user {
+			t.Errorf("expected user mention, got %v", got.Users)
+		}
+		if len(got.Roles) != 1 || got.Roles[0] != role {
+			t.Errorf("expected role mention, got %v", got.Roles)
+		}
+		if !got.Everyone || got.Here {
+			t.Errorf("expected only @everyone, got everyone=%v here=%v", got.Everyone, got.Here)
+		}
+	})
+
+	t.Run("duplicates collapse", func(t *testing.T) {
+		got := ParseMentions("<@" + user.String() + "> <@" + user.String() + "> @here")
+		if len(got.Users) != 1 || !got.Here {
+			t.Errorf("unexpected result: %+v", got)
+		}
+	})
+
+	t.Run("code is ignored", func(t *testing.T) {
+		got := ParseMentions("`@everyone` ```\n<@" + user.String() + ">\n```")
+		if !got.IsEmpty() {
+			t.Errorf("expected no mentions inside code, got %+v", got)
+		}
+	})
+
+	t.Run("invalid ids and words are ignored", func(t *testing.T) {
+		got := ParseMentions("<@not-a-valid-uuid-but-thirty-six-chr> @everyoneelse")
+		if !got.IsEmpty() {
+			t.Errorf("expected no mentions, got %+v", got)
+		}
+	})
+
+	t.Run("everyone needs a boundary on the left", func(t *testing.T) {
+		got := ParseMentions("mail admin@everyone.org or ping_@here, привет@everyone")
+		if !got.IsEmpty() {
+			t.Errorf("expected no mentions after word characters, got %+v", got)
+		}
+		got = ParseMentions("(@everyone) <@" + user.String() + ">@here")
+		if !got.Everyone || !got.Here {
+			t.Errorf("expected @everyone and @here after punctuation, got %+v", got)
+		}
+	})
+}
+
+func TestMentionsOf(t *testing.T) {
+	user := uuid.MustParse("0190b1a2-3c4d-7e5f-8a9b-0c1d2e3f4a5b")
+	role := uuid.MustParse("0190b1a2-3c4d-7e5f-8a9b-0c1d2e3f4a5c")
+
+	t.Run("mentions inside formatting", func(t *testing.T) {
+		got := MentionsOf(Parse("**<@" + user.String() + ">** ||<@&" + role.String() + ">|| > @here"))
+		if len(got.Users) != 1 || got.Users[0] != user || len(got.Roles) != 1 || got.Roles[0] != role || !got.Here {
+			t.Errorf("unexpected result: %+v", got)
+		}
+	})
+
+	t.Run("escapes, code and words are not mentions", func(t *testing.T) {
+		got := MentionsOf(Parse(`\@everyone ` + "`@here`" + ` mail@everyone.org`))
+		if !got.IsEmpty() {
+			t.Errorf("expected no mentions, got %+v", got)
+		}
+	})
+}
@@ -1,8 +1,10 @@
 package validator
 
 import (
+	"net/url"
 	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/KitsuLAN/KitsuLAN/services/core/pkg/errors"
 )
@@ -10,6 +12,7 @@ import (
 var (
 	usernameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
 	emailRegex    = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	hexColorRegex = regexp.MustCompile(`^#(?:[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
 )
 
 func ValidateCredentials(username, password string) *errors.AppError {
@@ -51,3 +54,58 @@ func ValidateChannelName(name string) *errors.AppError {
 	// Для каналов обычно запрещают пробелы или спецсимволы, но оставим мягкую проверку
 	return nil
 }
+
+// ValidateNickname проверяет ник участника в гильдии.
+// Пустая строка допустима и означает "сбросить".
+func ValidateNickname(nickname string) *errors.AppError {
+	if nickname == "" {
+		return nil
+	}
+	if strings.TrimSpace(nickname) == "" {
+		return errors.ValidationError("nickname", "Must not be blank")
+	}
+	if utf8.RuneCountInString(nickname) > 32 {
+		return errors.ValidationError("nickname", "Must be at most 32 characters")
+	}
+	return nil
+}
+
+func ValidateGuildDescription(description string) *errors.AppError {
+	if len(description) > 1024 {
+		return errors.ValidationError("description", "Must be at most 1024 characters")
+	}
+	return nil
+}
+
+// ValidateHexColor проверяет цвет в формате #RRGGBB или #RRGGBBAA.
+func ValidateHexColor(field, color string) *errors.AppError {
+	if !hexColorRegex.MatchString(color) {
+		return errors.ValidationError(field, "Must be a hex color like #ff0000").
+			WithRemedy("Use the #RRGGBB or #RRGGBBAA format.")
+	}
+	return nil
+}
+
+// ValidateMediaURL проверяет ссылку на картинку (иконка, баннер, аватар).
+// Пустая строка допустима и означает "сбросить".
+func ValidateMediaURL(field, raw string) *errors.AppError {
+	if raw == "" {
+		return nil
+	}
+	if len(raw) > 2048 {
+		return errors.ValidationError(field, "Must be at most 2048 characters")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.ValidationError(field, "Must be an absolute http(s) URL")
+	}
+	return nil
+}
+
+// ValidateEmoji проверяет реакцию: Unicode эмодзи или ID кастомного (до 64 байт, без пробелов).
+func ValidateEmoji(emoji string) *errors.AppError {
+	if emoji == "" || len(emoji) > 64 || strings.ContainsAny(emoji, " \t\r\n") || !utf8.ValidString(emoji) {
+		return errors.ValidationError("emoji", "Must be a single emoji or custom emoji ID")
+	}
+	return nil
+}